@@ -2,11 +2,16 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dmetrics"
 	firecore "github.com/streamingfast/firehose-core"
 	"github.com/streamingfast/firehose-core/blockpoller"
 	firecoreRPC "github.com/streamingfast/firehose-core/rpc"
@@ -15,6 +20,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// endpointSortInterval is how often endpoints are reordered by health score
+const endpointSortInterval = 30 * time.Second
+
 func NewFetchCmd(logger *zap.Logger, tracer logging.Tracer) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rpc <first-streamable-block>",
@@ -45,12 +53,35 @@ XRPL Endpoints:
 	cmd.Flags().String("state-dir", "/data/poller", "Directory to store poller state")
 	cmd.Flags().Duration("interval-between-fetch", 0, "Interval between consecutive fetches")
 	cmd.Flags().Duration("latest-block-retry-interval", time.Second, "Interval to wait before retrying when waiting for new ledger")
+	cmd.Flags().Int("latest-block-max-retries", 3, "Number of times a failed latest ledger poll is retried with backoff before the fetch fails")
+	cmd.Flags().Bool("adaptive-polling", false, "Once caught up, wait for the next expected ledger close (from observed cadence) instead of polling every latest-block-retry-interval")
+	cmd.Flags().String("block-id-encoding", string(rpc.BlockIDHexLower), "Encoding of block IDs derived from ledger hashes: hex-lower, hex-upper or base64")
+	cmd.Flags().Bool("include-decoded-meta", false, "Attach the decoded transaction metadata as a google.protobuf.Struct on each transaction (increases block size)")
+	cmd.Flags().Int("max-block-size", 100*1024*1024, "Warn when a marshaled block payload exceeds this many bytes (0 = disabled)")
+	cmd.Flags().Bool("fail-on-oversized-block", false, "Fail the fetch instead of warning when a block exceeds --max-block-size")
+	cmd.Flags().Duration("shutdown-timeout", 30*time.Second, "On SIGINT/SIGTERM, how long to wait for the ledger being fetched to finish before exiting with an error")
+	cmd.Flags().Int("large-meta-threshold", 1024*1024, "Warn about and count transactions whose metadata exceeds this many bytes (0 = disabled)")
+	cmd.Flags().Int("max-tx-size", 16*1024*1024, "Skip, with a warning, transactions whose blob or metadata exceeds this many bytes (0 = disabled)")
+	cmd.Flags().Bool("profile-tx-decoding", false, "Record how long transactions take to decode and map, by type, in the tx_decode_duration_seconds metric and the end-of-run summary")
+	cmd.Flags().Bool("validate-multisign", false, "Check that multi-signed transactions meet the account's signer list quorum, warning on discrepancies (one extra ledger_entry request per multi-signed transaction)")
+	cmd.Flags().String("websocket-endpoint", "", "rippled WebSocket URL (e.g. wss://xrplcluster.com/) to wake up as soon as a ledger validates instead of polling (empty = polling only)")
+	cmd.Flags().Bool("allow-gap-skipping", false, "Skip ledgers the endpoint reports as not found instead of failing, trading completeness for liveness (the stream will have gaps)")
+	cmd.Flags().StringSlice("include-tx-types", nil, "Only include transactions of these types in blocks, e.g. Payment,AMMDeposit (empty = all types)")
+	cmd.Flags().StringSlice("exclude-tx-types", nil, "Leave transactions of these types out of blocks")
+	cmd.Flags().Bool("verify-parent-hash", false, "Check that each ledger's parent hash matches the previously fetched ledger, refetching from the next endpoint on mismatch")
 	cmd.Flags().Duration("max-block-fetch-duration", 10*time.Second, "Maximum duration for fetching a single block")
 	cmd.Flags().Int("block-fetch-batch-size", 1, "Number of blocks to fetch in a single batch")
 	cmd.Flags().Int("worker-pool-size", 10, "Number of concurrent workers for processing transactions within a block")
 	cmd.Flags().Int("http-max-idle-conns", 100, "Maximum number of idle HTTP connections in the pool")
 	cmd.Flags().Int("http-max-idle-conns-per-host", 10, "Maximum number of idle HTTP connections per host")
 	cmd.Flags().Duration("http-idle-conn-timeout", 90*time.Second, "Maximum time an idle connection is kept alive")
+	cmd.Flags().StringArray("endpoint-header", []string{}, "Extra HTTP header sent to every endpoint as 'Name: value', e.g. a provider API key (repeatable)")
+	cmd.Flags().Int("rpc-max-retries", 3, "Retries of a JSON-RPC request failing with a transient error (timeout, 429, 502, 503, 504) before rotating endpoints (0 = disabled)")
+	cmd.Flags().String("metrics-listen-addr", "", "Address to serve Prometheus metrics on, e.g. :9102 (empty = disabled)")
+	cmd.Flags().Duration("rpc-retry-base", 200*time.Millisecond, "Delay before the first JSON-RPC retry, doubled on each following one (with jitter, 0 = retry immediately)")
+	cmd.Flags().Float64("endpoint-max-error-rate", 0.5, "Quarantine an endpoint once this fraction (0 to 1) of its recent requests failed (0 = disabled, always disabled with a single endpoint)")
+	cmd.Flags().Duration("endpoint-cooldown", time.Minute, "How long a quarantined endpoint is skipped before being tried again")
+	cmd.Flags().Float64("max-requests-per-second", 0, "Hard cap on the JSON-RPC requests sent to each endpoint per second, busy endpoints (429, 503, tooBusy) are slowed down below it automatically (0 = no cap)")
 
 	return cmd
 }
@@ -86,18 +117,47 @@ func fetchRunE(logger *zap.Logger, tracer logging.Tracer) firecore.CommandExecut
 		httpMaxIdleConns := sflags.MustGetInt(cmd, "http-max-idle-conns")
 		httpMaxIdleConnsPerHost := sflags.MustGetInt(cmd, "http-max-idle-conns-per-host")
 		httpIdleConnTimeout := sflags.MustGetDuration(cmd, "http-idle-conn-timeout")
+		retryBase := sflags.MustGetDuration(cmd, "rpc-retry-base")
+		if retryBase < 0 {
+			return fmt.Errorf("--rpc-retry-base must be positive or 0, got %s", retryBase)
+		}
+		retryPolicy := rpc.WithRetryPolicy(sflags.MustGetInt(cmd, "rpc-max-retries"), retryBase)
+
+		maxErrorRate := sflags.MustGetFloat64(cmd, "endpoint-max-error-rate")
+		if maxErrorRate < 0 || maxErrorRate > 1 {
+			return fmt.Errorf("--endpoint-max-error-rate must be between 0 and 1, got %v", maxErrorRate)
+		}
+		// Quarantine rotates to another endpoint, with a single one there is nowhere to go
+		if len(rpcEndpoints) == 1 {
+			maxErrorRate = 0
+		}
+		healthPolicy := rpc.WithHealthPolicy(maxErrorRate, sflags.MustGetDuration(cmd, "endpoint-cooldown"))
+
+		headerOptions, err := parseEndpointHeaders(sflags.MustGetStringArray(cmd, "endpoint-header"))
+		if err != nil {
+			return err
+		}
+		maxRequestsPerSecond := sflags.MustGetFloat64(cmd, "max-requests-per-second")
+		if maxRequestsPerSecond < 0 {
+			return fmt.Errorf("--max-requests-per-second must be positive or 0, got %v", maxRequestsPerSecond)
+		}
+		rateLimit := rpc.WithMaxRequestsPerSecond(maxRequestsPerSecond)
+
+		clientOptions := append([]rpc.ClientOption{retryPolicy, healthPolicy, rateLimit}, headerOptions...)
 
 		// Create rolling strategy for RPC clients
-		rollingStrategy := firecoreRPC.NewStickyRollingStrategy[*rpc.Client]()
+		rollingStrategy := firecoreRPC.NewStickyRollingStrategy[rpc.ClientInterface]()
 
 		// Create RPC clients manager
 		rpcClients := firecoreRPC.NewClients(maxBlockFetchDuration, rollingStrategy, logger)
+		var endpointClients []rpc.ClientInterface
 		for _, endpoint := range rpcEndpoints {
-			client, err := rpc.NewClientWithHTTPConfig(endpoint, logger, httpMaxIdleConns, httpMaxIdleConnsPerHost, httpIdleConnTimeout)
+			client, err := rpc.NewClientWithHTTPConfig(endpoint, logger, httpMaxIdleConns, httpMaxIdleConnsPerHost, httpIdleConnTimeout, clientOptions...)
 			if err != nil {
 				return fmt.Errorf("failed to create client for endpoint %s: %w", endpoint, err)
 			}
 			rpcClients.Add(client)
+			endpointClients = append(endpointClients, client)
 			logger.Info("added RPC endpoint",
 				zap.String("endpoint", endpoint),
 				zap.Int("max_idle_conns", httpMaxIdleConns),
@@ -105,18 +165,97 @@ func fetchRunE(logger *zap.Logger, tracer logging.Tracer) firecore.CommandExecut
 				zap.Duration("idle_conn_timeout", httpIdleConnTimeout))
 		}
 
+		blockIDEncoding, err := rpc.ParseBlockIDEncoding(sflags.MustGetString(cmd, "block-id-encoding"))
+		if err != nil {
+			return err
+		}
+
 		workerPoolSize := sflags.MustGetInt(cmd, "worker-pool-size")
 		fetcher := rpc.NewFetcherWithWorkerPool(fetchInterval, latestBlockRetryInterval, workerPoolSize, logger)
+		fetcher.SetEndpoints(endpointClients)
+		fetcher.SetLatestLedgerRetries(sflags.MustGetInt(cmd, "latest-block-max-retries"))
+		fetcher.SetAdaptivePolling(sflags.MustGetBool(cmd, "adaptive-polling"))
+		fetcher.SetBlockIDEncoding(blockIDEncoding)
+		fetcher.SetIncludeDecodedMeta(sflags.MustGetBool(cmd, "include-decoded-meta"))
+		fetcher.SetBlockSizeLimit(sflags.MustGetInt(cmd, "max-block-size"), sflags.MustGetBool(cmd, "fail-on-oversized-block"))
+		fetcher.SetLargeMetaThreshold(sflags.MustGetInt(cmd, "large-meta-threshold"))
+		fetcher.SetMaxTxSize(sflags.MustGetInt(cmd, "max-tx-size"))
+		fetcher.SetProfileTxDecoding(sflags.MustGetBool(cmd, "profile-tx-decoding"))
+		fetcher.SetValidateMultisign(sflags.MustGetBool(cmd, "validate-multisign"))
+		fetcher.SetVerifyParentHash(sflags.MustGetBool(cmd, "verify-parent-hash"))
+		if err := fetcher.SetTxTypeFilter(sflags.MustGetStringSlice(cmd, "include-tx-types"), sflags.MustGetStringSlice(cmd, "exclude-tx-types")); err != nil {
+			return err
+		}
+
+		if sflags.MustGetBool(cmd, "allow-gap-skipping") {
+			logger.Warn("gap skipping is enabled, ledgers the endpoint cannot provide will be missing from the stream")
+			fetcher.SetAllowGapSkipping(true)
+		}
+
+		if metricsAddr := sflags.MustGetString(cmd, "metrics-listen-addr"); metricsAddr != "" {
+			rpc.RegisterMetrics()
+			go dmetrics.Serve(metricsAddr)
+			logger.Info("serving Prometheus metrics", zap.String("listen_addr", metricsAddr))
+		}
 
 		poller := blockpoller.New(
 			fetcher,
 			blockpoller.NewFireBlockHandler("type.googleapis.com/sf.xrpl.type.v1.Block"),
 			rpcClients,
-			blockpoller.WithStoringState[*rpc.Client](stateDir),
-			blockpoller.WithLogger[*rpc.Client](logger),
+			blockpoller.WithStoringState[rpc.ClientInterface](stateDir),
+			blockpoller.WithLogger[rpc.ClientInterface](logger),
 		)
 
-		err = poller.Run(startBlock, nil, sflags.MustGetInt(cmd, "block-fetch-batch-size"))
+		// Log the end-of-run summary whether the poller stops on its own or is interrupted,
+		// letting the current ledger finish on SIGINT/SIGTERM
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		// Periodically move the healthiest endpoints first, the sticky strategy then restarts from the top
+		if len(rpcEndpoints) > 1 {
+			rpcClients.StartSorting(ctx, firecoreRPC.SortDirectionDescending, rpc.EndpointHealthSorter{}, endpointSortInterval)
+		}
+
+		if wsEndpoint := sflags.MustGetString(cmd, "websocket-endpoint"); wsEndpoint != "" {
+			subClient, err := rpc.NewClient(rpcEndpoints[0], logger, headerOptions...)
+			if err != nil {
+				return fmt.Errorf("failed to create subscription client: %w", err)
+			}
+			subClient.SetWebSocketEndpoint(wsEndpoint)
+
+			closed, err := subClient.SubscribeLedgers(ctx)
+			if err != nil {
+				logger.Warn("ledger subscription unavailable, falling back to polling", zap.String("websocket_endpoint", wsEndpoint), zap.Error(err))
+			} else {
+				fetcher.SetLedgerNotifications(closed)
+			}
+		}
+
+		runErr := make(chan error, 1)
+		go func() {
+			runErr <- poller.Run(startBlock, nil, sflags.MustGetInt(cmd, "block-fetch-batch-size"))
+		}()
+
+		select {
+		case err = <-runErr:
+		case <-ctx.Done():
+			shutdownTimeout := sflags.MustGetDuration(cmd, "shutdown-timeout")
+			logger.Info("received shutdown signal, waiting for the current ledger to finish", zap.Duration("timeout", shutdownTimeout))
+
+			// The poller saves its state file after each fired block, so draining the
+			// fetcher leaves the checkpoint at the last complete ledger
+			drained := fetcher.Drain(shutdownTimeout)
+			poller.Shutdown(nil)
+			fetcher.LogStats()
+
+			if !drained {
+				return fmt.Errorf("interrupted mid-ledger, the current ledger did not finish within %s", shutdownTimeout)
+			}
+			return nil
+		}
+
+		fetcher.LogStats()
+
 		if err != nil {
 			return fmt.Errorf("running poller: %w", err)
 		}
@@ -124,3 +263,18 @@ func fetchRunE(logger *zap.Logger, tracer logging.Tracer) firecore.CommandExecut
 		return nil
 	}
 }
+
+// parseEndpointHeaders turns --endpoint-header values ("Name: value") into client options
+func parseEndpointHeaders(headers []string) ([]rpc.ClientOption, error) {
+	options := make([]rpc.ClientOption, 0, len(headers))
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --endpoint-header %q, expected 'Name: value'", header)
+		}
+		options = append(options, rpc.WithHeader(name, strings.TrimSpace(value)))
+	}
+
+	return options, nil
+}
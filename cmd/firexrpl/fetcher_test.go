@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEndpointHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  []string
+		wantOpts int
+		wantErr  bool
+	}{
+		{"none", nil, 0, false},
+		{"api key", []string{"X-Api-Key: secret"}, 1, false},
+		{"value with a colon", []string{"Authorization: Bearer a:b"}, 1, false},
+		{"repeated", []string{"X-Api-Key: secret", "X-Tenant: acme"}, 2, false},
+		{"missing separator", []string{"X-Api-Key secret"}, 0, true},
+		{"empty name", []string{" : secret"}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, err := parseEndpointHeaders(tt.headers)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "--endpoint-header")
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, options, tt.wantOpts)
+		})
+	}
+}
@@ -46,8 +46,18 @@ func main() {
 			CobraCmd(NewFetchCmd(logger, tracer)),
 		),
 
+		CobraCmd(NewServeGRPCCmd(logger)),
+		CobraCmd(NewToolAccountObjectsCmd()),
+		CobraCmd(NewToolBackfillCmd()),
 		CobraCmd(NewToolDecodeBlockCmd()),
+		CobraCmd(NewToolFetchBlockCmd()),
 		CobraCmd(NewToolCheckLedgerCmd()),
+		CobraCmd(NewToolCheckRangeCmd()),
+		CobraCmd(NewToolHashLedgerCmd()),
+		CobraCmd(NewToolPathFindCmd()),
+		CobraCmd(NewToolReplayServerCmd()),
+		CobraCmd(NewToolTxCmd()),
+		CobraCmd(NewToolVerifyDecodeCmd()),
 
 		OnCommandErrorLogAndExit(logger),
 	)
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	pbxrplsvc "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/service/v1"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"github.com/xrpl-commons/firehose-xrpl/server"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// gracefulStopTimeout bounds how long open streams may take to end on shutdown
+const gracefulStopTimeout = 10 * time.Second
+
+func NewServeGRPCCmd(logger *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-grpc",
+		Short: "Serve decoded XRPL blocks over gRPC",
+		Long: `Starts a gRPC server exposing a streaming Blocks(start, stop) RPC that
+fetches and decodes ledgers on demand, for direct integration without the
+Firehose stack. The standard gRPC health service is also registered.
+
+Example:
+  firexrpl serve-grpc --endpoint https://s1.ripple.com:51234/ --listen-addr :9000
+`,
+		RunE: serveGRPCRunE(logger),
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().String("listen-addr", ":9000", "Address the gRPC server listens on")
+	cmd.Flags().Duration("latest-block-retry-interval", time.Second, "Interval to wait before retrying when waiting for new ledger")
+	cmd.Flags().Int("worker-pool-size", 10, "Number of concurrent workers for processing transactions within a block")
+
+	return cmd
+}
+
+func serveGRPCRunE(logger *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		endpoint := sflags.MustGetString(cmd, "endpoint")
+		listenAddr := sflags.MustGetString(cmd, "listen-addr")
+		latestBlockRetryInterval := sflags.MustGetDuration(cmd, "latest-block-retry-interval")
+		workerPoolSize := sflags.MustGetInt(cmd, "worker-pool-size")
+
+		client, err := rpc.NewClient(endpoint, logger)
+		if err != nil {
+			return fmt.Errorf("failed to create client: %w", err)
+		}
+
+		listener, err := net.Listen("tcp", listenAddr)
+		if err != nil {
+			return fmt.Errorf("listening on %s: %w", listenAddr, err)
+		}
+
+		newFetcher := func() *rpc.Fetcher {
+			return rpc.NewFetcherWithWorkerPool(0, latestBlockRetryInterval, workerPoolSize, logger)
+		}
+
+		grpcServer := grpc.NewServer()
+		blocksServer := server.NewBlocksServer(client, newFetcher, logger)
+		pbxrplsvc.RegisterBlockStreamServer(grpcServer, blocksServer)
+
+		healthServer := health.NewServer()
+		healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+		healthServer.SetServingStatus(pbxrplsvc.BlockStream_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+		healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		go func() {
+			<-ctx.Done()
+			logger.Info("received shutdown signal, stopping gRPC server")
+			healthServer.Shutdown()
+			blocksServer.Shutdown()
+
+			// Streams end as soon as their fetch notices the cancellation, don't wait forever on one that doesn't
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-time.After(gracefulStopTimeout):
+				logger.Warn("gRPC streams still open after graceful stop timeout, closing them", zap.Duration("timeout", gracefulStopTimeout))
+				grpcServer.Stop()
+			}
+		}()
+
+		logger.Info("serving blocks over gRPC",
+			zap.String("listen_addr", listenAddr),
+			zap.String("endpoint", endpoint))
+
+		if err := grpcServer.Serve(listener); err != nil {
+			return fmt.Errorf("serving gRPC: %w", err)
+		}
+
+		return nil
+	}
+}
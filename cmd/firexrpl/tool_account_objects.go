@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+)
+
+func NewToolAccountObjectsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-account-objects <account>",
+		Short: "List the ledger objects owned by an account",
+		Long: `Lists the ledger objects (offers, trust lines, escrows, checks, ...) owned
+by an account in the latest validated ledger, following pagination markers.
+
+Examples:
+  # All objects owned by an account
+  firexrpl tool-account-objects rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh
+
+  # Only the account's offers, printed as JSON
+  firexrpl tool-account-objects rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh --type offer --json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runToolAccountObjects,
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().String("type", "", "Only list objects of this type (e.g. offer, state, escrow, check)")
+	cmd.Flags().Int("max-pages", 10, "Maximum number of pages to fetch (0 = no limit)")
+	cmd.Flags().Bool("json", false, "Print each object as JSON")
+
+	return cmd
+}
+
+func runToolAccountObjects(cmd *cobra.Command, args []string) error {
+	account := args[0]
+	endpoint := sflags.MustGetString(cmd, "endpoint")
+	objectType := sflags.MustGetString(cmd, "type")
+	maxPages := sflags.MustGetInt(cmd, "max-pages")
+	asJSON := sflags.MustGetBool(cmd, "json")
+
+	logger, _ := zap.NewDevelopment()
+
+	client, err := rpc.NewClient(endpoint, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	total := 0
+	var marker any
+	for page := 1; maxPages == 0 || page <= maxPages; page++ {
+		result, err := client.GetAccountObjects(ctx, account, objectType, marker)
+		if err != nil {
+			return fmt.Errorf("failed to get account objects: %w", err)
+		}
+
+		if page == 1 {
+			fmt.Printf("Account %s objects at ledger %d\n\n", account, result.LedgerIndex)
+		}
+
+		for _, obj := range result.AccountObjects {
+			total++
+			if asJSON {
+				out, err := json.Marshal(obj)
+				if err != nil {
+					return fmt.Errorf("failed to format object: %w", err)
+				}
+				fmt.Println(string(out))
+				continue
+			}
+			fmt.Printf("%-20v %v\n", obj["LedgerEntryType"], obj["index"])
+		}
+
+		marker = result.Marker
+		if marker == nil {
+			fmt.Printf("\nTotal objects: %d\n", total)
+			return nil
+		}
+	}
+
+	fmt.Printf("\nTotal objects: %d (stopped after %d pages, more are available)\n", total, maxPages)
+	return nil
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+)
+
+// backfillCheckpointFile is the name of the checkpoint written in the output directory
+const backfillCheckpointFile = "checkpoint.json"
+
+func NewToolBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-backfill",
+		Short: "Fetch a historical ledger range to .dbin files, resuming after interruption",
+		Long: `Fetches a ledger range through the fetcher and writes the Firehose blocks to
+.dbin files, without running the Firehose reader protocol.
+
+Each file is named after the first ledger it holds. With --bundle-size 1 (default)
+every ledger gets its own file, larger values group ledgers into merged files
+aligned on multiples of the bundle size.
+
+After each written file the last written ledger is recorded in checkpoint.json in
+the output directory. Running the same command again resumes after it, and with a
+larger --end extends the backfill, rewriting its last bundle when --end cut it short.
+
+Example:
+  firexrpl tool-backfill --start 90000000 --end 90009999 --dir out/ --bundle-size 100 \
+    --endpoints https://s1.ripple.com:51234/ --endpoints https://xrplcluster.com/
+`,
+		RunE: runToolBackfill,
+	}
+
+	cmd.Flags().Uint64("start", 0, "First ledger index of the range (required)")
+	cmd.Flags().Uint64("end", 0, "Last ledger index of the range, inclusive (required)")
+	cmd.Flags().String("dir", "", "Output directory for the .dbin files and checkpoint (required)")
+	cmd.Flags().StringArray("endpoints", []string{"https://s1.ripple.com:51234/"}, "XRPL RPC endpoints, the next one is tried when a batch fails")
+	cmd.Flags().Uint64("bundle-size", 1, "Number of ledgers per .dbin file")
+	cmd.Flags().Int("batch-size", 10, "Number of ledgers fetched in parallel per batch")
+	cmd.Flags().Int("worker-pool-size", 10, "Number of concurrent workers for processing transactions within a block")
+
+	return cmd
+}
+
+// backfillCheckpoint records the progress of a backfill, the range settings guard against
+// resuming into a directory written with a different layout
+type backfillCheckpoint struct {
+	Start       uint64 `json:"start"`
+	BundleSize  uint64 `json:"bundle_size"`
+	LastWritten uint64 `json:"last_written"`
+}
+
+func runToolBackfill(cmd *cobra.Command, args []string) error {
+	start := sflags.MustGetUint64(cmd, "start")
+	end := sflags.MustGetUint64(cmd, "end")
+	dir := sflags.MustGetString(cmd, "dir")
+	bundleSize := sflags.MustGetUint64(cmd, "bundle-size")
+	batchSize := sflags.MustGetInt(cmd, "batch-size")
+	endpoints := sflags.MustGetStringArray(cmd, "endpoints")
+
+	if start == 0 || end == 0 || dir == "" {
+		return fmt.Errorf("--start, --end and --dir are required")
+	}
+	if end < start {
+		return fmt.Errorf("--end %d is before --start %d", end, start)
+	}
+	if bundleSize == 0 || batchSize <= 0 {
+		return fmt.Errorf("--bundle-size and --batch-size must be positive")
+	}
+	if len(endpoints) == 0 {
+		return fmt.Errorf("at least one --endpoints must be provided")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	checkpoint, err := loadBackfillCheckpoint(dir, start, bundleSize)
+	if err != nil {
+		return err
+	}
+	if checkpoint.LastWritten >= end {
+		fmt.Printf("Ledgers %d to %d already written to %s\n", start, end, dir)
+		return nil
+	}
+
+	logger, _ := zap.NewDevelopment()
+
+	clients := make([]*rpc.Client, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		client, err := rpc.NewClient(endpoint, logger)
+		if err != nil {
+			return fmt.Errorf("failed to create client for endpoint %s: %w", endpoint, err)
+		}
+		clients = append(clients, client)
+	}
+
+	fetcher := rpc.NewFetcherWithWorkerPool(0, time.Second, sflags.MustGetInt(cmd, "worker-pool-size"), logger)
+	defer fetcher.Close()
+
+	// The checkpoint is written after each file, so an interrupted backfill loses at most one bundle
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	from := backfillResumeFrom(start, checkpoint.LastWritten, bundleSize)
+	if from > start {
+		fmt.Printf("Resuming at ledger %d, last written %d\n", from, checkpoint.LastWritten)
+	}
+
+	var bundle []*pbbstream.Block
+	for num := from; num <= end; {
+		batch := make([]uint64, 0, batchSize)
+		for ; num <= end && len(batch) < batchSize; num++ {
+			batch = append(batch, num)
+		}
+
+		blocks, err := fetchBackfillBatch(ctx, fetcher, clients, batch)
+		if err != nil {
+			return err
+		}
+
+		for _, block := range blocks {
+			bundle = append(bundle, block)
+
+			// A bundle is complete at the end of its aligned range, or of the requested range
+			if (block.Number+1)%bundleSize != 0 && block.Number != end {
+				continue
+			}
+
+			if err := writeBackfillBundle(dir, bundle); err != nil {
+				return err
+			}
+
+			checkpoint.LastWritten = block.Number
+			if err := saveBackfillCheckpoint(dir, checkpoint); err != nil {
+				return err
+			}
+			bundle = bundle[:0]
+		}
+	}
+
+	summary := fetcher.Stats()
+	fmt.Printf("Wrote ledgers %d to %d to %s (%d transactions, %d decode failures)\n",
+		from, end, dir, summary.Transactions, summary.DecodeFailures)
+	return nil
+}
+
+// backfillResumeFrom returns the first ledger to fetch after lastWritten. A previous run with an
+// earlier --end can have stopped mid-bundle: that bundle is fetched again from its first ledger and
+// rewritten whole, so the files stay aligned on the bundle size
+func backfillResumeFrom(start, lastWritten, bundleSize uint64) uint64 {
+	if lastWritten < start {
+		return start
+	}
+	if (lastWritten+1)%bundleSize == 0 {
+		return lastWritten + 1
+	}
+	return max(start, lastWritten-lastWritten%bundleSize)
+}
+
+// fetchBackfillBatch fetches a batch of ledgers, trying each endpoint in turn until one succeeds
+func fetchBackfillBatch(ctx context.Context, fetcher *rpc.Fetcher, clients []*rpc.Client, batch []uint64) ([]*pbbstream.Block, error) {
+	var errs []error
+	for _, client := range clients {
+		blocks, err := fetcher.FetchBatch(ctx, client, batch)
+		if err == nil {
+			return blocks, nil
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("interrupted, resume by running the command again: %w", ctx.Err())
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", client.Endpoint(), err))
+	}
+
+	return nil, fmt.Errorf("fetching ledgers %d to %d: %w", batch[0], batch[len(batch)-1], errors.Join(errs...))
+}
+
+// writeBackfillBundle writes blocks to a .dbin file named after the first ledger, through a temporary
+// file so an interrupted write never leaves a truncated bundle behind
+func writeBackfillBundle(dir string, blocks []*pbbstream.Block) (err error) {
+	path := filepath.Join(dir, fmt.Sprintf("%010d.dbin", blocks[0].Number))
+
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return fmt.Errorf("creating bundle file: %w", err)
+	}
+	defer func() {
+		// Closed and checked below on success, only a failed write gets here with it open
+		if closeErr := file.Close(); closeErr != nil && !errors.Is(closeErr, os.ErrClosed) {
+			err = errors.Join(err, fmt.Errorf("closing bundle file: %w", closeErr))
+		}
+	}()
+
+	writer, err := bstream.NewDBinBlockWriter(file)
+	if err != nil {
+		return fmt.Errorf("creating block writer: %w", err)
+	}
+
+	for _, block := range blocks {
+		if err := writer.Write(block); err != nil {
+			return fmt.Errorf("writing block %d: %w", block.Number, err)
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("closing bundle file: %w", err)
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// loadBackfillCheckpoint reads the checkpoint of dir, or starts a new one when there is none
+func loadBackfillCheckpoint(dir string, start, bundleSize uint64) (*backfillCheckpoint, error) {
+	data, err := os.ReadFile(filepath.Join(dir, backfillCheckpointFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return &backfillCheckpoint{Start: start, BundleSize: bundleSize}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading checkpoint: %w", err)
+	}
+
+	checkpoint := &backfillCheckpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("parsing checkpoint: %w", err)
+	}
+
+	if checkpoint.Start != start || checkpoint.BundleSize != bundleSize {
+		return nil, fmt.Errorf("%s holds a backfill from ledger %d with bundle size %d, use another --dir for start %d and bundle size %d",
+			dir, checkpoint.Start, checkpoint.BundleSize, start, bundleSize)
+	}
+
+	return checkpoint, nil
+}
+
+// saveBackfillCheckpoint atomically replaces the checkpoint of dir
+func saveBackfillCheckpoint(dir string, checkpoint *backfillCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("encoding checkpoint: %w", err)
+	}
+
+	path := filepath.Join(dir, backfillCheckpointFile)
+	if err := os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+
+	return os.Rename(path+".tmp", path)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackfillResumeFrom(t *testing.T) {
+	tests := []struct {
+		name        string
+		start       uint64
+		lastWritten uint64
+		bundleSize  uint64
+		want        uint64
+	}{
+		{"nothing written", 1000, 0, 100, 1000},
+		{"one ledger per file", 1000, 1041, 1, 1042},
+		{"bundle complete", 1000, 1099, 100, 1100},
+		{"ended mid-bundle", 1000, 1149, 100, 1100},
+		{"ended mid first bundle of an unaligned start", 1050, 1070, 100, 1050},
+		{"first bundle of an unaligned start complete", 1050, 1099, 100, 1100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, backfillResumeFrom(tt.start, tt.lastWritten, tt.bundleSize))
+		})
+	}
+}
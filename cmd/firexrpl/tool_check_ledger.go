@@ -2,14 +2,21 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/cli/sflags"
 	"github.com/xrpl-commons/firehose-xrpl/decoder"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
 	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"github.com/xrpl-commons/firehose-xrpl/types"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func NewToolCheckLedgerCmd() *cobra.Command {
@@ -28,6 +35,15 @@ Examples:
 
   # Use testnet
   firexrpl tool-check-ledger --endpoint https://s.altnet.rippletest.net:51234/
+
+  # Verify the ledger links to its parent's hash
+  firexrpl tool-check-ledger --ledger 32570 --verify-parent
+
+  # Only show Payment and OfferCreate transactions with their decoded details
+  firexrpl tool-check-ledger --ledger 32570 --only-type Payment --only-type OfferCreate
+
+  # Show rippled's own JSON rendering of each transaction next to the binary decoding
+  firexrpl tool-check-ledger --ledger 32570 --decode-transactions --json
 `,
 		RunE: runToolCheckLedger,
 	}
@@ -36,6 +52,9 @@ Examples:
 	cmd.Flags().Uint64("ledger", 0, "Specific ledger index to fetch (0 = latest)")
 	cmd.Flags().Bool("decode-transactions", false, "Decode and display transaction details")
 	cmd.Flags().Int("max-transactions", 5, "Maximum number of transactions to display")
+	cmd.Flags().StringArray("only-type", []string{}, "Only display transactions of this type with their decoded details (repeatable)")
+	cmd.Flags().Bool("verify-parent", false, "Fetch the parent ledger header and verify its hash matches the ledger's parent hash")
+	cmd.Flags().Bool("json", false, "Also fetch the ledger with binary=false and display rippled's JSON rendering of each transaction")
 
 	return cmd
 }
@@ -45,8 +64,17 @@ func runToolCheckLedger(cmd *cobra.Command, args []string) error {
 	ledgerIndex := sflags.MustGetUint64(cmd, "ledger")
 	decodeTransactions := sflags.MustGetBool(cmd, "decode-transactions")
 	maxTransactions := sflags.MustGetInt(cmd, "max-transactions")
+	onlyTypes := sflags.MustGetStringArray(cmd, "only-type")
+	verifyParent := sflags.MustGetBool(cmd, "verify-parent")
+	jsonMode := sflags.MustGetBool(cmd, "json")
 
 	logger, _ := zap.NewDevelopment()
+	dec := decoder.NewDecoder(logger)
+
+	typeFilter, err := parseTypeFilter(dec, onlyTypes)
+	if err != nil {
+		return err
+	}
 
 	fmt.Printf("Connecting to XRPL endpoint: %s\n\n", endpoint)
 
@@ -59,6 +87,14 @@ func runToolCheckLedger(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	serverInfo, err := client.GetServerInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get server info: %w", err)
+	}
+	fmt.Printf("Server State:       %s\n", serverInfo.Info.ServerState)
+	fmt.Printf("Build Version:      %s\n", serverInfo.Info.BuildVersion)
+	fmt.Printf("Complete Ledgers:   %s\n\n", serverInfo.Info.CompleteLedgers)
+
 	// Get latest ledger if not specified
 	if ledgerIndex == 0 {
 		fmt.Println("Fetching latest validated ledger...")
@@ -90,18 +126,48 @@ func runToolCheckLedger(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Validated:          %v\n", ledgerResult.Validated)
 	fmt.Printf("Transaction Count:  %d\n", len(ledger.Transactions))
 
-	if len(ledger.Transactions) > 0 {
-		fmt.Printf("\n=== Transactions ===\n")
+	if verifyParent {
+		if err := verifyParentHash(ctx, client, &ledger); err != nil {
+			return err
+		}
+		fmt.Printf("Parent Verified:    ledger %d hash matches\n", ledger.LedgerIndex-1)
+	}
+
+	// rippled's JSON rendering is the reference when the binary decoding looks wrong
+	var jsonTransactions map[string]types.JSONTransaction
+	if jsonMode {
+		ledgerJSON, err := client.GetLedgerJSON(ctx, ledger.LedgerIndex)
+		if err != nil {
+			return fmt.Errorf("failed to get JSON ledger: %w", err)
+		}
+
+		jsonTransactions = make(map[string]types.JSONTransaction, len(ledger.Transactions))
+		for _, tx := range ledgerJSON.Transactions() {
+			jsonTransactions[strings.ToUpper(tx.Hash)] = tx
+		}
+	}
 
-		dec := decoder.NewDecoder(logger)
+	// Select the transactions to display, decoding their type only when filtering
+	indices := make([]int, 0, len(ledger.Transactions))
+	for i, tx := range ledger.Transactions {
+		if len(typeFilter) == 0 || typeFilter[dec.GetTransactionTypeFromHex(tx.TxBlob)] {
+			indices = append(indices, i)
+		}
+	}
+	if len(typeFilter) > 0 {
+		fmt.Printf("Matching Transactions: %d\n", len(indices))
+	}
 
-		displayed := 0
-		for i, tx := range ledger.Transactions {
+	if len(indices) > 0 {
+		fmt.Printf("\n=== Transactions ===\n")
+
+		for displayed, i := range indices {
 			if displayed >= maxTransactions {
-				fmt.Printf("\n... and %d more transactions\n", len(ledger.Transactions)-displayed)
+				fmt.Printf("\n... and %d more transactions\n", len(indices)-displayed)
 				break
 			}
 
+			tx := ledger.Transactions[i]
 			fmt.Printf("\n--- Transaction %d ---\n", i)
 			fmt.Printf("Hash: %s\n", tx.Hash)
 
@@ -112,8 +178,8 @@ func runToolCheckLedger(cmd *cobra.Command, args []string) error {
 				fmt.Printf("Meta length: %d bytes\n", len(tx.Meta)/2)
 			}
 
-			// Decode if requested
-			if decodeTransactions && tx.TxBlob != "" {
+			// Decode if requested (always when filtering by type)
+			if (decodeTransactions || len(typeFilter) > 0) && tx.TxBlob != "" {
 				decoded, err := dec.DecodeTransactionFromHex(tx.TxBlob)
 				if err != nil {
 					fmt.Printf("Failed to decode: %v\n", err)
@@ -130,8 +196,8 @@ func runToolCheckLedger(cmd *cobra.Command, args []string) error {
 					if feeStr, ok := decoded["Fee"].(string); ok {
 						fmt.Printf("Fee:     %s drops\n", feeStr)
 					}
-					if seq, ok := decoded["Sequence"].(float64); ok {
-						fmt.Printf("Sequence: %d\n", uint32(seq))
+					if seq, ok := decoded["Sequence"].(uint32); ok {
+						fmt.Printf("Sequence: %d\n", seq)
 					}
 				}
 
@@ -150,10 +216,114 @@ func runToolCheckLedger(cmd *cobra.Command, args []string) error {
 				}
 			}
 
-			displayed++
+			// Type-specific details are shown when investigating specific types
+			if len(typeFilter) > 0 {
+				printTransactionDetails(dec, tx.Hash, tx.TxBlob, tx.Meta, uint32(i))
+			}
+
+			if jsonMode {
+				printRippledJSON(jsonTransactions, tx.Hash)
+			}
 		}
 	}
 
 	fmt.Printf("\nCheck completed successfully!\n")
 	return nil
 }
+
+// verifyParentHash fetches the parent ledger header and checks that its hash matches the child's parent hash
+func verifyParentHash(ctx context.Context, client *rpc.Client, ledger *types.Ledger) error {
+	if ledger.LedgerIndex == 0 {
+		return fmt.Errorf("ledger 0 has no parent to verify")
+	}
+
+	parent, err := client.GetLedgerHeader(ctx, ledger.LedgerIndex-1)
+	if err != nil {
+		return fmt.Errorf("failed to get parent ledger header: %w", err)
+	}
+
+	if !strings.EqualFold(parent.LedgerHash, ledger.ParentHash) {
+		return fmt.Errorf("parent hash mismatch for ledger %d: expected %s, parent ledger %d has hash %s",
+			ledger.LedgerIndex, ledger.ParentHash, parent.LedgerIndex, parent.LedgerHash)
+	}
+
+	return nil
+}
+
+// parseTypeFilter validates --only-type values against the transaction types the decoder knows
+func parseTypeFilter(dec *decoder.Decoder, onlyTypes []string) (map[string]bool, error) {
+	if len(onlyTypes) == 0 {
+		return nil, nil
+	}
+
+	supported := dec.SupportedTransactionTypes()
+	known := make(map[string]bool, len(supported))
+	for _, txType := range supported {
+		known[txType] = true
+	}
+
+	filter := make(map[string]bool, len(onlyTypes))
+	for _, txType := range onlyTypes {
+		if !known[txType] {
+			return nil, fmt.Errorf("unknown transaction type %q, valid types are: %s", txType, strings.Join(supported, ", "))
+		}
+		filter[txType] = true
+	}
+
+	return filter, nil
+}
+
+// printTransactionDetails maps a transaction to protobuf and prints its type-specific details
+func printTransactionDetails(dec *decoder.Decoder, txHashHex, txBlobHex, metaHex string, txIndex uint32) {
+	txHash, err := hex.DecodeString(txHashHex)
+	if err != nil {
+		fmt.Printf("Failed to decode hash: %v\n", err)
+		return
+	}
+
+	protoTx, err := dec.MapTransactionToProto(txBlobHex, metaHex, txHash, txIndex)
+	if err != nil {
+		fmt.Printf("Failed to map transaction: %v\n", err)
+		return
+	}
+
+	details := transactionDetails(protoTx)
+	if details == nil {
+		fmt.Printf("Details: <none>\n")
+		return
+	}
+
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(details)
+	if err != nil {
+		fmt.Printf("Failed to format details: %v\n", err)
+		return
+	}
+	fmt.Printf("Details: %s\n", out)
+}
+
+// printRippledJSON prints the transaction and metadata as rendered by rippled with binary=false
+func printRippledJSON(jsonTransactions map[string]types.JSONTransaction, txHash string) {
+	tx, ok := jsonTransactions[strings.ToUpper(txHash)]
+	if !ok {
+		fmt.Printf("Rippled JSON: <missing from the JSON ledger>\n")
+		return
+	}
+
+	out, err := json.MarshalIndent(map[string]any{"tx": tx.Tx, "meta": tx.Meta}, "", "  ")
+	if err != nil {
+		fmt.Printf("Failed to format rippled JSON: %v\n", err)
+		return
+	}
+	fmt.Printf("Rippled JSON: %s\n", out)
+}
+
+// transactionDetails returns the message set in the tx_details oneof, or nil when unset
+func transactionDetails(tx *pbxrpl.Transaction) proto.Message {
+	msg := tx.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("tx_details"))
+	if field == nil {
+		return nil
+	}
+
+	return msg.Get(field).Message().Interface()
+}
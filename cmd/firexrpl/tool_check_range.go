@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+)
+
+func NewToolCheckRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-check-range",
+		Short: "Fetch and decode a ledger range to validate decoding before a backfill",
+		Long: `Fetches every ledger of a range through the fetcher, decoding all transactions
+without writing blocks, and reports transaction counts per type, decode failures
+and transactions whose type has no detail mapping (only raw fields in unknown_details).
+
+The command fails when a transaction cannot be decoded, and with --fail-on-unmapped
+when a transaction type has no detail mapping.
+
+Example:
+  firexrpl tool-check-range --endpoint https://s1.ripple.com:51234/ --start 90000000 --end 90000100
+`,
+		RunE: runToolCheckRange,
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().Uint64("start", 0, "First ledger index of the range (required)")
+	cmd.Flags().Uint64("end", 0, "Last ledger index of the range, inclusive (required)")
+	cmd.Flags().Bool("fail-on-unmapped", false, "Fail when a transaction type has no detail mapping")
+	cmd.Flags().Duration("max-block-fetch-duration", 30*time.Second, "Maximum duration for fetching a single ledger")
+	cmd.Flags().Int("worker-pool-size", 10, "Number of concurrent workers for processing transactions within a block")
+
+	return cmd
+}
+
+func runToolCheckRange(cmd *cobra.Command, args []string) error {
+	endpoint := sflags.MustGetString(cmd, "endpoint")
+	start := sflags.MustGetUint64(cmd, "start")
+	end := sflags.MustGetUint64(cmd, "end")
+	failOnUnmapped := sflags.MustGetBool(cmd, "fail-on-unmapped")
+	maxBlockFetchDuration := sflags.MustGetDuration(cmd, "max-block-fetch-duration")
+
+	if start == 0 || end == 0 {
+		return fmt.Errorf("--start and --end are required")
+	}
+	if end < start {
+		return fmt.Errorf("--end %d is before --start %d", end, start)
+	}
+
+	logger, _ := zap.NewDevelopment()
+
+	client, err := rpc.NewClient(endpoint, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	fetcher := rpc.NewFetcherWithWorkerPool(0, time.Second, sflags.MustGetInt(cmd, "worker-pool-size"), logger)
+	defer fetcher.Close()
+
+	// Transactions decoded without a detail mapping, by type
+	unmapped := make(map[string]uint64)
+
+	for ledgerIndex := start; ledgerIndex <= end; ledgerIndex++ {
+		ctx, cancel := context.WithTimeout(cmd.Context(), maxBlockFetchDuration)
+		block, err := fetcher.FetchLedgerBlock(ctx, client, ledgerIndex)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("ledger %d: %w", ledgerIndex, err)
+		}
+
+		countUnmapped(unmapped, block.Transactions)
+	}
+
+	summary := fetcher.Stats()
+
+	fmt.Printf("=== Ledgers %d to %d ===\n", start, end)
+	fmt.Printf("Ledgers:         %d\n", summary.Ledgers)
+	fmt.Printf("Transactions:    %d\n", summary.Transactions)
+	fmt.Printf("Decode failures: %d\n", summary.DecodeFailures)
+	fmt.Printf("Decode warnings: %d\n", summary.DecodeWarnings)
+	fmt.Printf("Codec outdated:  %d\n", summary.CodecOutdated)
+
+	fmt.Printf("\n=== Transactions by type ===\n")
+	for _, txType := range rpc.SortedKeys(summary.ByType) {
+		fmt.Printf("%-34s %d\n", txType, summary.ByType[txType])
+	}
+
+	var unmappedTotal uint64
+	if len(unmapped) > 0 {
+		fmt.Printf("\n=== Unmapped transaction types (unknown_details) ===\n")
+		for _, txType := range rpc.SortedKeys(unmapped) {
+			fmt.Printf("%-34s %d\n", txType, unmapped[txType])
+			unmappedTotal += unmapped[txType]
+		}
+	}
+
+	if summary.DecodeFailures > 0 {
+		return fmt.Errorf("%d transactions failed to decode, see the logged warnings", summary.DecodeFailures)
+	}
+	if failOnUnmapped && unmappedTotal > 0 {
+		return fmt.Errorf("%d transactions of %d types have no detail mapping", unmappedTotal, len(unmapped))
+	}
+
+	fmt.Printf("\nLedgers %d to %d decoded cleanly\n", start, end)
+	return nil
+}
+
+// countUnmapped adds the transactions whose type has no detail mapping to unmapped, by type
+func countUnmapped(unmapped map[string]uint64, transactions []*pbxrpl.Transaction) {
+	for _, tx := range transactions {
+		if rpc.IsUnmapped(tx) {
+			unmapped[tx.TxType]++
+		}
+	}
+}
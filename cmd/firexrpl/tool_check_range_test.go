@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/decoder"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+)
+
+func TestCountUnmapped(t *testing.T) {
+	mapper := decoder.NewMapper(zap.NewNop())
+
+	var transactions []*pbxrpl.Transaction
+	for _, flat := range []map[string]interface{}{
+		{"TransactionType": "SyntheticUnknownTx", "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Fee": "12"},
+		{"TransactionType": "SyntheticUnknownTx", "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Fee": "12"},
+		{"TransactionType": "AccountSet", "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Fee": "12"},
+	} {
+		tx, err := mapper.MapTransactionToProto(flat, nil, nil, nil, nil, 0, "tesSUCCESS")
+		require.NoError(t, err)
+		transactions = append(transactions, tx)
+	}
+
+	unmapped := make(map[string]uint64)
+	countUnmapped(unmapped, transactions)
+
+	assert.Equal(t, map[string]uint64{"SyntheticUnknownTx": 2}, unmapped)
+}
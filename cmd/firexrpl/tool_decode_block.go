@@ -1,14 +1,21 @@
 package main
 
 import (
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
 	"github.com/streamingfast/cli/sflags"
 	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func NewToolDecodeBlockCmd() *cobra.Command {
@@ -16,10 +23,17 @@ func NewToolDecodeBlockCmd() *cobra.Command {
 		Use:   "tool-decode-block <block-file>",
 		Short: "Decode and display an XRPL block from a .dbin file",
 		Long: `Reads a Firehose block file (.dbin) and decodes the XRPL block,
-displaying its contents in a human-readable format.
+displaying its contents in a human-readable format. Files holding a bare
+marshaled XRPL block are accepted too.
+
+With --output json the whole block is printed as JSON, including transaction
+details and state changes, with proto field names and keys in sorted order.
+Bytes fields (hashes, blobs) are rendered as hex rather than protojson's
+base64, and 64-bit integers as strings.
 
 Example:
   firexrpl tool-decode-block /data/blocks/32570.dbin
+  firexrpl tool-decode-block /data/blocks/32570.dbin --output json | jq '.transactions[].tx_type'
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runToolDecodeBlock,
@@ -27,6 +41,7 @@ Example:
 
 	cmd.Flags().Bool("show-transactions", true, "Show transaction details")
 	cmd.Flags().Bool("show-raw", false, "Show raw hex blobs")
+	cmd.Flags().String("output", "text", "Output format: text or json")
 
 	return cmd
 }
@@ -35,6 +50,10 @@ func runToolDecodeBlock(cmd *cobra.Command, args []string) error {
 	blockFile := args[0]
 	showTransactions := sflags.MustGetBool(cmd, "show-transactions")
 	showRaw := sflags.MustGetBool(cmd, "show-raw")
+	output := sflags.MustGetString(cmd, "output")
+	if output != "text" && output != "json" {
+		return fmt.Errorf("invalid --output %q, must be text or json", output)
+	}
 
 	// Read the block file
 	data, err := os.ReadFile(blockFile)
@@ -43,9 +62,18 @@ func runToolDecodeBlock(cmd *cobra.Command, args []string) error {
 	}
 
 	// Decode the block
-	block := &pbxrpl.Block{}
-	if err := proto.Unmarshal(data, block); err != nil {
-		return fmt.Errorf("unmarshaling block: %w", err)
+	block, err := decodeBlockFile(data)
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		out, err := protoToJSON(block)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
 	}
 
 	// Display block info
@@ -59,11 +87,14 @@ func runToolDecodeBlock(cmd *cobra.Command, args []string) error {
 	if block.Header != nil {
 		fmt.Printf("\n=== Header ===\n")
 		fmt.Printf("Parent Hash:          %s\n", hex.EncodeToString(block.Header.ParentHash))
-		fmt.Printf("Total Drops:          %d\n", block.Header.TotalDrops)
+		fmt.Printf("Total Drops:          %d (%s XRP)\n", block.Header.TotalDrops, utils.DropsToXRPString(uint64(block.Header.TotalDrops)))
 		fmt.Printf("Account Hash:         %s\n", hex.EncodeToString(block.Header.AccountHash))
 		fmt.Printf("Transaction Hash:     %s\n", hex.EncodeToString(block.Header.TransactionHash))
 		fmt.Printf("Close Time Resolution: %d\n", block.Header.CloseTimeResolution)
 		fmt.Printf("Close Flags:          %d\n", block.Header.CloseFlags)
+		fmt.Printf("Base Fee:             %d drops (%s XRP)\n", block.Header.BaseFee, utils.DropsToXRPString(block.Header.BaseFee))
+		fmt.Printf("Reserve Base:         %d drops (%s XRP)\n", block.Header.ReserveBase, utils.DropsToXRPString(block.Header.ReserveBase))
+		fmt.Printf("Reserve Increment:    %d drops (%s XRP)\n", block.Header.ReserveIncrement, utils.DropsToXRPString(block.Header.ReserveIncrement))
 	}
 
 	if showTransactions && len(block.Transactions) > 0 {
@@ -75,7 +106,7 @@ func runToolDecodeBlock(cmd *cobra.Command, args []string) error {
 			fmt.Printf("Type:     %s\n", tx.TxType)
 			fmt.Printf("Result:   %s\n", tx.Result)
 			fmt.Printf("Account:  %s\n", tx.Account)
-			fmt.Printf("Fee:      %d drops\n", tx.Fee)
+			fmt.Printf("Fee:      %d drops (%s XRP)\n", tx.Fee, utils.DropsToXRPString(tx.Fee))
 			fmt.Printf("Sequence: %d\n", tx.Sequence)
 
 			if showRaw {
@@ -87,3 +118,87 @@ func runToolDecodeBlock(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// decodeBlockFile decodes the first block of a dbin file (as written by tool-fetch-block), or a bare
+// marshaled XRPL block
+func decodeBlockFile(data []byte) (*pbxrpl.Block, error) {
+	payload := data
+	if bytes.HasPrefix(data, []byte("dbin")) {
+		reader, err := bstream.NewDBinBlockReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("reading dbin file: %w", err)
+		}
+
+		bstreamBlock, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("reading dbin block: %w", err)
+		}
+		payload = bstreamBlock.Payload.GetValue()
+	}
+
+	block := &pbxrpl.Block{}
+	if err := proto.Unmarshal(payload, block); err != nil {
+		return nil, fmt.Errorf("unmarshaling block: %w", err)
+	}
+
+	return block, nil
+}
+
+// protoToJSON renders a message as indented JSON with sorted keys and bytes fields as hex
+func protoToJSON(msg proto.Message) ([]byte, error) {
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling to JSON: %w", err)
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("reading JSON: %w", err)
+	}
+	bytesToHex(msg.ProtoReflect(), generic)
+
+	// encoding/json sorts map keys, making the output deterministic
+	return json.MarshalIndent(generic, "", "  ")
+}
+
+// bytesToHex replaces the base64 protojson gives bytes fields with hex, walking msg alongside its JSON form
+func bytesToHex(msg protoreflect.Message, generic map[string]interface{}) {
+	// Well-known types (Timestamp, Struct) have their own JSON form and no bytes fields
+	if strings.HasPrefix(string(msg.Descriptor().FullName()), "google.protobuf.") {
+		return
+	}
+
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := string(field.Name())
+		if field.IsMap() {
+			return true
+		}
+
+		switch field.Kind() {
+		case protoreflect.BytesKind:
+			if field.IsList() {
+				list := value.List()
+				items := make([]interface{}, list.Len())
+				for i := range items {
+					items[i] = hex.EncodeToString(list.Get(i).Bytes())
+				}
+				generic[name] = items
+			} else {
+				generic[name] = hex.EncodeToString(value.Bytes())
+			}
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			if field.IsList() {
+				items, _ := generic[name].([]interface{})
+				list := value.List()
+				for i := 0; i < list.Len() && i < len(items); i++ {
+					if item, ok := items[i].(map[string]interface{}); ok {
+						bytesToHex(list.Get(i).Message(), item)
+					}
+				}
+			} else if nested, ok := generic[name].(map[string]interface{}); ok {
+				bytesToHex(value.Message(), nested)
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestProtoToJSON(t *testing.T) {
+	newFields, err := structpb.NewStruct(map[string]interface{}{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"})
+	require.NoError(t, err)
+
+	block := &pbxrpl.Block{
+		Number: 90000001,
+		Hash:   []byte{0xCD, 0xCD},
+		Header: &pbxrpl.Header{ParentHash: []byte{0xAB, 0xAB}, TotalDrops: 99999999999},
+		Transactions: []*pbxrpl.Transaction{{
+			Hash:   []byte{0xD7, 0x39},
+			TxBlob: []byte{0x12, 0x00},
+			TxType: "Batch",
+			TxDetails: &pbxrpl.Transaction_Batch{Batch: &pbxrpl.Batch{
+				RawTransactions: []*pbxrpl.RawTransaction{{RawTransaction: []byte{0x01, 0xFF}}},
+			}},
+			StateChanges: []*pbxrpl.StateChange{{
+				Key:       []byte{0x0A, 0x0B},
+				NewFields: newFields,
+			}},
+		}},
+	}
+
+	out, err := protoToJSON(block)
+	require.NoError(t, err)
+
+	again, err := protoToJSON(block)
+	require.NoError(t, err)
+	assert.Equal(t, string(out), string(again), "output must be deterministic")
+
+	var generic map[string]interface{}
+	require.NoError(t, json.Unmarshal(out, &generic))
+	tx := generic["transactions"].([]interface{})[0].(map[string]interface{})
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"block hash", generic["hash"], "cdcd"},
+		{"header bytes", generic["header"].(map[string]interface{})["parent_hash"], "abab"},
+		{"64-bit integer", generic["header"].(map[string]interface{})["total_drops"], "99999999999"},
+		{"transaction hash", tx["hash"], "d739"},
+		{"transaction blob", tx["tx_blob"], "1200"},
+		{"bytes inside details", tx["batch"].(map[string]interface{})["raw_transactions"].([]interface{})[0].(map[string]interface{})["raw_transaction"], "01ff"},
+		{"state change key", tx["state_changes"].([]interface{})[0].(map[string]interface{})["key"], "0a0b"},
+		{"struct left as is", tx["state_changes"].([]interface{})[0].(map[string]interface{})["new_fields"], map[string]interface{}{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+func NewToolFetchBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-fetch-block <ledger-index>",
+		Short: "Fetch a single XRPL ledger and write it as a Firehose block to a .dbin file",
+		Long: `Fetches one ledger through the same path as the poller and writes the
+resulting Firehose block in the dbin format, ready for tool-decode-block.
+Use --output - to write the block to stdout, and --verify-parent to check the
+ledger's parent hash against the parent ledger header before writing it.
+
+Example:
+  firexrpl tool-fetch-block 32570 --endpoint https://s1.ripple.com:51234/ --output 32570.dbin
+  firexrpl tool-fetch-block 32570 --output - > 32570.dbin
+  firexrpl tool-fetch-block 32570 --verify-parent
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runToolFetchBlock,
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().String("output", "", "Path of the .dbin file to write, - for stdout (default <ledger-index>.dbin)")
+	cmd.Flags().Bool("verify-parent", false, "Fetch the parent ledger header and verify its hash matches the ledger's parent hash before writing the block")
+	cmd.Flags().Duration("timeout", 30*time.Second, "Maximum duration for fetching the ledger")
+	cmd.Flags().Int("worker-pool-size", 10, "Number of concurrent workers for processing transactions within a block")
+
+	return cmd
+}
+
+func runToolFetchBlock(cmd *cobra.Command, args []string) error {
+	ledgerIndex, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse ledger index %q: %w", args[0], err)
+	}
+
+	endpoint := sflags.MustGetString(cmd, "endpoint")
+	output := sflags.MustGetString(cmd, "output")
+	if output == "" {
+		output = fmt.Sprintf("%d.dbin", ledgerIndex)
+	}
+
+	// Logs go to stderr, leaving stdout to the block with --output -
+	logger, _ := zap.NewDevelopment()
+
+	client, err := rpc.NewClient(endpoint, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sflags.MustGetDuration(cmd, "timeout"))
+	defer cancel()
+
+	fetcher := rpc.NewFetcherWithWorkerPool(0, time.Second, sflags.MustGetInt(cmd, "worker-pool-size"), logger)
+	defer fetcher.Close()
+
+	block, skipped, err := fetcher.Fetch(ctx, client, ledgerIndex)
+	if err != nil {
+		return fmt.Errorf("fetching ledger %d: %w", ledgerIndex, err)
+	}
+	if skipped {
+		return fmt.Errorf("ledger %d was skipped", ledgerIndex)
+	}
+
+	// A standalone block is never linked to its parent by the stream, check it before writing
+	if sflags.MustGetBool(cmd, "verify-parent") {
+		if err := verifyParentHash(ctx, client, &types.Ledger{LedgerIndex: block.Number, ParentHash: block.ParentId}); err != nil {
+			return err
+		}
+	}
+
+	var out io.Writer = os.Stdout
+	var file *os.File
+	if output != "-" {
+		file, err = os.Create(output)
+		if err != nil {
+			return fmt.Errorf("creating output file: %w", err)
+		}
+		defer func() {
+			// Closed and checked below once the block is written, only a failed write gets here with it open
+			if err := file.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+				logger.Warn("failed to close output file", zap.String("output", output), zap.Error(err))
+			}
+		}()
+		out = file
+	}
+
+	writer, err := bstream.NewDBinBlockWriter(out)
+	if err != nil {
+		return fmt.Errorf("creating block writer: %w", err)
+	}
+
+	if err := writer.Write(block); err != nil {
+		return fmt.Errorf("writing block: %w", err)
+	}
+
+	if file != nil {
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("closing output file: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Wrote ledger %d (%s) to %s\n", block.Number, block.Id, output)
+	}
+
+	return nil
+}
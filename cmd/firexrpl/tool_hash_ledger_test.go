@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+)
+
+func TestHashBlockReproducible(t *testing.T) {
+	server := httptest.NewServer(rpc.NewReplayHandler("../../rpc/testdata/replay", "", zap.NewNop()))
+	defer server.Close()
+
+	client, err := rpc.NewClient(server.URL, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var hashes []string
+	for run := 0; run < 3; run++ {
+		fetcher := rpc.NewFetcherWithWorkerPool(0, time.Millisecond, 4, zap.NewNop())
+		block, err := fetcher.FetchLedgerBlock(ctx, client, 90000001)
+		fetcher.Close()
+		require.NoError(t, err)
+
+		hash, err := hashBlock(block)
+		require.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	assert.Len(t, hashes[0], 64)
+	assert.Equal(t, hashes[0], hashes[1])
+	assert.Equal(t, hashes[0], hashes[2])
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+)
+
+func NewToolPathFindCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-path-find <source> <destination> <amount>",
+		Short: "Show the payment paths rippled would find between two accounts",
+		Long: `Runs ripple_path_find against the latest validated ledger and prints the
+candidate paths, decoded the same way as a Payment's Paths field. Useful to
+understand why a cross-currency payment took a particular path.
+
+The amount is in drops for XRP, or in token units when --currency is set.
+
+Examples:
+  # Paths to deliver 10 XRP
+  firexrpl tool-path-find rSource... rDest... 10000000
+
+  # Paths to deliver 25 USD issued by rIssuer...
+  firexrpl tool-path-find rSource... rDest... 25 --currency USD --issuer rIssuer...
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: runToolPathFind,
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().String("currency", "", "Currency code of the destination amount (empty = XRP)")
+	cmd.Flags().String("issuer", "", "Issuer of the destination amount, required with --currency")
+
+	return cmd
+}
+
+func runToolPathFind(cmd *cobra.Command, args []string) error {
+	src, dst := args[0], args[1]
+	endpoint := sflags.MustGetString(cmd, "endpoint")
+	currency := sflags.MustGetString(cmd, "currency")
+	issuer := sflags.MustGetString(cmd, "issuer")
+
+	if currency != "" && issuer == "" {
+		return fmt.Errorf("--issuer is required with --currency")
+	}
+
+	destAmount := &pbxrpl.Amount{Value: args[2], Currency: currency, Issuer: issuer}
+
+	logger, _ := zap.NewDevelopment()
+
+	client, err := rpc.NewClient(endpoint, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	paths, err := client.FindPaths(ctx, src, dst, destAmount)
+	if err != nil {
+		return fmt.Errorf("failed to find paths: %w", err)
+	}
+
+	fmt.Printf("Paths from %s to %s: %d\n", src, dst, len(paths))
+	for i, path := range paths {
+		fmt.Printf("\n--- Path %d ---\n", i)
+		for _, elem := range path.Elements {
+			fmt.Printf("  account=%s currency=%s issuer=%s\n", elem.Account, elem.Currency, elem.Issuer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+)
+
+func NewToolReplayServerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-replay-server",
+		Short: "Serve recorded JSON-RPC responses so the fetcher can run offline",
+		Long: `Serves the JSON-RPC responses recorded in --dir as a rippled endpoint, so the
+fetcher, tool-fetch-block or tool-backfill can run offline and deterministically
+against recorded ledgers by pointing their endpoint at --listen-addr.
+
+Each request is answered from <key>.json in --dir: ledger_<index>.json for a
+ledger with its transactions, ledger_header_<index>.json for a header only (the
+full ledger is used when absent), ledger_json_<index>.json for a JSON-expanded
+ledger, tx_<hash>.json, ledger_entry_<index>_<ledger>.json,
+ledger_data_<ledger>_<marker>.json (marker "START" for the first page),
+server_info.json and ledger_closed.json. The latest ledger and the complete ledger
+range default to the recorded ledgers. Requests without a recording get the error
+rippled returns for a missing ledger, entry or transaction.
+
+With --record-from, requests are forwarded to that endpoint and the responses
+saved in --dir, replacing any previous recording of the same request.
+
+Example:
+  firexrpl tool-replay-server --dir testdata/ledgers --record-from https://s1.ripple.com:51234/ &
+  firexrpl tool-fetch-block 90000000 --endpoint http://localhost:51234/
+  firexrpl tool-replay-server --dir testdata/ledgers
+`,
+		RunE: runToolReplayServer,
+	}
+
+	cmd.Flags().String("dir", "", "Directory holding the recorded responses (required)")
+	cmd.Flags().String("listen-addr", "localhost:51234", "Address to serve the JSON-RPC endpoint on")
+	cmd.Flags().String("record-from", "", "Record the responses of this XRPL RPC endpoint instead of replaying")
+
+	return cmd
+}
+
+func runToolReplayServer(cmd *cobra.Command, args []string) error {
+	dir := sflags.MustGetString(cmd, "dir")
+	listenAddr := sflags.MustGetString(cmd, "listen-addr")
+	recordFrom := sflags.MustGetString(cmd, "record-from")
+
+	if dir == "" {
+		return fmt.Errorf("--dir is required")
+	}
+	if recordFrom != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("creating recording directory: %w", err)
+		}
+	} else if _, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("reading recording directory: %w", err)
+	}
+
+	logger, _ := zap.NewDevelopment()
+
+	server := &http.Server{
+		Addr:    listenAddr,
+		Handler: rpc.NewReplayHandler(dir, recordFrom, logger),
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if recordFrom != "" {
+		logger.Info("recording responses", zap.String("listen_addr", listenAddr), zap.String("upstream", recordFrom), zap.String("dir", dir))
+	} else {
+		logger.Info("replaying responses", zap.String("listen_addr", listenAddr), zap.String("dir", dir))
+	}
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serving: %w", err)
+	}
+	return nil
+}
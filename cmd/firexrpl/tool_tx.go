@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/xrpl-commons/firehose-xrpl/decoder"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func NewToolTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-tx <tx-hash>",
+		Short: "Fetch a transaction by hash and display it as decoded by the fetcher",
+		Long: `Fetches a single transaction with the tx method, without knowing its ledger,
+decodes its blob and metadata with the same decoder as the fetcher and prints
+the mapped transaction.
+
+With --output json the whole mapped transaction is printed as JSON, in the
+same format as tool-decode-block --output json.
+
+Example:
+  firexrpl tool-tx C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9
+  firexrpl tool-tx C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9 --output json | jq .tx_details
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runToolTx,
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().String("output", "text", "Output format: text or json")
+	cmd.Flags().Duration("timeout", 30*time.Second, "Maximum duration for fetching the transaction")
+
+	return cmd
+}
+
+func runToolTx(cmd *cobra.Command, args []string) error {
+	txHash := args[0]
+	output := sflags.MustGetString(cmd, "output")
+	if output != "text" && output != "json" {
+		return fmt.Errorf("invalid --output %q, must be text or json", output)
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil || len(hash) != 32 {
+		return fmt.Errorf("invalid transaction hash %q, expected 64 hex chars", txHash)
+	}
+
+	logger, _ := zap.NewDevelopment()
+
+	client, err := rpc.NewClient(sflags.MustGetString(cmd, "endpoint"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), sflags.MustGetDuration(cmd, "timeout"))
+	defer cancel()
+
+	result, err := client.GetTransaction(ctx, txHash)
+	if err != nil {
+		return err
+	}
+
+	dec := decoder.NewDecoder(logger)
+	decoded := dec.Decode(result.BinaryTx(), result.BinaryMeta())
+
+	txIndex, _ := decoded.TransactionIndex()
+	protoTx, err := dec.MapDecodedToProto(decoded, hash, txIndex)
+	if err != nil {
+		return fmt.Errorf("mapping transaction: %w", err)
+	}
+	protoTx.LedgerIndex = result.LedgerIndex
+
+	if output == "json" {
+		out, err := protoToJSON(protoTx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	fmt.Printf("=== Transaction %s ===\n", result.Hash)
+	fmt.Printf("Ledger:    %d\n", result.LedgerIndex)
+	fmt.Printf("Validated: %v\n", result.Validated)
+	fmt.Printf("Index:     %d\n", protoTx.Index)
+	fmt.Printf("Type:      %s\n", protoTx.TxType)
+	fmt.Printf("Result:    %s\n", protoTx.Result)
+	fmt.Printf("Account:   %s\n", protoTx.Account)
+	fmt.Printf("Fee:       %d drops\n", protoTx.Fee)
+	fmt.Printf("Sequence:  %d\n", protoTx.Sequence)
+	fmt.Printf("Flags:     %v\n", protoTx.SetFlags)
+	fmt.Printf("State Changes: %d\n", len(protoTx.StateChanges))
+
+	details := transactionDetails(protoTx)
+	if details == nil {
+		fmt.Printf("Details: <none>\n")
+		return nil
+	}
+
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("formatting details: %w", err)
+	}
+	fmt.Printf("Details: %s\n", out)
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/xrpl-commons/firehose-xrpl/decoder"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func NewToolVerifyDecodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool-verify-decode <ledger>",
+		Short: "Compare the binary decoding of a ledger's transactions with rippled's JSON rendering",
+		Long: `Fetches a ledger twice, in binary (the fetcher's path) and expanded to JSON by rippled
+(binary=false), maps both with the same mapper and diffs the resulting transactions field by field.
+
+A difference usually means the binary codec lags behind a field or transaction type added by an
+amendment. The command exits with an error when any transaction differs.
+
+Examples:
+  firexrpl tool-verify-decode 90000000 --endpoint https://s1.ripple.com:51234/
+
+  # Show every difference of each transaction
+  firexrpl tool-verify-decode 90000000 --max-diffs 0
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runToolVerifyDecode,
+	}
+
+	cmd.Flags().String("endpoint", "https://s1.ripple.com:51234/", "XRPL RPC endpoint URL")
+	cmd.Flags().Duration("timeout", time.Minute, "Timeout of the ledger requests")
+	cmd.Flags().Int("max-diffs", 10, "Maximum number of differences displayed per transaction (0 = all)")
+
+	return cmd
+}
+
+func runToolVerifyDecode(cmd *cobra.Command, args []string) error {
+	ledgerIndex, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ledger %q: %w", args[0], err)
+	}
+	maxDiffs := sflags.MustGetInt(cmd, "max-diffs")
+
+	logger, _ := zap.NewDevelopment()
+
+	client, err := rpc.NewClient(sflags.MustGetString(cmd, "endpoint"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), sflags.MustGetDuration(cmd, "timeout"))
+	defer cancel()
+
+	ledgerResult, err := client.GetLedger(ctx, ledgerIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get ledger: %w", err)
+	}
+	ledgerJSON, err := client.GetLedgerJSON(ctx, ledgerIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get JSON ledger: %w", err)
+	}
+
+	jsonTransactions := make(map[string]types.JSONTransaction)
+	for _, tx := range ledgerJSON.Transactions() {
+		jsonTransactions[strings.ToUpper(tx.Hash)] = tx
+	}
+
+	dec := decoder.NewDecoder(logger)
+	transactions := ledgerResult.Ledger.Transactions
+
+	fmt.Printf("Ledger %d: %d binary transactions, %d JSON transactions\n",
+		ledgerIndex, len(transactions), len(jsonTransactions))
+
+	var matched, mismatched int
+	for i, tx := range transactions {
+		diffs, txType := verifyTransactionDecode(dec, tx, uint32(i), jsonTransactions)
+		delete(jsonTransactions, strings.ToUpper(tx.Hash))
+		if len(diffs) == 0 {
+			matched++
+			continue
+		}
+
+		mismatched++
+		fmt.Printf("\n--- %s %s: %d difference(s)\n", txType, tx.Hash, len(diffs))
+		for shown, diff := range diffs {
+			if maxDiffs > 0 && shown >= maxDiffs {
+				fmt.Printf("  ... and %d more\n", len(diffs)-shown)
+				break
+			}
+			fmt.Printf("  %s\n", diff)
+		}
+	}
+
+	// Whatever is left was only in the JSON ledger
+	for hash := range jsonTransactions {
+		mismatched++
+		fmt.Printf("\n--- %s: missing from the binary ledger\n", hash)
+	}
+
+	fmt.Printf("\n%d transaction(s) match, %d differ\n", matched, mismatched)
+	if mismatched > 0 {
+		return fmt.Errorf("%d transaction(s) of ledger %d decode differently in binary and JSON", mismatched, ledgerIndex)
+	}
+
+	return nil
+}
+
+// verifyTransactionDecode maps a transaction from its binary blobs and from rippled's JSON rendering
+// and returns their differences, along with the transaction type for display
+func verifyTransactionDecode(dec *decoder.Decoder, tx types.LedgerTransaction, position uint32, jsonTransactions map[string]types.JSONTransaction) ([]string, string) {
+	txType := dec.GetTransactionTypeFromHex(tx.TxBlob)
+	if txType == "" {
+		txType = "<unknown>"
+	}
+
+	hash, err := hex.DecodeString(tx.Hash)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid hash: %v", err)}, txType
+	}
+
+	decoded := dec.Decode(tx.TxBlob, tx.Meta)
+	txIndex, ok := decoded.TransactionIndex()
+	if !ok {
+		txIndex = position
+	}
+
+	binaryTx, err := dec.MapDecodedToProto(decoded, hash, txIndex)
+	if err != nil {
+		return []string{fmt.Sprintf("binary mapping failed: %v", err)}, txType
+	}
+	if binaryTx.CodecOutdated {
+		return []string{"binary codec outdated: " + errorString(decoded.TxErr, decoded.MetaErr)}, txType
+	}
+
+	jsonTx, ok := jsonTransactions[strings.ToUpper(tx.Hash)]
+	if !ok {
+		return []string{"missing from the JSON ledger"}, txType
+	}
+
+	jsonProto, err := dec.MapJSONToProto(jsonTx.Tx, jsonTx.Meta, hash, txIndex)
+	if err != nil {
+		return []string{fmt.Sprintf("JSON mapping failed: %v", err)}, txType
+	}
+
+	return diffMessages("", binaryTx.ProtoReflect(), jsonProto.ProtoReflect(), nil), txType
+}
+
+// errorString joins the non-nil errors' messages
+func errorString(errs ...error) string {
+	var messages []string
+	for _, err := range errs {
+		if err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ignoredDiffFields are only set from the binary blobs, never from JSON
+var ignoredDiffFields = map[protoreflect.Name]bool{
+	"tx_blob":   true,
+	"meta_blob": true,
+}
+
+// diffMessages appends a "path: binary=... json=..." line for every field that differs
+func diffMessages(path string, binary, json protoreflect.Message, diffs []string) []string {
+	fields := binary.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if ignoredDiffFields[fd.Name()] || (!binary.Has(fd) && !json.Has(fd)) {
+			continue
+		}
+
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+
+		switch {
+		case fd.IsList():
+			diffs = diffLists(fieldPath, fd, binary.Get(fd).List(), json.Get(fd).List(), diffs)
+		case fd.IsMap():
+			diffs = diffMaps(fieldPath, fd, binary.Get(fd).Map(), json.Get(fd).Map(), diffs)
+		default:
+			diffs = diffValues(fieldPath, fd, binary.Get(fd), json.Get(fd), diffs)
+		}
+	}
+
+	return diffs
+}
+
+// diffLists compares list entries pairwise, reporting a length difference once
+func diffLists(path string, fd protoreflect.FieldDescriptor, binary, json protoreflect.List, diffs []string) []string {
+	if binary.Len() != json.Len() {
+		diffs = append(diffs, fmt.Sprintf("%s: binary has %d entries, json has %d", path, binary.Len(), json.Len()))
+	}
+
+	for i := 0; i < min(binary.Len(), json.Len()); i++ {
+		diffs = diffValues(fmt.Sprintf("%s[%d]", path, i), fd, binary.Get(i), json.Get(i), diffs)
+	}
+
+	return diffs
+}
+
+// diffMaps compares map entries by key, in key order
+func diffMaps(path string, fd protoreflect.FieldDescriptor, binary, json protoreflect.Map, diffs []string) []string {
+	keys := make(map[string]protoreflect.MapKey)
+	collect := func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	}
+	binary.Range(collect)
+	json.Range(collect)
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		key := keys[name]
+		entryPath := fmt.Sprintf("%s[%s]", path, name)
+		switch {
+		case !binary.Has(key):
+			diffs = append(diffs, entryPath+": missing from binary")
+		case !json.Has(key):
+			diffs = append(diffs, entryPath+": missing from json")
+		default:
+			diffs = diffValues(entryPath, fd.MapValue(), binary.Get(key), json.Get(key), diffs)
+		}
+	}
+
+	return diffs
+}
+
+// diffValues compares a single (non-list, non-map) value, recursing into messages
+func diffValues(path string, fd protoreflect.FieldDescriptor, binary, json protoreflect.Value, diffs []string) []string {
+	if fd.Message() != nil {
+		return diffMessages(path, binary.Message(), json.Message(), diffs)
+	}
+
+	var equal bool
+	if fd.Kind() == protoreflect.BytesKind {
+		equal = bytes.Equal(binary.Bytes(), json.Bytes())
+	} else {
+		equal = binary.Interface() == json.Interface()
+	}
+	if equal {
+		return diffs
+	}
+
+	return append(diffs, fmt.Sprintf("%s: binary=%s json=%s", path, formatValue(fd, binary), formatValue(fd, json)))
+}
+
+// formatValue renders a scalar value for the diff: bytes as hex, enums by name
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(v.Bytes())
+	case protoreflect.EnumKind:
+		if value := fd.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name())
+		}
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String())
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/decoder"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+func TestDiffMessages(t *testing.T) {
+	base := func() *pbxrpl.Transaction {
+		return &pbxrpl.Transaction{
+			Hash:            []byte{0xab, 0xcd},
+			TxType:          "Payment",
+			TransactionType: pbxrpl.TransactionType_TRANSACTION_TYPE_PAYMENT,
+			Fee:             12,
+			Memos:           []*pbxrpl.Memo{{MemoData: "AA"}},
+			TxDetails: &pbxrpl.Transaction_Payment{Payment: &pbxrpl.Payment{
+				Amount: &pbxrpl.Amount{Value: "1000000", Kind: pbxrpl.AmountKind_AMOUNT_KIND_XRP},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(json *pbxrpl.Transaction)
+		want   []string
+	}{
+		{"identical", func(*pbxrpl.Transaction) {}, nil},
+		{"scalar", func(tx *pbxrpl.Transaction) { tx.Fee = 15 }, []string{"fee: binary=12 json=15"}},
+		{"string", func(tx *pbxrpl.Transaction) { tx.TxType = "Unknown" }, []string{`tx_type: binary="Payment" json="Unknown"`}},
+		{"bytes as hex", func(tx *pbxrpl.Transaction) { tx.Hash = []byte{0xab} }, []string{"hash: binary=abcd json=ab"}},
+		{
+			"enum by name",
+			func(tx *pbxrpl.Transaction) {
+				tx.TransactionType = pbxrpl.TransactionType_TRANSACTION_TYPE_OFFER_CREATE
+			},
+			[]string{"transaction_type: binary=TRANSACTION_TYPE_PAYMENT json=TRANSACTION_TYPE_OFFER_CREATE"},
+		},
+		{
+			"nested message",
+			func(tx *pbxrpl.Transaction) { tx.GetPayment().Amount.Value = "999" },
+			[]string{`payment.amount.value: binary="1000000" json="999"`},
+		},
+		{
+			"list entry",
+			func(tx *pbxrpl.Transaction) { tx.Memos[0].MemoData = "BB" },
+			[]string{`memos[0].memo_data: binary="AA" json="BB"`},
+		},
+		{
+			"list length",
+			func(tx *pbxrpl.Transaction) { tx.Memos = append(tx.Memos, &pbxrpl.Memo{MemoData: "CC"}) },
+			[]string{"memos: binary has 1 entries, json has 2"},
+		},
+		{"blobs ignored", func(tx *pbxrpl.Transaction) { tx.TxBlob = []byte{0x01}; tx.MetaBlob = []byte{0x02} }, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			json := base()
+			tt.modify(json)
+			assert.Equal(t, tt.want, diffMessages("", base().ProtoReflect(), json.ProtoReflect(), nil))
+		})
+	}
+}
+
+// replayedTransaction returns the first transaction of the recorded ledger 90000001
+func replayedTransaction(t *testing.T) types.LedgerTransaction {
+	data, err := os.ReadFile("../../rpc/testdata/replay/ledger_90000001.json")
+	require.NoError(t, err)
+
+	var recorded struct {
+		Result types.LedgerResult `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(data, &recorded))
+	require.NotEmpty(t, recorded.Result.Ledger.Transactions)
+
+	return recorded.Result.Ledger.Transactions[0]
+}
+
+// jsonRendering renders a binary transaction the way rippled expands it, numbers included
+func jsonRendering(t *testing.T, tx types.LedgerTransaction) types.JSONTransaction {
+	render := func(blob string) map[string]any {
+		decoded, err := binarycodec.Decode(blob)
+		require.NoError(t, err)
+		data, err := json.Marshal(decoded)
+		require.NoError(t, err)
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(data, &fields))
+		return fields
+	}
+
+	return types.JSONTransaction{Hash: tx.Hash, Tx: render(tx.TxBlob), Meta: render(tx.Meta)}
+}
+
+func TestVerifyTransactionDecode(t *testing.T) {
+	tx := replayedTransaction(t)
+
+	tests := []struct {
+		name      string
+		modify    func(json *types.JSONTransaction)
+		missing   bool
+		wantDiffs []string
+	}{
+		{"same decoding", func(*types.JSONTransaction) {}, false, nil},
+		{"DeliverMax alias", func(json *types.JSONTransaction) { json.Tx["DeliverMax"] = json.Tx["Amount"] }, false, nil},
+		{"field differs", func(json *types.JSONTransaction) { json.Tx["Fee"] = "15" }, false, []string{"fee: binary=12 json=15"}},
+		{"missing from JSON", func(*types.JSONTransaction) {}, true, []string{"missing from the JSON ledger"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered := jsonRendering(t, tx)
+			tt.modify(&rendered)
+			jsonTransactions := map[string]types.JSONTransaction{}
+			if !tt.missing {
+				jsonTransactions[tx.Hash] = rendered
+			}
+
+			diffs, txType := verifyTransactionDecode(decoder.NewDecoder(zap.NewNop()), tx, 0, jsonTransactions)
+			assert.Equal(t, "Payment", txType)
+			assert.Equal(t, tt.wantDiffs, diffs)
+		})
+	}
+}
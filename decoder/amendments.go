@@ -0,0 +1,124 @@
+package decoder
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"strings"
+)
+
+// knownAmendments lists amendment names; an amendment ID is the SHA-512Half of its name,
+// so the ID-to-name table is derived from this list instead of hardcoding hashes
+// Reference: https://xrpl.org/resources/known-amendments
+var knownAmendments = []string{
+	"AMM",
+	"AMMClawback",
+	"Batch",
+	"CheckCashMakesTrustLine",
+	"Checks",
+	"Clawback",
+	"Credentials",
+	"CryptoConditions",
+	"CryptoConditionsSuite",
+	"DeepFreeze",
+	"DeletableAccounts",
+	"DepositAuth",
+	"DepositPreauth",
+	"DID",
+	"DisallowIncoming",
+	"DynamicNFT",
+	"EnforceInvariants",
+	"Escrow",
+	"ExpandedSignerList",
+	"FeeEscalation",
+	"Flow",
+	"FlowCross",
+	"FlowSortStrands",
+	"HardenedValidations",
+	"ImmediateOfferKilled",
+	"MPTokensV1",
+	"MultiSign",
+	"MultiSignReserve",
+	"NegativeUNL",
+	"NonFungibleTokensV1",
+	"NonFungibleTokensV1_1",
+	"PayChan",
+	"PermissionDelegation",
+	"PermissionedDEX",
+	"PermissionedDomains",
+	"PriceOracle",
+	"RequireFullyCanonicalSig",
+	"SingleAssetVault",
+	"SortedDirectories",
+	"TicketBatch",
+	"TickSize",
+	"TokenEscrow",
+	"TrustSetAuth",
+	"XChainBridge",
+	"XRPFees",
+	"fix1201",
+	"fix1368",
+	"fix1373",
+	"fix1512",
+	"fix1513",
+	"fix1515",
+	"fix1523",
+	"fix1528",
+	"fix1543",
+	"fix1571",
+	"fix1578",
+	"fix1623",
+	"fix1781",
+	"fixAMMOverflowOffer",
+	"fixAMMv1_1",
+	"fixAMMv1_2",
+	"fixAMMv1_3",
+	"fixAmendmentMajorityCalc",
+	"fixCheckThreading",
+	"fixDisallowIncomingV1",
+	"fixEmptyDID",
+	"fixEnforceNFTokenTrustline",
+	"fixFillOrKill",
+	"fixFrozenLPTokenTransfer",
+	"fixInnerObjTemplate",
+	"fixInnerObjTemplate2",
+	"fixInvalidTxFlags",
+	"fixMasterKeyAsRegularKey",
+	"fixNFTokenDirV1",
+	"fixNFTokenNegOffer",
+	"fixNFTokenPageLinks",
+	"fixNFTokenRemint",
+	"fixNFTokenReserve",
+	"fixNonFungibleTokensV1_2",
+	"fixPayChanCancelAfter",
+	"fixPayChanRecipientOwnerDir",
+	"fixPreviousTxnID",
+	"fixQualityUpperBound",
+	"fixReducedOffersV1",
+	"fixReducedOffersV2",
+	"fixRemoveNFTokenAutoTrustLine",
+	"fixRmSmallIncreasedQOffers",
+	"fixSTAmountCanonicalize",
+	"fixTakerDryOfferRemoval",
+	"fixTrustLinesToSelf",
+	"fixUniversalNumber",
+	"fixXChainRewardRounding",
+}
+
+// amendmentNames maps uppercase hex amendment IDs to their names
+var amendmentNames = func() map[string]string {
+	names := make(map[string]string, len(knownAmendments))
+	for _, name := range knownAmendments {
+		sum := sha512.Sum512([]byte(name))
+		names[strings.ToUpper(hex.EncodeToString(sum[:32]))] = name
+	}
+	return names
+}()
+
+// AmendmentName returns the name of an amendment from its hex ID, or the ID itself when unknown
+func AmendmentName(amendmentID string) string {
+	if name, ok := amendmentNames[strings.ToUpper(amendmentID)]; ok {
+		return name
+	}
+
+	return amendmentID
+}
@@ -0,0 +1,36 @@
+package decoder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestAmendmentName(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want string
+	}{
+		{"AMM", "8CC0774A3BF66D1D22E76BBDA8E8A232E6B6313834301B3B23E8601196AE6455", "AMM"},
+		{"lowercase ID", "30cd365592b8ee40489ba01ae2f7555cac9c983145871dc82a42a31cf5bae7d9", "DeletableAccounts"},
+		{"unknown amendment", "0000000000000000000000000000000000000000000000000000000000000001", "0000000000000000000000000000000000000000000000000000000000000001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AmendmentName(tt.id))
+		})
+	}
+}
+
+func TestMapEnableAmendment(t *testing.T) {
+	amend := NewMapper(zap.NewNop()).mapEnableAmendment(map[string]interface{}{
+		"Amendment":      "8CC0774A3BF66D1D22E76BBDA8E8A232E6B6313834301B3B23E8601196AE6455",
+		"LedgerSequence": uint32(90000000),
+	})
+	assert.Equal(t, "8CC0774A3BF66D1D22E76BBDA8E8A232E6B6313834301B3B23E8601196AE6455", amend.Amendment)
+	assert.Equal(t, "AMM", amend.AmendmentName)
+	assert.Equal(t, uint32(90000000), amend.LedgerSequence)
+}
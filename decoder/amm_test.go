@@ -0,0 +1,98 @@
+package decoder
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+)
+
+const ammIssuer = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+
+func TestMapAMMTransactions(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/amm_transactions.json")
+	require.NoError(t, err)
+
+	var blobs map[string]string
+	require.NoError(t, json.Unmarshal(fixture, &blobs))
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	tests := []struct {
+		txType       string
+		wantSetFlags []string
+		check        func(t *testing.T, tx *pbxrpl.Transaction)
+	}{
+		{"AMMCreate", nil, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmCreate()
+			require.NotNil(t, amm)
+			assert.Equal(t, "1000000", amm.Amount.Value)
+			assert.Equal(t, "USD", amm.Amount2.Currency)
+			assert.Equal(t, uint32(500), amm.TradingFee)
+		}},
+		{"AMMDeposit", []string{"tfSingleAsset"}, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmDeposit()
+			require.NotNil(t, amm)
+			assert.Equal(t, "XRP", amm.Asset.Currency)
+			assert.Equal(t, ammIssuer, amm.Asset2.Issuer)
+			assert.Equal(t, "1000000", amm.Amount.Value)
+			assert.Equal(t, uint32(0x00080000), amm.Flags)
+		}},
+		{"AMMWithdraw", []string{"tfLPToken"}, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmWithdraw()
+			require.NotNil(t, amm)
+			assert.Equal(t, "100", amm.LpTokenIn.Value)
+			assert.Equal(t, uint32(0x00010000), amm.Flags)
+		}},
+		{"AMMVote", nil, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmVote()
+			require.NotNil(t, amm)
+			assert.Equal(t, "USD", amm.Asset2.Currency)
+			assert.Equal(t, uint32(600), amm.TradingFee)
+		}},
+		{"AMMBid", nil, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmBid()
+			require.NotNil(t, amm)
+			assert.Equal(t, "10", amm.BidMin.Value)
+			assert.Equal(t, "20", amm.BidMax.Value)
+			require.Len(t, amm.AuthAccounts, 1)
+			assert.Equal(t, ammIssuer, amm.AuthAccounts[0].Account)
+		}},
+		{"AMMDelete", nil, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmDelete()
+			require.NotNil(t, amm)
+			assert.Equal(t, "XRP", amm.Asset.Currency)
+			assert.Equal(t, ammIssuer, amm.Asset2.Issuer)
+		}},
+		{"AMMClawback", []string{"tfClawTwoAssets"}, func(t *testing.T, tx *pbxrpl.Transaction) {
+			amm := tx.GetAmmClawback()
+			require.NotNil(t, amm)
+			assert.Equal(t, ammIssuer, amm.Holder)
+			assert.Equal(t, "5", amm.Amount.Value)
+			assert.Equal(t, uint32(1), amm.Flags)
+		}},
+	}
+
+	require.Len(t, blobs, len(tests))
+
+	for _, tt := range tests {
+		t.Run(tt.txType, func(t *testing.T) {
+			blob, ok := blobs[tt.txType]
+			require.True(t, ok, "missing fixture for %s", tt.txType)
+
+			d := NewDecoder(zap.NewNop())
+			protoTx, err := d.MapTransactionToProto(blob, paymentMetaBlob, hash, 0)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.txType, protoTx.TxType)
+			assert.ElementsMatch(t, tt.wantSetFlags, protoTx.SetFlags)
+			tt.check(t, protoTx)
+		})
+	}
+}
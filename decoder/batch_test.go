@@ -0,0 +1,93 @@
+package decoder
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+)
+
+// The inner Payments of testdata/batch_two_payments.hex, an all-or-nothing Batch
+const (
+	batchInnerPayment1 = "120000224000000024000000066140000000000F424068400000000000000073008114B5F762798A53D543A014CAF8B297CFF8F2F937E88314F667B0CA50CC7709A220B0561B85E53A48461FA8"
+	batchInnerPayment2 = "120000224000000024000000076140000000001E848068400000000000000073008114B5F762798A53D543A014CAF8B297CFF8F2F937E88314F667B0CA50CC7709A220B0561B85E53A48461FA8"
+)
+
+func TestMapBatchInnerTransactions(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/batch_two_payments.hex")
+	require.NoError(t, err)
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	protoTx, err := NewDecoder(zap.NewNop()).MapTransactionToProto(strings.TrimSpace(string(fixture)), paymentMetaBlob, hash, 0)
+	require.NoError(t, err)
+
+	batch := protoTx.GetBatch()
+	require.NotNil(t, batch)
+	require.Len(t, batch.RawTransactions, 2)
+	require.Len(t, protoTx.InnerTransactions, 2)
+
+	tests := []struct {
+		name       string
+		blob       string
+		wantSeq    uint32
+		wantAmount string
+	}{
+		{"first payment", batchInnerPayment1, 6, "1000000"},
+		{"second payment", batchInnerPayment2, 7, "2000000"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := hex.DecodeString(tt.blob)
+			require.NoError(t, err)
+			assert.Equal(t, blob, batch.RawTransactions[i].RawTransaction)
+
+			inner := protoTx.InnerTransactions[i]
+			assert.Equal(t, "Payment", inner.TxType)
+			assert.Equal(t, uint32(i), inner.Index)
+			assert.Equal(t, tt.wantSeq, inner.Sequence)
+			assert.Equal(t, utils.TransactionHash(blob), inner.Hash)
+			assert.Equal(t, blob, inner.TxBlob)
+			assert.Empty(t, inner.Result)
+			assert.Empty(t, inner.StateChanges)
+			require.NotNil(t, inner.GetPayment())
+			assert.Equal(t, tt.wantAmount, inner.GetPayment().Amount.Value)
+		})
+	}
+}
+
+func TestMapBatchSkipsNestedBatch(t *testing.T) {
+	flat := map[string]interface{}{
+		"TransactionType": "Batch",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Fee":             "40",
+		"Flags":           uint32(0x00010000),
+		"Sequence":        uint32(5),
+		"RawTransactions": []interface{}{
+			map[string]interface{}{"RawTransaction": map[string]interface{}{
+				"TransactionType": "Batch",
+				"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":             "0",
+				"Flags":           uint32(0x40010000),
+				"Sequence":        uint32(6),
+				"SigningPubKey":   "",
+			}},
+		},
+	}
+
+	m := NewMapper(zap.NewNop())
+	protoTx, err := m.MapTransactionToProto(flat, nil, nil, nil, nil, 0, "")
+	require.NoError(t, err)
+
+	require.Len(t, protoTx.GetBatch().RawTransactions, 1)
+	assert.NotEmpty(t, protoTx.GetBatch().RawTransactions[0].RawTransaction)
+	assert.Empty(t, protoTx.InnerTransactions)
+	assert.Equal(t, uint64(1), m.WarningCount())
+}
@@ -2,10 +2,14 @@ package decoder
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"maps"
+	"strings"
 	"sync"
 
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	xrpltx "github.com/Peersyst/xrpl-go/xrpl/transaction"
 	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
 	"go.uber.org/zap"
@@ -15,16 +19,29 @@ import (
 type Decoder struct {
 	logger *zap.Logger
 	mapper *Mapper
+
+	// jsonMapper maps rippled's JSON rendering, which differs from the codec's in a few fields
+	jsonMapper *Mapper
+
+	// includeDecodedMeta attaches the decoded metadata map to each transaction
+	includeDecodedMeta bool
 }
 
 // NewDecoder creates a new XRPL decoder
 func NewDecoder(logger *zap.Logger) *Decoder {
 	return &Decoder{
-		logger: logger,
-		mapper: NewMapper(logger),
+		logger:     logger,
+		mapper:     NewMapper(logger),
+		jsonMapper: newJSONMapper(logger),
 	}
 }
 
+// SetIncludeDecodedMeta attaches the decoded metadata to each mapped transaction as a Struct
+// Off by default since it roughly doubles the size of a transaction
+func (d *Decoder) SetIncludeDecodedMeta(enabled bool) {
+	d.includeDecodedMeta = enabled
+}
+
 // DecodeTransactionFromHex decodes a transaction blob (hex string) to a FlatTransaction
 func (d *Decoder) DecodeTransactionFromHex(txBlobHex string) (xrpltx.FlatTransaction, error) {
 	decoded, err := binarycodec.Decode(txBlobHex)
@@ -59,7 +76,12 @@ func (d *Decoder) DecodeMetadataFromBytes(metaBlob []byte) (map[string]interface
 
 // GetTransactionType extracts the transaction type string from a tx blob
 func (d *Decoder) GetTransactionType(txBlob []byte) string {
-	decoded, err := d.DecodeTransactionFromBytes(txBlob)
+	return d.GetTransactionTypeFromHex(hex.EncodeToString(txBlob))
+}
+
+// GetTransactionTypeFromHex extracts the transaction type string from a tx blob (hex string)
+func (d *Decoder) GetTransactionTypeFromHex(txBlobHex string) string {
+	decoded, err := d.DecodeTransactionFromHex(txBlobHex)
 	if err != nil {
 		d.logger.Debug("failed to decode transaction for type extraction", zap.Error(err))
 		return ""
@@ -72,6 +94,21 @@ func (d *Decoder) GetTransactionType(txBlob []byte) string {
 	return ""
 }
 
+// SupportedTransactionTypes returns the sorted list of transaction types with a details mapper
+func (d *Decoder) SupportedTransactionTypes() []string {
+	return d.mapper.SupportedTransactionTypes()
+}
+
+// DecodeStateChanges converts the AffectedNodes of decoded metadata to ledger state changes
+func (d *Decoder) DecodeStateChanges(meta map[string]interface{}) []*pbxrpl.StateChange {
+	return d.mapper.mapStateChanges(meta)
+}
+
+// WarningCount returns the number of decode warnings emitted so far
+func (d *Decoder) WarningCount() uint64 {
+	return d.mapper.WarningCount() + d.jsonMapper.WarningCount()
+}
+
 // GetTransactionResult extracts the result code string from metadata
 func (d *Decoder) GetTransactionResult(metaBlob []byte) string {
 	decoded, err := d.DecodeMetadataFromBytes(metaBlob)
@@ -87,30 +124,79 @@ func (d *Decoder) GetTransactionResult(metaBlob []byte) string {
 	return ""
 }
 
-// MapTransactionToProto converts a decoded FlatTransaction and metadata to protobuf
-// This is the main entry point used by the fetcher
-// Accepts hex strings directly to avoid unnecessary encoding round-trips
-func (d *Decoder) MapTransactionToProto(txBlobHex, metaBlobHex string, txHash []byte, txIndex uint32) (*pbxrpl.Transaction, error) {
-	// Decode the transaction and metadata in parallel
-	var flatTx xrpltx.FlatTransaction
-	var meta map[string]interface{}
-	var txErr, metaErr error
+// DecodedTransaction is a transaction and its metadata decoded once, shared by the type and
+// result accessors and the protobuf mapping so a transaction is never decoded twice
+type DecodedTransaction struct {
+	TxBlobHex   string
+	MetaBlobHex string
+
+	Tx   xrpltx.FlatTransaction
+	Meta map[string]interface{}
+
+	TxErr   error
+	MetaErr error
+}
+
+// Type returns the transaction type, empty when the transaction failed to decode
+func (dt *DecodedTransaction) Type() string {
+	txType, _ := dt.Tx["TransactionType"].(string)
+	return txType
+}
+
+// Result returns the transaction result code, empty when the metadata failed to decode
+func (dt *DecodedTransaction) Result() string {
+	result, _ := dt.Meta["TransactionResult"].(string)
+	return result
+}
+
+// TransactionIndex returns the position the transaction was applied at in its ledger, from the
+// metadata TransactionIndex, false when the metadata failed to decode
+func (dt *DecodedTransaction) TransactionIndex() (uint32, bool) {
+	index, ok := dt.Meta["TransactionIndex"].(uint32)
+	return index, ok
+}
+
+// Decode decodes a transaction blob and its metadata (hex strings) in parallel
+// Decode errors are kept on the result rather than returned, so the part that decoded stays usable
+func (d *Decoder) Decode(txBlobHex, metaBlobHex string) *DecodedTransaction {
+	dt := &DecodedTransaction{TxBlobHex: txBlobHex, MetaBlobHex: metaBlobHex}
 	var wg sync.WaitGroup
 
 	wg.Add(2)
 
 	go func() {
 		defer wg.Done()
-		flatTx, txErr = d.DecodeTransactionFromHex(txBlobHex)
+		dt.Tx, dt.TxErr = d.DecodeTransactionFromHex(txBlobHex)
 	}()
 
 	go func() {
 		defer wg.Done()
-		meta, metaErr = d.DecodeMetadataFromHex(metaBlobHex)
+		dt.Meta, dt.MetaErr = d.DecodeMetadataFromHex(metaBlobHex)
 	}()
 
 	wg.Wait()
 
+	return dt
+}
+
+// MapTransactionToProto converts a transaction and its metadata to protobuf
+// Accepts hex strings directly to avoid unnecessary encoding round-trips
+func (d *Decoder) MapTransactionToProto(txBlobHex, metaBlobHex string, txHash []byte, txIndex uint32) (*pbxrpl.Transaction, error) {
+	return d.MapDecodedToProto(d.Decode(txBlobHex, metaBlobHex), txHash, txIndex)
+}
+
+// MapDecodedToProto converts an already decoded transaction to protobuf
+// This is the main entry point used by the fetcher
+func (d *Decoder) MapDecodedToProto(dt *DecodedTransaction, txHash []byte, txIndex uint32) (*pbxrpl.Transaction, error) {
+	txBlobHex, metaBlobHex := dt.TxBlobHex, dt.MetaBlobHex
+	flatTx, meta := dt.Tx, dt.Meta
+	txErr, metaErr := dt.TxErr, dt.MetaErr
+
+	// A codec lagging the network still emits the raw transaction rather than dropping it
+	if (txErr != nil && IsCodecOutdated(txErr)) || (metaErr != nil && IsCodecOutdated(metaErr)) {
+		return d.mapCodecOutdated(txBlobHex, metaBlobHex, txHash, txIndex, flatTx, meta, errors.Join(txErr, metaErr))
+	}
+
 	// Check for errors
 	if txErr != nil {
 		return nil, fmt.Errorf("decoding transaction: %w", txErr)
@@ -119,10 +205,7 @@ func (d *Decoder) MapTransactionToProto(txBlobHex, metaBlobHex string, txHash []
 		return nil, fmt.Errorf("decoding metadata: %w", metaErr)
 	}
 
-	result := ""
-	if txResult, ok := meta["TransactionResult"].(string); ok {
-		result = txResult
-	}
+	result := dt.Result()
 
 	// Decode hex to bytes for mapper (done once here instead of in fetcher + here)
 	txBlob, err := hex.DecodeString(txBlobHex)
@@ -135,5 +218,89 @@ func (d *Decoder) MapTransactionToProto(txBlobHex, metaBlobHex string, txHash []
 	}
 
 	// Use the mapper to convert to protobuf
-	return d.mapper.MapTransactionToProto(flatTx, txBlob, metaBlob, txHash, txIndex, result)
+	protoTx, err := d.mapper.MapTransactionToProto(flatTx, meta, txBlob, metaBlob, txHash, txIndex, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.includeDecodedMeta {
+		// Reuse the already decoded map instead of having consumers decode meta_blob again
+		decodedMeta, err := newStruct(meta)
+		if err != nil {
+			d.mapper.warn("failed to convert decoded metadata to struct",
+				zap.String("tx_hash", hex.EncodeToString(txHash)),
+				zap.Error(err))
+		} else {
+			protoTx.DecodedMeta = decodedMeta
+		}
+	}
+
+	return protoTx, nil
+}
+
+// MapJSONToProto converts a transaction and its metadata as rendered by rippled with binary=false
+// to protobuf, with a mapper reading the JSON MPT amounts in base 10. tx_blob and meta_blob are left empty
+func (d *Decoder) MapJSONToProto(tx, meta map[string]interface{}, txHash []byte, txIndex uint32) (*pbxrpl.Transaction, error) {
+	// DeliverMax is an alias of a Payment's Amount added by rippled (replacing it in API v2),
+	// never part of the signed transaction
+	if deliverMax, ok := tx["DeliverMax"]; ok {
+		tx = maps.Clone(tx)
+		if _, ok := tx["Amount"]; !ok {
+			tx["Amount"] = deliverMax
+		}
+		delete(tx, "DeliverMax")
+	}
+
+	result, _ := meta["TransactionResult"].(string)
+	return d.jsonMapper.MapTransactionToProto(tx, meta, nil, nil, txHash, txIndex, result)
+}
+
+// IsCodecOutdated reports whether a decode error comes from a field or type the codec doesn't know,
+// as opposed to a corrupt blob
+func IsCodecOutdated(err error) bool {
+	var notFound *definitions.NotFoundError
+	var notFoundInt *definitions.NotFoundErrorInt
+	var notFoundHeader *definitions.NotFoundErrorFieldHeader
+
+	if errors.As(err, &notFound) || errors.As(err, &notFoundInt) || errors.As(err, &notFoundHeader) {
+		return true
+	}
+
+	// STObject reports unknown serialized types without a typed error
+	return strings.Contains(err.Error(), "unknown type")
+}
+
+// mapCodecOutdated builds a minimal transaction carrying the raw blobs when the codec is too old to decode them
+func (d *Decoder) mapCodecOutdated(txBlobHex, metaBlobHex string, txHash []byte, txIndex uint32, flatTx xrpltx.FlatTransaction, meta map[string]interface{}, decodeErr error) (*pbxrpl.Transaction, error) {
+	txBlob, err := hex.DecodeString(txBlobHex)
+	if err != nil {
+		return nil, fmt.Errorf("decoding tx blob hex: %w", err)
+	}
+	metaBlob, err := hex.DecodeString(metaBlobHex)
+	if err != nil {
+		return nil, fmt.Errorf("decoding meta blob hex: %w", err)
+	}
+
+	protoTx := &pbxrpl.Transaction{
+		Hash:          txHash,
+		Index:         txIndex,
+		TxBlob:        txBlob,
+		MetaBlob:      metaBlob,
+		CodecOutdated: true,
+	}
+
+	// Keep whatever part decoded successfully
+	if txType, ok := flatTx["TransactionType"].(string); ok {
+		protoTx.TxType = txType
+	}
+	if result, ok := meta["TransactionResult"].(string); ok {
+		protoTx.Result = result
+	}
+
+	d.mapper.warn("codec_outdated: transaction uses fields unknown to the binary codec, emitting raw blobs only",
+		zap.String("tx_hash", hex.EncodeToString(txHash)),
+		zap.Uint32("tx_index", txIndex),
+		zap.Error(decodeErr))
+
+	return protoTx, nil
 }
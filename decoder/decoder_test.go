@@ -0,0 +1,346 @@
+package decoder
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// The Payment of the rpc replay fixture, as blobs and as rendered by rippled with binary=false
+const (
+	paymentTxBlob   = "120000220000000024000000056140000000000F424068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE8114B5F762798A53D543A014CAF8B297CFF8F2F937E88314F667B0CA50CC7709A220B0561B85E53A48461FA8"
+	paymentMetaBlob = "201C00000000601240000000000F4240F8F1031000"
+	paymentHash     = "D73985B82B093D35E87850995FE01C1540AEA4919FFB4DBB5777032657F02F58"
+)
+
+func paymentJSON() (tx, meta map[string]interface{}) {
+	tx = map[string]interface{}{
+		"TransactionType": "Payment",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"DeliverMax":      "1000000",
+		"Fee":             "12",
+		"Flags":           float64(0),
+		"Sequence":        float64(5),
+		"SigningPubKey":   "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+		"TxnSignature":    "3045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE",
+	}
+	meta = map[string]interface{}{
+		"AffectedNodes":     []interface{}{},
+		"TransactionIndex":  float64(0),
+		"TransactionResult": "tesSUCCESS",
+		"delivered_amount":  "1000000",
+	}
+	return tx, meta
+}
+
+// The binary and JSON paths share the flat-map mapper, the same transaction must map identically
+func TestBinaryAndJSONPathsAgree(t *testing.T) {
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	dec := NewDecoder(zap.NewNop())
+
+	binaryTx, err := dec.MapTransactionToProto(paymentTxBlob, paymentMetaBlob, hash, 0)
+	require.NoError(t, err)
+	assert.NotEmpty(t, binaryTx.TxBlob)
+	assert.NotEmpty(t, binaryTx.MetaBlob)
+
+	tx, meta := paymentJSON()
+	jsonTx, err := dec.MapJSONToProto(tx, meta, hash, 0)
+	require.NoError(t, err)
+
+	// Only the binary path carries the blobs
+	binaryTx.TxBlob, binaryTx.MetaBlob = nil, nil
+	assert.True(t, proto.Equal(binaryTx, jsonTx), "binary: %v\njson: %v", binaryTx, jsonTx)
+
+	assert.Equal(t, "1000000", jsonTx.GetPayment().GetAmount().GetValue())
+	assert.Equal(t, "1000000", jsonTx.GetPayment().GetDeliveredAmount().GetValue())
+	assert.Zero(t, dec.WarningCount())
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name        string
+		txBlob      string
+		metaBlob    string
+		wantType    string
+		wantResult  string
+		wantTxErr   bool
+		wantMetaErr bool
+	}{
+		{"payment", paymentTxBlob, paymentMetaBlob, "Payment", "tesSUCCESS", false, false},
+		{"invalid tx blob", "ZZ", paymentMetaBlob, "", "tesSUCCESS", true, false},
+		{"invalid meta blob", paymentTxBlob, "ZZ", "Payment", "", false, true},
+	}
+
+	dec := NewDecoder(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded := dec.Decode(tt.txBlob, tt.metaBlob)
+			assert.Equal(t, tt.wantType, decoded.Type())
+			assert.Equal(t, tt.wantResult, decoded.Result())
+			assert.Equal(t, tt.wantTxErr, decoded.TxErr != nil)
+			assert.Equal(t, tt.wantMetaErr, decoded.MetaErr != nil)
+		})
+	}
+}
+
+func TestMapDecodedToProtoMatchesMapTransactionToProto(t *testing.T) {
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	dec := NewDecoder(zap.NewNop())
+	want, err := dec.MapTransactionToProto(paymentTxBlob, paymentMetaBlob, hash, 0)
+	require.NoError(t, err)
+
+	got, err := dec.MapDecodedToProto(dec.Decode(paymentTxBlob, paymentMetaBlob), hash, 0)
+	require.NoError(t, err)
+
+	assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+}
+
+// BenchmarkDecodeLedger compares decoding a 1000 transactions ledger once per helper, as the
+// fetcher did before Decode, with decoding each transaction once and sharing the result
+func BenchmarkDecodeLedger(b *testing.B) {
+	const txCount = 1000
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(b, err)
+	metaBlob, err := hex.DecodeString(paymentMetaBlob)
+	require.NoError(b, err)
+
+	dec := NewDecoder(zap.NewNop())
+
+	b.Run("decode per helper", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for i := 0; i < txCount; i++ {
+				_ = dec.GetTransactionTypeFromHex(paymentTxBlob)
+				_ = dec.GetTransactionResult(metaBlob)
+				if _, err := dec.MapTransactionToProto(paymentTxBlob, paymentMetaBlob, hash, uint32(i)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("decode once", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for i := 0; i < txCount; i++ {
+				decoded := dec.Decode(paymentTxBlob, paymentMetaBlob)
+				_, _ = decoded.Type(), decoded.Result()
+				if _, err := dec.MapDecodedToProto(decoded, hash, uint32(i)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestMapDomainID(t *testing.T) {
+	const domainID = "D0B2A8C5C5C8D3B9E1F1E3B86B0C5FCB3C7B0B4E2E5F1F8C9A3D1E0F2A4B6C8D"
+
+	tests := []struct {
+		name     string
+		tx       map[string]interface{}
+		domainID func(*pbxrpl.Transaction) string
+	}{
+		{
+			name: "domain-scoped OfferCreate",
+			tx: map[string]interface{}{
+				"TransactionType": "OfferCreate",
+				"TakerGets":       "1000000",
+				"TakerPays": map[string]interface{}{
+					"currency": "USD",
+					"issuer":   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+					"value":    "1",
+				},
+			},
+			domainID: func(tx *pbxrpl.Transaction) string { return tx.GetOfferCreate().GetDomainId() },
+		},
+		{
+			name: "domain-scoped Payment",
+			tx: map[string]interface{}{
+				"TransactionType": "Payment",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":          "1000000",
+			},
+			domainID: func(tx *pbxrpl.Transaction) string { return tx.GetPayment().GetDomainId() },
+		},
+	}
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	dec := NewDecoder(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := map[string]interface{}{
+				"Account":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":      "12",
+				"Sequence": uint32(5),
+				"Flags":    uint32(0),
+				"DomainID": domainID,
+			}
+			for k, v := range tt.tx {
+				tx[k] = v
+			}
+			blob, err := binarycodec.Encode(tx)
+			require.NoError(t, err)
+
+			protoTx, err := dec.MapTransactionToProto(blob, paymentMetaBlob, hash, 0)
+			require.NoError(t, err)
+			assert.Equal(t, domainID, tt.domainID(protoTx))
+		})
+	}
+}
+
+func TestMapCredentialIDs(t *testing.T) {
+	credentialIDs := []string{
+		"EA85602C1B41F6F1F5E83C0E6B87142FB8957BD209469E4CC347BA2D0C26F66A",
+		"4C2A3B5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F70819",
+	}
+
+	tests := []struct {
+		name          string
+		tx            map[string]interface{}
+		credentialIDs func(*pbxrpl.Transaction) []string
+	}{
+		{
+			name: "Payment",
+			tx: map[string]interface{}{
+				"TransactionType": "Payment",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":          "1000000",
+			},
+			credentialIDs: func(tx *pbxrpl.Transaction) []string { return tx.GetPayment().GetCredentialIds() },
+		},
+		{
+			name: "AccountDelete",
+			tx: map[string]interface{}{
+				"TransactionType": "AccountDelete",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			},
+			credentialIDs: func(tx *pbxrpl.Transaction) []string { return tx.GetAccountDelete().GetCredentialIds() },
+		},
+		{
+			name: "EscrowFinish",
+			tx: map[string]interface{}{
+				"TransactionType": "EscrowFinish",
+				"Owner":           "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"OfferSequence":   uint32(7),
+			},
+			credentialIDs: func(tx *pbxrpl.Transaction) []string { return tx.GetEscrowFinish().GetCredentialIds() },
+		},
+		{
+			name: "PaymentChannelClaim",
+			tx: map[string]interface{}{
+				"TransactionType": "PaymentChannelClaim",
+				"Channel":         "C1AE6DDDEEC05CF2978C0BAD6FE302948E9533691DC749DCDD3B9E5992CA6198",
+			},
+			credentialIDs: func(tx *pbxrpl.Transaction) []string { return tx.GetPaymentChannelClaim().GetCredentialIds() },
+		},
+		{
+			// Not a credential field, but a Vector256 read the same way
+			name: "NFTokenCancelOffer offers",
+			tx: map[string]interface{}{
+				"TransactionType": "NFTokenCancelOffer",
+				"NFTokenOffers":   []interface{}{credentialIDs[0], credentialIDs[1]},
+			},
+			credentialIDs: func(tx *pbxrpl.Transaction) []string { return tx.GetNftokenCancelOffer().GetNftokenOffers() },
+		},
+	}
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	dec := NewDecoder(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := map[string]interface{}{
+				"Account":       "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":           "12",
+				"Sequence":      uint32(5),
+				"Flags":         uint32(0),
+				"CredentialIDs": []interface{}{credentialIDs[0], credentialIDs[1]},
+			}
+			for k, v := range tt.tx {
+				tx[k] = v
+			}
+			blob, err := binarycodec.Encode(tx)
+			require.NoError(t, err)
+
+			protoTx, err := dec.MapTransactionToProto(blob, paymentMetaBlob, hash, 0)
+			require.NoError(t, err)
+			assert.Equal(t, credentialIDs, tt.credentialIDs(protoTx))
+		})
+	}
+}
+
+func TestAmountKind(t *testing.T) {
+	const (
+		issuer = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+		mptID  = "00000001B5F762798A53D543A014CAF8B297CFF8F2F937E8"
+	)
+
+	tests := []struct {
+		name   string
+		amount interface{}
+		want   *pbxrpl.Amount
+	}{
+		{
+			name:   "XRP",
+			amount: "1000000",
+			want:   &pbxrpl.Amount{Value: "1000000", Kind: pbxrpl.AmountKind_AMOUNT_KIND_XRP},
+		},
+		{
+			name:   "IOU",
+			amount: map[string]interface{}{"currency": "USD", "issuer": issuer, "value": "12.5"},
+			want:   &pbxrpl.Amount{Value: "12.5", Currency: "USD", Issuer: issuer, Kind: pbxrpl.AmountKind_AMOUNT_KIND_IOU},
+		},
+		{
+			name:   "MPT",
+			amount: map[string]interface{}{"mpt_issuance_id": mptID, "value": "100"},
+			want:   &pbxrpl.Amount{Value: "100", MptIssuanceId: mptID, Kind: pbxrpl.AmountKind_AMOUNT_KIND_MPT},
+		},
+	}
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	dec := NewDecoder(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := binarycodec.Encode(map[string]interface{}{
+				"TransactionType": "Payment",
+				"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Destination":     issuer,
+				"Amount":          tt.amount,
+				"Fee":             "12",
+				"Sequence":        uint32(5),
+				"Flags":           uint32(0),
+			})
+			require.NoError(t, err)
+
+			protoTx, err := dec.MapTransactionToProto(blob, paymentMetaBlob, hash, 0)
+			require.NoError(t, err)
+			got := protoTx.GetPayment().GetAmount()
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want.Kind, got.Kind)
+			assert.Equal(t, tt.want.Value, got.Value)
+			assert.Equal(t, tt.want.Currency, got.Currency)
+			assert.Equal(t, tt.want.Issuer, got.Issuer)
+			// The codec decodes the issuance ID in lower case
+			assert.True(t, strings.EqualFold(tt.want.MptIssuanceId, got.MptIssuanceId))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package decoder
+
+// flagName pairs a transaction flag bit with its canonical name
+type flagName struct {
+	Bit  uint32
+	Name string
+}
+
+// universalFlags apply to every transaction type
+var universalFlags = []flagName{
+	{0x80000000, "tfFullyCanonicalSig"},
+	{0x40000000, "tfInnerBatchTxn"},
+}
+
+// txTypeFlags lists the type-specific flags of each transaction type
+// Types without an entry only have the universal flags
+var txTypeFlags = map[string][]flagName{
+	"Payment": {
+		{0x00010000, "tfNoRippleDirect"},
+		{0x00020000, "tfPartialPayment"},
+		{0x00040000, "tfLimitQuality"},
+	},
+	"OfferCreate": {
+		{tfPassive, "tfPassive"},
+		{tfImmediateOrCancel, "tfImmediateOrCancel"},
+		{tfFillOrKill, "tfFillOrKill"},
+		{tfSell, "tfSell"},
+		{tfHybrid, "tfHybrid"},
+	},
+	"TrustSet": {
+		{0x00010000, "tfSetfAuth"},
+		{0x00020000, "tfSetNoRipple"},
+		{0x00040000, "tfClearNoRipple"},
+		{0x00100000, "tfSetFreeze"},
+		{0x00200000, "tfClearFreeze"},
+		{0x00400000, "tfSetDeepFreeze"},
+		{0x00800000, "tfClearDeepFreeze"},
+	},
+	"AccountSet": {
+		{0x00010000, "tfRequireDestTag"},
+		{0x00020000, "tfOptionalDestTag"},
+		{0x00040000, "tfRequireAuth"},
+		{0x00080000, "tfOptionalAuth"},
+		{0x00100000, "tfDisallowXRP"},
+		{0x00200000, "tfAllowXRP"},
+	},
+	"PaymentChannelClaim": {
+		{0x00010000, "tfRenew"},
+		{0x00020000, "tfClose"},
+	},
+	"NFTokenMint": {
+		{0x00000001, "tfBurnable"},
+		{0x00000002, "tfOnlyXRP"},
+		{0x00000004, "tfTrustLine"},
+		{0x00000008, "tfTransferable"},
+		{0x00000010, "tfMutable"},
+	},
+	"NFTokenCreateOffer": {
+		{tfSellNFToken, "tfSellNFToken"},
+	},
+	"AMMDeposit": {
+		{0x00010000, "tfLPToken"},
+		{0x00080000, "tfSingleAsset"},
+		{0x00100000, "tfTwoAsset"},
+		{0x00200000, "tfOneAssetLPToken"},
+		{0x00400000, "tfLimitLPToken"},
+		{0x00800000, "tfTwoAssetIfEmpty"},
+	},
+	"AMMWithdraw": {
+		{0x00010000, "tfLPToken"},
+		{0x00020000, "tfWithdrawAll"},
+		{0x00040000, "tfOneAssetWithdrawAll"},
+		{0x00080000, "tfSingleAsset"},
+		{0x00100000, "tfTwoAsset"},
+		{0x00200000, "tfOneAssetLPToken"},
+		{0x00400000, "tfLimitLPToken"},
+	},
+	"AMMClawback": {
+		{0x00000001, "tfClawTwoAssets"},
+	},
+	"MPTokenIssuanceCreate": {
+		{0x00000002, "tfMPTCanLock"},
+		{0x00000004, "tfMPTRequireAuth"},
+		{0x00000008, "tfMPTCanEscrow"},
+		{0x00000010, "tfMPTCanTrade"},
+		{0x00000020, "tfMPTCanTransfer"},
+		{0x00000040, "tfMPTCanClawback"},
+	},
+	"MPTokenAuthorize": {
+		{0x00000001, "tfMPTUnauthorize"},
+	},
+	"MPTokenIssuanceSet": {
+		{0x00000001, "tfMPTLock"},
+		{0x00000002, "tfMPTUnlock"},
+	},
+	"Batch": {
+		{tfAllOrNothing, "tfAllOrNothing"},
+		{tfOnlyOne, "tfOnlyOne"},
+		{tfUntilFailure, "tfUntilFailure"},
+		{tfIndependent, "tfIndependent"},
+	},
+	"EnableAmendment": {
+		{0x00010000, "tfGotMajority"},
+		{0x00020000, "tfLostMajority"},
+	},
+}
+
+// setFlagNames returns the names of the flags set in flags for txType
+// Absent and zero flags both yield an empty, non-nil list; unknown bits are ignored
+func setFlagNames(txType string, flags uint32) []string {
+	names := []string{}
+	if flags == 0 {
+		return names
+	}
+
+	for _, flag := range txTypeFlags[txType] {
+		if flags&flag.Bit != 0 {
+			names = append(names, flag.Name)
+		}
+	}
+	for _, flag := range universalFlags {
+		if flags&flag.Bit != 0 {
+			names = append(names, flag.Name)
+		}
+	}
+
+	return names
+}
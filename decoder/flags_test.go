@@ -0,0 +1,142 @@
+package decoder
+
+import (
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+)
+
+func TestSetFlagNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		txType string
+		flags  uint32
+		want   []string
+	}{
+		{"absent or zero", "Payment", 0, []string{}},
+		{"type flag", "Payment", 0x00020000, []string{"tfPartialPayment"}},
+		{"type and universal flags", "OfferCreate", 0x80080000, []string{"tfSell", "tfFullyCanonicalSig"}},
+		{"same bit, other type", "TrustSet", 0x00020000, []string{"tfSetNoRipple"}},
+		{"type without specific flags", "AccountDelete", 0x80000000, []string{"tfFullyCanonicalSig"}},
+		{"unknown bits ignored", "Payment", 0x00000100, []string{}},
+		{"unknown type", "NotAType", 0x00010000, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := setFlagNames(tt.txType, tt.flags)
+			assert.NotNil(t, got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOfferCreateFlagsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags uint32
+		check func(*pbxrpl.OfferCreate) bool
+	}{
+		{"tfPassive", tfPassive, func(o *pbxrpl.OfferCreate) bool { return o.IsPassive }},
+		{"tfImmediateOrCancel", tfImmediateOrCancel, func(o *pbxrpl.OfferCreate) bool { return o.IsImmediateOrCancel }},
+		{"tfFillOrKill", tfFillOrKill, func(o *pbxrpl.OfferCreate) bool { return o.IsFillOrKill }},
+		{"tfSell", tfSell, func(o *pbxrpl.OfferCreate) bool { return o.IsSell }},
+		{"tfHybrid", tfHybrid, func(o *pbxrpl.OfferCreate) bool { return o.IsHybrid }},
+	}
+
+	dec := NewDecoder(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := binarycodec.Encode(map[string]interface{}{
+				"TransactionType": "OfferCreate",
+				"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":             "12",
+				"Sequence":        uint32(5),
+				"Flags":           tt.flags,
+				"TakerGets":       "1000000",
+				"TakerPays": map[string]interface{}{
+					"currency": "USD",
+					"issuer":   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+					"value":    "1",
+				},
+			})
+			require.NoError(t, err)
+
+			flat, err := dec.DecodeTransactionFromHex(blob)
+			require.NoError(t, err)
+
+			offer := NewMapper(zap.NewNop()).mapOfferCreate(flat)
+			assert.Equal(t, tt.flags, offer.Flags)
+
+			// Exactly the flag set maps to true
+			for _, other := range tests {
+				assert.Equal(t, other.flags == tt.flags, other.check(offer), other.name)
+			}
+		})
+	}
+}
+
+func TestTypeSpecificFlagsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		tx    map[string]interface{}
+		flags func(*Mapper, map[string]interface{}) uint32
+		want  uint32
+	}{
+		{
+			name: "NFTokenCreateOffer tfSellNFToken",
+			tx: map[string]interface{}{
+				"TransactionType": "NFTokenCreateOffer",
+				"NFTokenID":       "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000D9D00000000",
+				"Amount":          "1000000",
+				"Flags":           uint32(0x00000001),
+			},
+			flags: func(m *Mapper, flat map[string]interface{}) uint32 { return m.mapNFTokenCreateOffer(flat).Flags },
+			want:  0x00000001,
+		},
+		{
+			name: "NFTokenMint tfTransferable",
+			tx: map[string]interface{}{
+				"TransactionType": "NFTokenMint",
+				"NFTokenTaxon":    uint32(0),
+				"Flags":           uint32(0x00000008),
+			},
+			flags: func(m *Mapper, flat map[string]interface{}) uint32 { return m.mapNFTokenMint(flat).Flags },
+			want:  0x00000008,
+		},
+		{
+			name: "AccountSet tfRequireDestTag",
+			tx: map[string]interface{}{
+				"TransactionType": "AccountSet",
+				"Flags":           uint32(0x00010000),
+			},
+			flags: func(m *Mapper, flat map[string]interface{}) uint32 { return m.mapAccountSet(flat).Flags },
+			want:  0x00010000,
+		},
+	}
+
+	dec := NewDecoder(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := map[string]interface{}{
+				"Account":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":      "12",
+				"Sequence": uint32(5),
+			}
+			for k, v := range tt.tx {
+				tx[k] = v
+			}
+			blob, err := binarycodec.Encode(tx)
+			require.NoError(t, err)
+
+			flat, err := dec.DecodeTransactionFromHex(blob)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, tt.flags(NewMapper(zap.NewNop()), flat))
+		})
+	}
+}
@@ -1,25 +1,38 @@
 package decoder
 
 import (
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
+	"sync/atomic"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	xrpltx "github.com/Peersyst/xrpl-go/xrpl/transaction"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
 	"go.uber.org/zap"
 )
 
 // Mapper handles mapping from goxrpl types to protobuf types
 type Mapper struct {
-	logger    *zap.Logger
-	txMappers map[string]func(*pbxrpl.Transaction, xrpltx.FlatTransaction)
+	logger      *zap.Logger
+	txMappers   map[string]func(*pbxrpl.Transaction, xrpltx.FlatTransaction)
+	metaMappers map[string]func(*pbxrpl.Transaction, map[string]interface{})
+
+	// mptAmountBase is the base of the MPT amount fields (MaximumAmount, OutstandingAmount,
+	// MPTAmount): hex like any UInt64 from the binary codec, base 10 in rippled's JSON
+	mptAmountBase int
+
+	// warnings counts decode warnings emitted since creation
+	warnings atomic.Uint64
 }
 
 // NewMapper creates a new mapper with pre-built transaction type dispatch map
 func NewMapper(logger *zap.Logger) *Mapper {
 	m := &Mapper{
-		logger: logger,
+		logger:        logger,
+		mptAmountBase: 16,
 	}
 
 	// Build hashmap for O(1) transaction type lookup instead of O(n) switch
@@ -177,127 +190,77 @@ func NewMapper(logger *zap.Logger) *Mapper {
 		// XChain transactions removed - deprecated and will never be in prod
 	}
 
-	return m
-}
-
-// MapAmount converts goxrpl CurrencyAmount to protobuf Amount
-func (m *Mapper) MapAmount(amt types.CurrencyAmount) *pbxrpl.Amount {
-	if amt == nil {
-		return nil
-	}
-
-	// Flatten the amount to get the underlying data
-	flat := amt.Flatten()
-
-	switch v := flat.(type) {
-	case string:
-		// XRP amount in drops
-		return &pbxrpl.Amount{
-			Value: v,
-		}
-	case map[string]interface{}:
-		// Token or MPT amount
-		result := &pbxrpl.Amount{}
-
-		if value, ok := v["value"].(string); ok {
-			result.Value = value
-		}
-		if currency, ok := v["currency"].(string); ok {
-			result.Currency = currency
-		}
-		if issuer, ok := v["issuer"].(string); ok {
-			result.Issuer = issuer
-		}
-		// Check for MPT issuance ID
-		if mptID, ok := v["mpt_issuance_id"].(string); ok {
-			result.MptIssuanceId = mptID
-		}
-
-		return result
-	}
-
-	return nil
-}
-
-// MapMemos converts goxrpl MemoWrapper array to protobuf Memo array
-func (m *Mapper) MapMemos(memos []types.MemoWrapper) []*pbxrpl.Memo {
-	if len(memos) == 0 {
-		return nil
-	}
-
-	result := make([]*pbxrpl.Memo, 0, len(memos))
-	for _, memoWrapper := range memos {
-		flat := memoWrapper.Flatten()
-		if memoMap, ok := flat["Memo"].(map[string]interface{}); ok {
-			memo := &pbxrpl.Memo{}
-
-			if data, ok := memoMap["MemoData"].(string); ok {
-				memo.MemoData = data
+	// Enrichments for transaction types whose effects only appear in the metadata
+	m.metaMappers = map[string]func(*pbxrpl.Transaction, map[string]interface{}){
+		"AMMCreate": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			m.mapAMMCreateMeta(tx.GetAmmCreate(), meta)
+		},
+		"CheckCash": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			if cash := tx.GetCheckCash(); cash != nil {
+				cash.DeliveredAmount = m.mapDeliveredAmount(meta)
 			}
-			if format, ok := memoMap["MemoFormat"].(string); ok {
-				memo.MemoFormat = format
+		},
+		"Payment": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			if payment := tx.GetPayment(); payment != nil {
+				payment.DeliveredAmount = m.mapDeliveredAmount(meta)
 			}
-			if memoType, ok := memoMap["MemoType"].(string); ok {
-				memo.MemoType = memoType
+		},
+		"AMMVote": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			m.mapAMMVoteMeta(tx.GetAmmVote(), meta)
+		},
+		"NFTokenMint": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			if mint := tx.GetNftokenMint(); mint != nil {
+				mint.NftokenId = mintedNFTokenID(meta)
+				mint.OfferId = createdNFTokenOfferID(meta)
 			}
-
-			result = append(result, memo)
-		}
+		},
+		"NFTokenAcceptOffer": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			m.mapNFTokenAcceptOfferMeta(tx.GetNftokenAcceptOffer(), meta)
+		},
+		"TrustSet": func(tx *pbxrpl.Transaction, meta map[string]interface{}) {
+			m.mapTrustSetMeta(tx.GetTrustSet(), tx.Account, meta)
+		},
 	}
 
-	return result
+	return m
 }
 
-// MapSigners converts goxrpl Signer array to protobuf Signer array
-func (m *Mapper) MapSigners(signers []types.Signer) []*pbxrpl.Signer {
-	if len(signers) == 0 {
-		return nil
-	}
-
-	result := make([]*pbxrpl.Signer, 0, len(signers))
-	for _, signer := range signers {
-		flat := signer.Flatten()
-		if signerMap, ok := flat["Signer"].(map[string]interface{}); ok {
-			pbSigner := &pbxrpl.Signer{}
-
-			if account, ok := signerMap["Account"].(string); ok {
-				pbSigner.Account = account
-			}
-			if txnSig, ok := signerMap["TxnSignature"].(string); ok {
-				pbSigner.TxnSignature = txnSig
-			}
-			if signingPubKey, ok := signerMap["SigningPubKey"].(string); ok {
-				pbSigner.SigningPubKey = signingPubKey
-			}
+// newJSONMapper creates a mapper for rippled's JSON rendering (binary=false), whose MPT amounts are base 10
+func newJSONMapper(logger *zap.Logger) *Mapper {
+	m := NewMapper(logger)
+	m.mptAmountBase = 10
+	return m
+}
 
-			result = append(result, pbSigner)
-		}
+// SupportedTransactionTypes returns the sorted list of transaction types with a details mapper
+func (m *Mapper) SupportedTransactionTypes() []string {
+	txTypes := make([]string, 0, len(m.txMappers))
+	for txType := range m.txMappers {
+		txTypes = append(txTypes, txType)
 	}
+	sort.Strings(txTypes)
 
-	return result
+	return txTypes
 }
 
-// MapBaseTxFields extracts common transaction fields from goxrpl BaseTx
-func (m *Mapper) MapBaseTxFields(baseTx xrpltx.BaseTx) (account string, fee uint64, sequence uint32, flags uint32, memos []*pbxrpl.Memo, signers []*pbxrpl.Signer) {
-	account = baseTx.Account.String()
+// maxFeeDrops is the total XRP supply in drops, no valid fee can exceed it
+const maxFeeDrops = 100_000_000_000_000_000
 
-	if baseTx.Fee != 0 {
-		// Fee is in drops, convert XRPCurrencyAmount to uint64
-		fee = uint64(baseTx.Fee)
+// checkFeeDrops returns the fee when it is within the XRP supply, and warns and returns 0 otherwise
+func (m *Mapper) checkFeeDrops(fee uint64, ref string) uint64 {
+	if fee > maxFeeDrops {
+		m.warn("fee exceeds the XRP supply, using 0",
+			zap.Uint64("fee", fee),
+			zap.String("ref", ref))
+		return 0
 	}
 
-	sequence = baseTx.Sequence
-	flags = baseTx.Flags
-
-	memos = m.MapMemos(baseTx.Memos)
-	signers = m.MapSigners(baseTx.Signers)
-
-	return
+	return fee
 }
 
-// MapTransactionToProto maps a goxrpl FlatTransaction to protobuf Transaction
+// MapTransactionToProto maps a goxrpl FlatTransaction and its decoded metadata to protobuf Transaction
 // This is the main entry point for mapping transaction data
-func (m *Mapper) MapTransactionToProto(flatTx xrpltx.FlatTransaction, txBlob, metaBlob []byte, txHash []byte, txIndex uint32, result string) (*pbxrpl.Transaction, error) {
+func (m *Mapper) MapTransactionToProto(flatTx xrpltx.FlatTransaction, meta map[string]interface{}, txBlob, metaBlob []byte, txHash []byte, txIndex uint32, result string) (*pbxrpl.Transaction, error) {
 	// Extract transaction type
 	txType, ok := flatTx["TransactionType"].(string)
 	if !ok {
@@ -314,32 +277,39 @@ func (m *Mapper) MapTransactionToProto(flatTx xrpltx.FlatTransaction, txBlob, me
 	if feeStr, ok := flatTx["Fee"].(string); ok {
 		// Fee is in drops as string, convert to uint64
 		if feeVal, err := strconv.ParseUint(feeStr, 10, 64); err == nil {
-			fee = feeVal
+			fee = m.checkFeeDrops(feeVal, hex.EncodeToString(txHash))
+		} else {
+			m.warn("invalid fee, using 0",
+				zap.String("fee", feeStr),
+				zap.String("tx_hash", hex.EncodeToString(txHash)),
+				zap.Error(err))
 		}
 	}
 
 	sequence := uint32(0)
-	if seq, ok := flatTx["Sequence"].(float64); ok {
-		sequence = uint32(seq)
+	if seq, ok := uint32FromFlat(flatTx["Sequence"]); ok {
+		sequence = seq
 	}
 
 	flags := uint32(0)
-	if f, ok := flatTx["Flags"].(float64); ok {
-		flags = uint32(f)
+	if f, ok := uint32FromFlat(flatTx["Flags"]); ok {
+		flags = f
 	}
 
 	// Build base transaction
 	protoTx := &pbxrpl.Transaction{
-		Hash:     txHash,
-		Result:   result,
-		Index:    txIndex,
-		TxBlob:   txBlob,
-		MetaBlob: metaBlob,
-		TxType:   txType,
-		Account:  account,
-		Fee:      fee,
-		Sequence: sequence,
-		Flags:    flags,
+		Hash:            txHash,
+		Result:          result,
+		Index:           txIndex,
+		TxBlob:          txBlob,
+		MetaBlob:        metaBlob,
+		TxType:          txType,
+		TransactionType: transactionTypes[txType],
+		Account:         account,
+		Fee:             fee,
+		Sequence:        sequence,
+		Flags:           flags,
+		SetFlags:        setFlagNames(txType, flags),
 	}
 
 	// Extract optional common fields
@@ -351,8 +321,8 @@ func (m *Mapper) MapTransactionToProto(flatTx xrpltx.FlatTransaction, txBlob, me
 		protoTx.Delegate = delegate
 	}
 
-	if lastLedgerSeq, ok := flatTx["LastLedgerSequence"].(float64); ok {
-		protoTx.LastLedgerSequence = uint32(lastLedgerSeq)
+	if lastLedgerSeq, ok := uint32FromFlat(flatTx["LastLedgerSequence"]); ok {
+		protoTx.LastLedgerSequence = lastLedgerSeq
 	}
 
 	// Map memos
@@ -360,8 +330,8 @@ func (m *Mapper) MapTransactionToProto(flatTx xrpltx.FlatTransaction, txBlob, me
 		protoTx.Memos = m.mapMemosFromFlat(memosRaw)
 	}
 
-	if networkID, ok := flatTx["NetworkID"].(float64); ok {
-		protoTx.NetworkId = uint32(networkID)
+	if networkID, ok := uint32FromFlat(flatTx["NetworkID"]); ok {
+		protoTx.NetworkId = networkID
 	}
 
 	// Map signers
@@ -369,28 +339,72 @@ func (m *Mapper) MapTransactionToProto(flatTx xrpltx.FlatTransaction, txBlob, me
 		protoTx.Signers = m.mapSignersFromFlat(signersRaw)
 	}
 
-	if sourceTag, ok := flatTx["SourceTag"].(float64); ok {
-		protoTx.SourceTag = uint32(sourceTag)
+	if sourceTag, ok := uint32FromFlat(flatTx["SourceTag"]); ok {
+		protoTx.SourceTag = sourceTag
 	}
 
 	if signingPubKey, ok := flatTx["SigningPubKey"].(string); ok {
 		protoTx.SigningPubKey = signingPubKey
 	}
 
-	if ticketSeq, ok := flatTx["TicketSequence"].(float64); ok {
-		protoTx.TicketSequence = uint32(ticketSeq)
+	if ticketSeq, ok := uint32FromFlat(flatTx["TicketSequence"]); ok {
+		protoTx.TicketSequence = ticketSeq
 	}
 
 	if txnSig, ok := flatTx["TxnSignature"].(string); ok {
 		protoTx.TxnSignature = txnSig
 	}
 
+	m.mapSigningMode(protoTx)
+
 	// Map transaction-specific details based on type
-	m.mapTxDetails(protoTx, flatTx, txType)
+	m.mapTxDetails(protoTx, flatTx, meta, txType)
+
+	if batch := protoTx.GetBatch(); batch != nil {
+		protoTx.InnerTransactions = m.mapBatchInnerTransactions(flatTx, batch)
+	}
+
+	// tef, tel, tem and ter transactions were not applied and cannot change the ledger, results
+	// without a known prefix still get their state changes rather than silently losing them
+	protoTx.Applied = utils.IsAppliedResult(result)
+	if protoTx.Applied || utils.GetResultCategory(result) == utils.ResultUnknown {
+		protoTx.StateChanges = m.mapStateChanges(meta)
+		protoTx.NftokenTransfers = m.mapNFTokenTransfers(meta)
+		protoTx.NftokenOfferChanges = m.mapNFTokenOfferChanges(meta)
+	}
 
 	return protoTx, nil
 }
 
+// mapSigningMode derives is_multisigned from the signing fields
+// A multi-signed transaction has an empty SigningPubKey and a non-empty Signers array,
+// a single-signed one has a SigningPubKey and no Signers
+func (m *Mapper) mapSigningMode(tx *pbxrpl.Transaction) {
+	hasSigningPubKey := tx.SigningPubKey != ""
+	hasSigners := len(tx.Signers) > 0
+
+	tx.IsMultisigned = !hasSigningPubKey && hasSigners
+
+	if hasSigningPubKey && hasSigners {
+		m.warn("transaction has both SigningPubKey and Signers",
+			zap.String("tx_hash", hex.EncodeToString(tx.Hash)),
+			zap.String("tx_type", tx.TxType),
+			zap.Int("signer_count", len(tx.Signers)))
+	}
+}
+
+// warn reports a decoded transaction that violates an XRPL invariant
+// The transaction is still emitted, the warning only flags it for operators
+func (m *Mapper) warn(msg string, fields ...zap.Field) {
+	m.warnings.Add(1)
+	m.logger.Warn(msg, fields...)
+}
+
+// WarningCount returns the number of decode warnings emitted so far
+func (m *Mapper) WarningCount() uint64 {
+	return m.warnings.Load()
+}
+
 // Helper methods for mapping from flat representations
 
 func (m *Mapper) mapMemosFromFlat(memosRaw []interface{}) []*pbxrpl.Memo {
@@ -413,6 +427,7 @@ func (m *Mapper) mapMemosFromFlat(memosRaw []interface{}) []*pbxrpl.Memo {
 				if memoType, ok := memo["MemoType"].(string); ok {
 					pbMemo.MemoType = memoType
 				}
+				pbMemo.MemoText = utils.MemoText(pbMemo.MemoData, pbMemo.MemoFormat)
 
 				result = append(result, pbMemo)
 			}
@@ -461,13 +476,14 @@ func (m *Mapper) mapAmountFromFlat(amtRaw interface{}) *pbxrpl.Amount {
 		// XRP amount in drops
 		return &pbxrpl.Amount{
 			Value: v,
+			Kind:  pbxrpl.AmountKind_AMOUNT_KIND_XRP,
 		}
 	case map[string]interface{}:
 		// Token or MPT amount
-		result := &pbxrpl.Amount{}
+		result := &pbxrpl.Amount{Kind: pbxrpl.AmountKind_AMOUNT_KIND_IOU}
 
 		if value, ok := v["value"].(string); ok {
-			result.Value = value
+			result.Value = m.normalizeAmountValue(value)
 		}
 		if currency, ok := v["currency"].(string); ok {
 			result.Currency = currency
@@ -477,6 +493,7 @@ func (m *Mapper) mapAmountFromFlat(amtRaw interface{}) *pbxrpl.Amount {
 		}
 		if mptID, ok := v["mpt_issuance_id"].(string); ok {
 			result.MptIssuanceId = mptID
+			result.Kind = pbxrpl.AmountKind_AMOUNT_KIND_MPT
 		}
 
 		return result
@@ -486,18 +503,33 @@ func (m *Mapper) mapAmountFromFlat(amtRaw interface{}) *pbxrpl.Amount {
 }
 
 // mapTxDetails populates the tx_details oneof field based on transaction type
-func (m *Mapper) mapTxDetails(tx *pbxrpl.Transaction, flatTx xrpltx.FlatTransaction, txType string) {
+func (m *Mapper) mapTxDetails(tx *pbxrpl.Transaction, flatTx xrpltx.FlatTransaction, meta map[string]interface{}, txType string) {
 	// Use hashmap for O(1) lookup instead of O(n) switch with 50+ cases
 	if mapper, ok := m.txMappers[txType]; ok {
 		mapper(tx, flatTx)
-	} else {
-		// Unknown transaction type - log for debugging
-		m.logger.Debug("unknown transaction type", zap.String("tx_type", txType))
+	} else if txType != "" {
+		// No mapping yet (e.g. a new amendment), keep the decoded fields rather than dropping them
+		details, err := newStruct(flatTx)
+		if err != nil {
+			m.warn("failed to convert unmapped transaction to struct, tx_details left empty",
+				zap.String("tx_type", txType),
+				zap.Error(err))
+		} else {
+			tx.TxDetails = &pbxrpl.Transaction_UnknownDetails{UnknownDetails: details}
+		}
+	}
+
+	// Fields that only exist in the metadata are attached after the body is mapped
+	if metaMapper, ok := m.metaMappers[txType]; ok && meta != nil {
+		metaMapper(tx, meta)
 	}
 }
 
 // Transaction-specific mappers
 
+// Payment flags
+const tfNoRippleDirect = 0x00010000
+
 // Payment transactions
 func (m *Mapper) mapPayment(flat xrpltx.FlatTransaction) *pbxrpl.Payment {
 	payment := &pbxrpl.Payment{}
@@ -515,28 +547,84 @@ func (m *Mapper) mapPayment(flat xrpltx.FlatTransaction) *pbxrpl.Payment {
 		payment.InvoiceId = invoiceID
 	}
 
-	if destTag, ok := flat["DestinationTag"].(float64); ok {
-		payment.DestinationTag = uint32(destTag)
+	if destTag, ok := uint32FromFlat(flat["DestinationTag"]); ok {
+		payment.DestinationTag = destTag
 	}
 
-	if credIDs, ok := flat["CredentialIDs"].([]interface{}); ok {
-		payment.CredentialIds = m.mapStringArray(credIDs)
-	}
+	payment.CredentialIds = m.mapStringArray(flat["CredentialIDs"])
 
 	if domainID, ok := flat["DomainID"].(string); ok {
 		payment.DomainId = domainID
 	}
 
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		payment.Flags = flags
+	}
+
 	if paths, ok := flat["Paths"].([]interface{}); ok {
-		payment.Paths = m.mapPaths(paths)
+		payment.HasPaths = true
+		payment.Paths = MapPaths(paths)
 	}
 
-	// DeliveredAmount from metadata would be mapped separately
-	payment.DeliveredAmount = m.mapAmountFromFlat(flat["delivered_amount"])
+	// rippled tries the default path alongside any explicit paths, unless tfNoRippleDirect excludes it
+	crossCurrency := payment.SendMax != nil && !sameAsset(payment.SendMax, payment.Amount)
+	payment.UsesDefaultPath = crossCurrency && payment.Flags&tfNoRippleDirect == 0
 
 	return payment
 }
 
+// mapDeliveredAmount reads the amount actually delivered from the metadata
+// The binary codec names it DeliveredAmount, JSON metadata uses delivered_amount
+func (m *Mapper) mapDeliveredAmount(meta map[string]interface{}) *pbxrpl.Amount {
+	if delivered, ok := meta["DeliveredAmount"]; ok {
+		return m.mapMetaAmount(delivered)
+	}
+
+	return m.mapMetaAmount(meta["delivered_amount"])
+}
+
+// metaAmountKeys maps capitalized amount field names to the XRPL JSON spelling mapAmountFromFlat reads
+var metaAmountKeys = map[string]string{
+	"Value":             "value",
+	"Currency":          "currency",
+	"Issuer":            "issuer",
+	"MPTokenIssuanceID": "mpt_issuance_id",
+}
+
+// normalizeAmountValue canonicalizes a token or MPT amount value, keeping it verbatim when malformed
+func (m *Mapper) normalizeAmountValue(value string) string {
+	normalized, err := utils.NormalizeIOUValue(value)
+	if err != nil {
+		m.warn("invalid amount value, keeping it as is", zap.String("value", value), zap.Error(err))
+		return value
+	}
+
+	return normalized
+}
+
+// mapMetaAmount decodes an amount read from metadata: XRP drops, or an IOU/MPT object using either
+// the XRPL JSON field names (value, currency, issuer) or their capitalized spelling
+// delivered_amount is "unavailable" for payments older than the DeliveredAmount field, mapped to nil
+func (m *Mapper) mapMetaAmount(amtRaw interface{}) *pbxrpl.Amount {
+	switch v := amtRaw.(type) {
+	case string:
+		if v == "unavailable" {
+			return nil
+		}
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if jsonKey, ok := metaAmountKeys[key]; ok {
+				key = jsonKey
+			}
+			normalized[key] = value
+		}
+		return m.mapAmountFromFlat(normalized)
+	}
+
+	return m.mapAmountFromFlat(amtRaw)
+}
+
 // DEX transactions
 func (m *Mapper) mapOfferCreate(flat xrpltx.FlatTransaction) *pbxrpl.OfferCreate {
 	offer := &pbxrpl.OfferCreate{}
@@ -544,26 +632,63 @@ func (m *Mapper) mapOfferCreate(flat xrpltx.FlatTransaction) *pbxrpl.OfferCreate
 	offer.TakerGets = m.mapAmountFromFlat(flat["TakerGets"])
 	offer.TakerPays = m.mapAmountFromFlat(flat["TakerPays"])
 
-	if exp, ok := flat["Expiration"].(float64); ok {
-		offer.Expiration = uint32(exp)
+	if exp, ok := uint32FromFlat(flat["Expiration"]); ok {
+		offer.Expiration = exp
 	}
 
-	if offerSeq, ok := flat["OfferSequence"].(float64); ok {
-		offer.OfferSequence = uint32(offerSeq)
+	if offerSeq, ok := uint32FromFlat(flat["OfferSequence"]); ok {
+		offer.OfferSequence = offerSeq
 	}
 
 	if domainID, ok := flat["DomainID"].(string); ok {
 		offer.DomainId = domainID
 	}
 
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		offer.Flags = flags
+	}
+	offer.IsPassive = offer.Flags&tfPassive != 0
+	offer.IsImmediateOrCancel = offer.Flags&tfImmediateOrCancel != 0
+	offer.IsFillOrKill = offer.Flags&tfFillOrKill != 0
+	offer.IsSell = offer.Flags&tfSell != 0
+	offer.IsHybrid = offer.Flags&tfHybrid != 0
+
+	// rippled rejects zero-amount offers (temBAD_OFFER), seeing one means a malformed source
+	if isZeroAmount(offer.TakerGets) || isZeroAmount(offer.TakerPays) {
+		m.warn("OfferCreate has a zero amount",
+			zap.String("account", fmt.Sprint(flat["Account"])),
+			zap.Uint32("offer_sequence", offer.OfferSequence),
+			zap.String("taker_gets", offer.TakerGets.GetValue()),
+			zap.String("taker_pays", offer.TakerPays.GetValue()))
+	}
+
 	return offer
 }
 
+// OfferCreate flags
+const (
+	tfPassive           = 0x00010000
+	tfImmediateOrCancel = 0x00020000
+	tfFillOrKill        = 0x00040000
+	tfSell              = 0x00080000
+	tfHybrid            = 0x00100000
+)
+
+// isZeroAmount reports whether an amount is present and numerically zero
+func isZeroAmount(amt *pbxrpl.Amount) bool {
+	if amt == nil {
+		return false
+	}
+
+	value, err := strconv.ParseFloat(amt.Value, 64)
+	return err == nil && value == 0
+}
+
 func (m *Mapper) mapOfferCancel(flat xrpltx.FlatTransaction) *pbxrpl.OfferCancel {
 	cancel := &pbxrpl.OfferCancel{}
 
-	if offerSeq, ok := flat["OfferSequence"].(float64); ok {
-		cancel.OfferSequence = uint32(offerSeq)
+	if offerSeq, ok := uint32FromFlat(flat["OfferSequence"]); ok {
+		cancel.OfferSequence = offerSeq
 	}
 
 	return cancel
@@ -575,27 +700,87 @@ func (m *Mapper) mapTrustSet(flat xrpltx.FlatTransaction) *pbxrpl.TrustSet {
 
 	trust.LimitAmount = m.mapAmountFromFlat(flat["LimitAmount"])
 
-	if qualityIn, ok := flat["QualityIn"].(float64); ok {
-		trust.QualityIn = uint32(qualityIn)
+	if qualityIn, ok := uint32FromFlat(flat["QualityIn"]); ok {
+		trust.QualityIn = qualityIn
+	}
+
+	if qualityOut, ok := uint32FromFlat(flat["QualityOut"]); ok {
+		trust.QualityOut = qualityOut
 	}
 
-	if qualityOut, ok := flat["QualityOut"].(float64); ok {
-		trust.QualityOut = uint32(qualityOut)
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		trust.Flags = flags
 	}
 
 	return trust
 }
 
+// RippleState ledger entry flags holding each side's NoRipple setting
+const (
+	lsfLowNoRipple  = 0x00100000
+	lsfHighNoRipple = 0x00200000
+)
+
+// mapTrustSetMeta attaches the resulting NoRipple state of both sides of the trust line,
+// read from the RippleState entry the transaction created or modified
+func (m *Mapper) mapTrustSetMeta(trust *pbxrpl.TrustSet, account string, meta map[string]interface{}) {
+	if trust == nil || trust.LimitAmount == nil {
+		return
+	}
+	peer := trust.LimitAmount.Issuer
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.LedgerEntryType != "RippleState" {
+			return true
+		}
+
+		var fields map[string]interface{}
+		switch node.Kind {
+		case createdNode:
+			fields = node.NewFields
+		case modifiedNode:
+			fields = node.FinalFields
+		default:
+			return true
+		}
+
+		lowLimit, _ := fields["LowLimit"].(map[string]interface{})
+		highLimit, _ := fields["HighLimit"].(map[string]interface{})
+		low, _ := lowLimit["issuer"].(string)
+		high, _ := highLimit["issuer"].(string)
+
+		// Flags is omitted from NewFields when no flag is set
+		flags, _ := uint32FromFlat(fields["Flags"])
+		lowNoRipple := flags&lsfLowNoRipple != 0
+		highNoRipple := flags&lsfHighNoRipple != 0
+
+		switch {
+		case low == account && high == peer:
+			trust.AccountNoRipple, trust.PeerNoRipple = lowNoRipple, highNoRipple
+		case high == account && low == peer:
+			trust.AccountNoRipple, trust.PeerNoRipple = highNoRipple, lowNoRipple
+		default:
+			return true
+		}
+
+		trust.HasTrustLineState = true
+		return false
+	})
+}
+
 // Account management
 func (m *Mapper) mapAccountSet(flat xrpltx.FlatTransaction) *pbxrpl.AccountSet {
 	acct := &pbxrpl.AccountSet{}
 
-	if setFlag, ok := flat["SetFlag"].(float64); ok {
-		acct.SetFlag = uint32(setFlag)
+	// The enum values are the asf codes, so unnamed codes pass through unchanged
+	if setFlag, ok := uint32FromFlat(flat["SetFlag"]); ok {
+		acct.SetFlag = setFlag
+		acct.SetAccountFlag = pbxrpl.AccountSetFlag(setFlag)
 	}
 
-	if clearFlag, ok := flat["ClearFlag"].(float64); ok {
-		acct.ClearFlag = uint32(clearFlag)
+	if clearFlag, ok := uint32FromFlat(flat["ClearFlag"]); ok {
+		acct.ClearFlag = clearFlag
+		acct.ClearAccountFlag = pbxrpl.AccountSetFlag(clearFlag)
 	}
 
 	if domain, ok := flat["Domain"].(string); ok {
@@ -610,12 +795,21 @@ func (m *Mapper) mapAccountSet(flat xrpltx.FlatTransaction) *pbxrpl.AccountSet {
 		acct.MessageKey = msgKey
 	}
 
-	if transferRate, ok := flat["TransferRate"].(float64); ok {
-		acct.TransferRate = uint32(transferRate)
+	if transferRate, ok := uint32FromFlat(flat["TransferRate"]); ok {
+		acct.TransferRate = transferRate
+		acct.TransferRatePercent = utils.TransferRateToPercent(transferRate)
+		acct.InvalidTransferRate = !utils.ValidTransferRate(transferRate)
+	}
+
+	if tickSize, ok := uint32FromFlat(flat["TickSize"]); ok {
+		acct.TickSize = tickSize
+		acct.InvalidTickSize = !utils.ValidTickSize(tickSize)
 	}
 
-	if tickSize, ok := flat["TickSize"].(float64); ok {
-		acct.TickSize = uint32(tickSize)
+	if acct.InvalidTransferRate || acct.InvalidTickSize {
+		m.warn("AccountSet has an out of range transfer rate or tick size",
+			zap.Uint32("transfer_rate", acct.TransferRate),
+			zap.Uint32("tick_size", acct.TickSize))
 	}
 
 	if minter, ok := flat["NFTokenMinter"].(string); ok {
@@ -626,8 +820,12 @@ func (m *Mapper) mapAccountSet(flat xrpltx.FlatTransaction) *pbxrpl.AccountSet {
 		acct.WalletLocator = walletLocator
 	}
 
-	if walletSize, ok := flat["WalletSize"].(float64); ok {
-		acct.WalletSize = uint32(walletSize)
+	if walletSize, ok := uint32FromFlat(flat["WalletSize"]); ok {
+		acct.WalletSize = walletSize
+	}
+
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		acct.Flags = flags
 	}
 
 	return acct
@@ -640,13 +838,11 @@ func (m *Mapper) mapAccountDelete(flat xrpltx.FlatTransaction) *pbxrpl.AccountDe
 		del.Destination = dest
 	}
 
-	if destTag, ok := flat["DestinationTag"].(float64); ok {
-		del.DestinationTag = uint32(destTag)
+	if destTag, ok := uint32FromFlat(flat["DestinationTag"]); ok {
+		del.DestinationTag = destTag
 	}
 
-	if credIDs, ok := flat["CredentialIDs"].([]interface{}); ok {
-		del.CredentialIds = m.mapStringArray(credIDs)
-	}
+	del.CredentialIds = m.mapStringArray(flat["CredentialIDs"])
 
 	return del
 }
@@ -656,6 +852,8 @@ func (m *Mapper) mapSetRegularKey(flat xrpltx.FlatTransaction) *pbxrpl.SetRegula
 
 	if regKey, ok := flat["RegularKey"].(string); ok {
 		key.RegularKey = regKey
+	} else {
+		key.IsRemoval = true
 	}
 
 	return key
@@ -664,8 +862,8 @@ func (m *Mapper) mapSetRegularKey(flat xrpltx.FlatTransaction) *pbxrpl.SetRegula
 func (m *Mapper) mapSignerListSet(flat xrpltx.FlatTransaction) *pbxrpl.SignerListSet {
 	sls := &pbxrpl.SignerListSet{}
 
-	if quorum, ok := flat["SignerQuorum"].(float64); ok {
-		sls.SignerQuorum = uint32(quorum)
+	if quorum, ok := uint32FromFlat(flat["SignerQuorum"]); ok {
+		sls.SignerQuorum = quorum
 	}
 
 	if entries, ok := flat["SignerEntries"].([]interface{}); ok {
@@ -685,20 +883,25 @@ func (m *Mapper) mapEscrowCreate(flat xrpltx.FlatTransaction) *pbxrpl.EscrowCrea
 
 	escrow.Amount = m.mapAmountFromFlat(flat["Amount"])
 
-	if cancelAfter, ok := flat["CancelAfter"].(float64); ok {
-		escrow.CancelAfter = uint32(cancelAfter)
+	if cancelAfter, ok := uint32FromFlat(flat["CancelAfter"]); ok {
+		escrow.CancelAfter = cancelAfter
 	}
 
-	if finishAfter, ok := flat["FinishAfter"].(float64); ok {
-		escrow.FinishAfter = uint32(finishAfter)
+	if finishAfter, ok := uint32FromFlat(flat["FinishAfter"]); ok {
+		escrow.FinishAfter = finishAfter
 	}
 
 	if condition, ok := flat["Condition"].(string); ok {
 		escrow.Condition = condition
 	}
 
-	if destTag, ok := flat["DestinationTag"].(float64); ok {
-		escrow.DestinationTag = uint32(destTag)
+	if destTag, ok := uint32FromFlat(flat["DestinationTag"]); ok {
+		escrow.DestinationTag = destTag
+	}
+
+	if sourceTag, ok := uint32FromFlat(flat["SourceTag"]); ok {
+		escrow.SourceTag = sourceTag
+		escrow.HasSourceTag = true
 	}
 
 	return escrow
@@ -711,8 +914,8 @@ func (m *Mapper) mapEscrowFinish(flat xrpltx.FlatTransaction) *pbxrpl.EscrowFini
 		finish.Owner = owner
 	}
 
-	if offerSeq, ok := flat["OfferSequence"].(float64); ok {
-		finish.OfferSequence = uint32(offerSeq)
+	if offerSeq, ok := uint32FromFlat(flat["OfferSequence"]); ok {
+		finish.OfferSequence = offerSeq
 	}
 
 	if condition, ok := flat["Condition"].(string); ok {
@@ -723,9 +926,7 @@ func (m *Mapper) mapEscrowFinish(flat xrpltx.FlatTransaction) *pbxrpl.EscrowFini
 		finish.Fulfillment = fulfillment
 	}
 
-	if credIDs, ok := flat["CredentialIDs"].([]interface{}); ok {
-		finish.CredentialIds = m.mapStringArray(credIDs)
-	}
+	finish.CredentialIds = m.mapStringArray(flat["CredentialIDs"])
 
 	return finish
 }
@@ -737,8 +938,8 @@ func (m *Mapper) mapEscrowCancel(flat xrpltx.FlatTransaction) *pbxrpl.EscrowCanc
 		cancel.Owner = owner
 	}
 
-	if offerSeq, ok := flat["OfferSequence"].(float64); ok {
-		cancel.OfferSequence = uint32(offerSeq)
+	if offerSeq, ok := uint32FromFlat(flat["OfferSequence"]); ok {
+		cancel.OfferSequence = offerSeq
 	}
 
 	return cancel
@@ -754,20 +955,25 @@ func (m *Mapper) mapPaymentChannelCreate(flat xrpltx.FlatTransaction) *pbxrpl.Pa
 
 	pc.Amount = m.mapAmountFromFlat(flat["Amount"])
 
-	if settleDelay, ok := flat["SettleDelay"].(float64); ok {
-		pc.SettleDelay = uint32(settleDelay)
+	if settleDelay, ok := uint32FromFlat(flat["SettleDelay"]); ok {
+		pc.SettleDelay = settleDelay
 	}
 
 	if pubKey, ok := flat["PublicKey"].(string); ok {
 		pc.PublicKey = pubKey
 	}
 
-	if cancelAfter, ok := flat["CancelAfter"].(float64); ok {
-		pc.CancelAfter = uint32(cancelAfter)
+	if cancelAfter, ok := uint32FromFlat(flat["CancelAfter"]); ok {
+		pc.CancelAfter = cancelAfter
+	}
+
+	if destTag, ok := uint32FromFlat(flat["DestinationTag"]); ok {
+		pc.DestinationTag = destTag
 	}
 
-	if destTag, ok := flat["DestinationTag"].(float64); ok {
-		pc.DestinationTag = uint32(destTag)
+	if sourceTag, ok := uint32FromFlat(flat["SourceTag"]); ok {
+		pc.SourceTag = sourceTag
+		pc.HasSourceTag = true
 	}
 
 	return pc
@@ -782,8 +988,8 @@ func (m *Mapper) mapPaymentChannelFund(flat xrpltx.FlatTransaction) *pbxrpl.Paym
 
 	fund.Amount = m.mapAmountFromFlat(flat["Amount"])
 
-	if expiration, ok := flat["Expiration"].(float64); ok {
-		fund.Expiration = uint32(expiration)
+	if expiration, ok := uint32FromFlat(flat["Expiration"]); ok {
+		fund.Expiration = expiration
 	}
 
 	return fund
@@ -807,9 +1013,7 @@ func (m *Mapper) mapPaymentChannelClaim(flat xrpltx.FlatTransaction) *pbxrpl.Pay
 		claim.PublicKey = pubKey
 	}
 
-	if credIDs, ok := flat["CredentialIDs"].([]interface{}); ok {
-		claim.CredentialIds = m.mapStringArray(credIDs)
-	}
+	claim.CredentialIds = m.mapStringArray(flat["CredentialIDs"])
 
 	return claim
 }
@@ -824,12 +1028,12 @@ func (m *Mapper) mapCheckCreate(flat xrpltx.FlatTransaction) *pbxrpl.CheckCreate
 
 	check.SendMax = m.mapAmountFromFlat(flat["SendMax"])
 
-	if expiration, ok := flat["Expiration"].(float64); ok {
-		check.Expiration = uint32(expiration)
+	if expiration, ok := uint32FromFlat(flat["Expiration"]); ok {
+		check.Expiration = expiration
 	}
 
-	if destTag, ok := flat["DestinationTag"].(float64); ok {
-		check.DestinationTag = uint32(destTag)
+	if destTag, ok := uint32FromFlat(flat["DestinationTag"]); ok {
+		check.DestinationTag = destTag
 	}
 
 	if invoiceID, ok := flat["InvoiceID"].(string); ok {
@@ -874,17 +1078,89 @@ func (m *Mapper) mapDepositPreauth(flat xrpltx.FlatTransaction) *pbxrpl.DepositP
 		dp.Unauthorize = unauth
 	}
 
-	// TODO: Map AuthorizeCredentials and UnauthorizeCredentials arrays
+	if creds, ok := flat["AuthorizeCredentials"].([]interface{}); ok {
+		dp.AuthorizeCredentials = m.mapAuthorizeCredentials(creds)
+	}
+
+	if creds, ok := flat["UnauthorizeCredentials"].([]interface{}); ok {
+		dp.UnauthorizeCredentials = m.mapAuthorizeCredentials(creds)
+	}
+
+	dp.Authorization = m.normalizeDepositAuthorization(dp)
 
 	return dp
 }
 
+// normalizeDepositAuthorization collapses the four mutually exclusive DepositPreauth fields into one record
+// Exactly one of them must be set, otherwise a warning is emitted and nil is returned
+func (m *Mapper) normalizeDepositAuthorization(dp *pbxrpl.DepositPreauth) *pbxrpl.DepositAuthorization {
+	var auths []*pbxrpl.DepositAuthorization
+
+	if dp.Authorize != "" {
+		auths = append(auths, &pbxrpl.DepositAuthorization{
+			Direction: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE,
+			Target:    &pbxrpl.DepositAuthorization_Account{Account: dp.Authorize},
+		})
+	}
+	if dp.Unauthorize != "" {
+		auths = append(auths, &pbxrpl.DepositAuthorization{
+			Direction: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE,
+			Target:    &pbxrpl.DepositAuthorization_Account{Account: dp.Unauthorize},
+		})
+	}
+	if len(dp.AuthorizeCredentials) > 0 {
+		auths = append(auths, &pbxrpl.DepositAuthorization{
+			Direction: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE,
+			Target: &pbxrpl.DepositAuthorization_Credentials{
+				Credentials: &pbxrpl.CredentialSet{Credentials: dp.AuthorizeCredentials},
+			},
+		})
+	}
+	if len(dp.UnauthorizeCredentials) > 0 {
+		auths = append(auths, &pbxrpl.DepositAuthorization{
+			Direction: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE,
+			Target: &pbxrpl.DepositAuthorization_Credentials{
+				Credentials: &pbxrpl.CredentialSet{Credentials: dp.UnauthorizeCredentials},
+			},
+		})
+	}
+
+	if len(auths) != 1 {
+		m.warn("DepositPreauth must set exactly one of Authorize, Unauthorize, AuthorizeCredentials, UnauthorizeCredentials",
+			zap.Int("fields_set", len(auths)))
+		return nil
+	}
+
+	return auths[0]
+}
+
+// mapAuthorizeCredentials unwraps the {"Credential": {Issuer, CredentialType}} objects of
+// AuthorizeCredentials/UnauthorizeCredentials, the same way mapSignerEntries unwraps SignerEntry
+func (m *Mapper) mapAuthorizeCredentials(credsRaw []interface{}) []*pbxrpl.AuthorizeCredential {
+	result := make([]*pbxrpl.AuthorizeCredential, 0, len(credsRaw))
+	for _, credRaw := range credsRaw {
+		if wrapper, ok := credRaw.(map[string]interface{}); ok {
+			if cred, ok := wrapper["Credential"].(map[string]interface{}); ok {
+				ac := &pbxrpl.AuthorizeCredential{}
+				if issuer, ok := cred["Issuer"].(string); ok {
+					ac.Issuer = issuer
+				}
+				if credType, ok := cred["CredentialType"].(string); ok {
+					ac.CredentialType = credType
+				}
+				result = append(result, ac)
+			}
+		}
+	}
+	return result
+}
+
 // Ticket
 func (m *Mapper) mapTicketCreate(flat xrpltx.FlatTransaction) *pbxrpl.TicketCreate {
 	ticket := &pbxrpl.TicketCreate{}
 
-	if count, ok := flat["TicketCount"].(float64); ok {
-		ticket.TicketCount = uint32(count)
+	if count, ok := uint32FromFlat(flat["TicketCount"]); ok {
+		ticket.TicketCount = count
 	}
 
 	return ticket
@@ -894,16 +1170,16 @@ func (m *Mapper) mapTicketCreate(flat xrpltx.FlatTransaction) *pbxrpl.TicketCrea
 func (m *Mapper) mapNFTokenMint(flat xrpltx.FlatTransaction) *pbxrpl.NFTokenMint {
 	mint := &pbxrpl.NFTokenMint{}
 
-	if taxon, ok := flat["NFTokenTaxon"].(float64); ok {
-		mint.NftokenTaxon = uint32(taxon)
+	if taxon, ok := uint32FromFlat(flat["NFTokenTaxon"]); ok {
+		mint.NftokenTaxon = taxon
 	}
 
 	if issuer, ok := flat["Issuer"].(string); ok {
 		mint.Issuer = issuer
 	}
 
-	if transferFee, ok := flat["TransferFee"].(float64); ok {
-		mint.TransferFee = uint32(transferFee)
+	if transferFee, ok := uint32FromFlat(flat["TransferFee"]); ok {
+		mint.TransferFee = transferFee
 	}
 
 	if uri, ok := flat["URI"].(string); ok {
@@ -912,17 +1188,142 @@ func (m *Mapper) mapNFTokenMint(flat xrpltx.FlatTransaction) *pbxrpl.NFTokenMint
 
 	mint.Amount = m.mapAmountFromFlat(flat["Amount"])
 
-	if expiration, ok := flat["Expiration"].(float64); ok {
-		mint.Expiration = uint32(expiration)
+	if expiration, ok := uint32FromFlat(flat["Expiration"]); ok {
+		mint.Expiration = expiration
 	}
 
 	if dest, ok := flat["Destination"].(string); ok {
 		mint.Destination = dest
 	}
 
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		mint.Flags = flags
+	}
+
 	return mint
 }
 
+// mintedNFTokenID finds the NFToken an NFTokenMint added, as the one ID present in the NFTokenPage
+// entries after the transaction but not before. JSON metadata carries it directly as nftoken_id
+func mintedNFTokenID(meta map[string]interface{}) string {
+	if tokenID, ok := meta["nftoken_id"].(string); ok {
+		return tokenID
+	}
+
+	before := make(map[string]bool)
+	var after []string
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.LedgerEntryType != "NFTokenPage" {
+			return true
+		}
+
+		switch node.Kind {
+		case createdNode:
+			after = append(after, nftokenIDs(node.NewFields)...)
+		case modifiedNode:
+			after = append(after, nftokenIDs(node.FinalFields)...)
+			for _, tokenID := range nftokenIDs(previousNFTokenFields(node)) {
+				before[tokenID] = true
+			}
+		case deletedNode:
+			// A page merged away on mint still lists its tokens as previously held
+			for _, tokenID := range nftokenIDs(previousNFTokenFields(node)) {
+				before[tokenID] = true
+			}
+		}
+		return true
+	})
+
+	for _, tokenID := range after {
+		if !before[tokenID] {
+			return tokenID
+		}
+	}
+
+	return ""
+}
+
+// previousNFTokenFields returns the fields holding an NFTokenPage's tokens before the transaction:
+// PreviousFields when its NFTokens changed, FinalFields when only the page links did
+func previousNFTokenFields(node affectedNode) map[string]interface{} {
+	if _, ok := node.PreviousFields["NFTokens"]; ok {
+		return node.PreviousFields
+	}
+	return node.FinalFields
+}
+
+// nftokenIDs lists the NFTokenIDs of an NFTokenPage's NFTokens field
+func nftokenIDs(fields map[string]interface{}) []string {
+	tokens, _ := fields["NFTokens"].([]interface{})
+
+	ids := make([]string, 0, len(tokens))
+	for _, tokenRaw := range tokens {
+		wrapper, _ := tokenRaw.(map[string]interface{})
+		token, _ := wrapper["NFToken"].(map[string]interface{})
+		if tokenID, ok := token["NFTokenID"].(string); ok {
+			ids = append(ids, tokenID)
+		}
+	}
+
+	return ids
+}
+
+// createdNFTokenOfferID finds the sell offer an NFTokenMint with an amount created
+// JSON metadata carries it directly as offer_id
+func createdNFTokenOfferID(meta map[string]interface{}) string {
+	if offerID, ok := meta["offer_id"].(string); ok {
+		return offerID
+	}
+
+	var offerID string
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.Kind == createdNode && node.LedgerEntryType == "NFTokenOffer" {
+			offerID = node.LedgerIndex
+			return false
+		}
+		return true
+	})
+
+	return offerID
+}
+
+// lsfSellNFToken marks an NFTokenOffer ledger entry as a sell offer
+const lsfSellNFToken = 0x00000001
+
+// mapNFTokenAcceptOfferMeta records the offers an NFTokenAcceptOffer consumed and the traded NFToken
+func (m *Mapper) mapNFTokenAcceptOfferMeta(accept *pbxrpl.NFTokenAcceptOffer, meta map[string]interface{}) {
+	if accept == nil {
+		return
+	}
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.Kind != deletedNode || node.LedgerEntryType != "NFTokenOffer" {
+			return true
+		}
+
+		offer := &pbxrpl.ConsumedNFTokenOffer{
+			OfferId: node.LedgerIndex,
+			Amount:  m.mapAmountFromFlat(node.FinalFields["Amount"]),
+		}
+		if owner, ok := node.FinalFields["Owner"].(string); ok {
+			offer.Owner = owner
+		}
+		if flags, ok := uint32FromFlat(node.FinalFields["Flags"]); ok {
+			offer.IsSellOffer = flags&lsfSellNFToken != 0
+		}
+		if tokenID, ok := node.FinalFields["NFTokenID"].(string); ok && accept.NftokenId == "" {
+			accept.NftokenId = tokenID
+		}
+
+		accept.ConsumedOffers = append(accept.ConsumedOffers, offer)
+		return true
+	})
+
+	if tokenID, ok := meta["nftoken_id"].(string); ok {
+		accept.NftokenId = tokenID
+	}
+}
+
 func (m *Mapper) mapNFTokenBurn(flat xrpltx.FlatTransaction) *pbxrpl.NFTokenBurn {
 	burn := &pbxrpl.NFTokenBurn{}
 
@@ -954,19 +1355,25 @@ func (m *Mapper) mapNFTokenCreateOffer(flat xrpltx.FlatTransaction) *pbxrpl.NFTo
 		offer.Destination = dest
 	}
 
-	if expiration, ok := flat["Expiration"].(float64); ok {
-		offer.Expiration = uint32(expiration)
+	if expiration, ok := uint32FromFlat(flat["Expiration"]); ok {
+		offer.Expiration = expiration
+	}
+
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		offer.Flags = flags
 	}
+	offer.IsSellOffer = offer.Flags&tfSellNFToken != 0
 
 	return offer
 }
 
+// tfSellNFToken marks an NFTokenCreateOffer as a sell offer
+const tfSellNFToken = 0x00000001
+
 func (m *Mapper) mapNFTokenCancelOffer(flat xrpltx.FlatTransaction) *pbxrpl.NFTokenCancelOffer {
 	cancel := &pbxrpl.NFTokenCancelOffer{}
 
-	if offers, ok := flat["NFTokenOffers"].([]interface{}); ok {
-		cancel.NftokenOffers = m.mapStringArray(offers)
-	}
+	cancel.NftokenOffers = m.mapStringArray(flat["NFTokenOffers"])
 
 	return cancel
 }
@@ -1007,13 +1414,37 @@ func (m *Mapper) mapAMMCreate(flat xrpltx.FlatTransaction) *pbxrpl.AMMCreate {
 	amm.Amount = m.mapAmountFromFlat(flat["Amount"])
 	amm.Amount2 = m.mapAmountFromFlat(flat["Amount2"])
 
-	if tradingFee, ok := flat["TradingFee"].(float64); ok {
-		amm.TradingFee = uint32(tradingFee)
+	if tradingFee, ok := uint32FromFlat(flat["TradingFee"]); ok {
+		amm.TradingFee = tradingFee
 	}
 
 	return amm
 }
 
+// mapAMMCreateMeta attaches the AMM account and LP token created by an AMMCreate
+func (m *Mapper) mapAMMCreateMeta(amm *pbxrpl.AMMCreate, meta map[string]interface{}) {
+	if amm == nil {
+		return
+	}
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.Kind != createdNode || node.LedgerEntryType != "AMM" {
+			return true
+		}
+
+		if account, ok := node.NewFields["Account"].(string); ok {
+			amm.AmmAccount = account
+		}
+		if lpToken, ok := node.NewFields["LPTokenBalance"].(map[string]interface{}); ok {
+			if currency, ok := lpToken["currency"].(string); ok {
+				amm.LpTokenCurrency = currency
+			}
+		}
+
+		return false
+	})
+}
+
 func (m *Mapper) mapAMMDeposit(flat xrpltx.FlatTransaction) *pbxrpl.AMMDeposit {
 	deposit := &pbxrpl.AMMDeposit{}
 
@@ -1024,8 +1455,12 @@ func (m *Mapper) mapAMMDeposit(flat xrpltx.FlatTransaction) *pbxrpl.AMMDeposit {
 	deposit.EPrice = m.mapAmountFromFlat(flat["EPrice"])
 	deposit.LpTokenOut = m.mapAmountFromFlat(flat["LPTokenOut"])
 
-	if tradingFee, ok := flat["TradingFee"].(float64); ok {
-		deposit.TradingFee = uint32(tradingFee)
+	if tradingFee, ok := uint32FromFlat(flat["TradingFee"]); ok {
+		deposit.TradingFee = tradingFee
+	}
+
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		deposit.Flags = flags
 	}
 
 	return deposit
@@ -1041,6 +1476,10 @@ func (m *Mapper) mapAMMWithdraw(flat xrpltx.FlatTransaction) *pbxrpl.AMMWithdraw
 	withdraw.EPrice = m.mapAmountFromFlat(flat["EPrice"])
 	withdraw.LpTokenIn = m.mapAmountFromFlat(flat["LPTokenIn"])
 
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		withdraw.Flags = flags
+	}
+
 	return withdraw
 }
 
@@ -1050,13 +1489,33 @@ func (m *Mapper) mapAMMVote(flat xrpltx.FlatTransaction) *pbxrpl.AMMVote {
 	vote.Asset = m.mapAssetFromFlat(flat["Asset"])
 	vote.Asset2 = m.mapAssetFromFlat(flat["Asset2"])
 
-	if tradingFee, ok := flat["TradingFee"].(float64); ok {
-		vote.TradingFee = uint32(tradingFee)
+	if tradingFee, ok := uint32FromFlat(flat["TradingFee"]); ok {
+		vote.TradingFee = tradingFee
 	}
 
 	return vote
 }
 
+// mapAMMVoteMeta attaches the AMM trading fee resulting from the vote, read from the modified AMM entry
+func (m *Mapper) mapAMMVoteMeta(vote *pbxrpl.AMMVote, meta map[string]interface{}) {
+	if vote == nil {
+		return
+	}
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.Kind != modifiedNode || node.LedgerEntryType != "AMM" {
+			return true
+		}
+
+		// TradingFee is omitted from the AMM entry when it is 0
+		if tradingFee, ok := uint32FromFlat(node.FinalFields["TradingFee"]); ok {
+			vote.EffectiveTradingFee = tradingFee
+		}
+
+		return false
+	})
+}
+
 func (m *Mapper) mapAMMBid(flat xrpltx.FlatTransaction) *pbxrpl.AMMBid {
 	bid := &pbxrpl.AMMBid{}
 
@@ -1092,6 +1551,10 @@ func (m *Mapper) mapAMMClawback(flat xrpltx.FlatTransaction) *pbxrpl.AMMClawback
 	clawback.Asset2 = m.mapAssetFromFlat(flat["Asset2"])
 	clawback.Amount = m.mapAmountFromFlat(flat["Amount"])
 
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		clawback.Flags = flags
+	}
+
 	return clawback
 }
 
@@ -1122,8 +1585,8 @@ func (m *Mapper) mapDIDDelete() *pbxrpl.DIDDelete {
 func (m *Mapper) mapOracleSet(flat xrpltx.FlatTransaction) *pbxrpl.OracleSet {
 	oracle := &pbxrpl.OracleSet{}
 
-	if oracleDocID, ok := flat["OracleDocumentID"].(float64); ok {
-		oracle.OracleDocumentId = uint32(oracleDocID)
+	if oracleDocID, ok := uint32FromFlat(flat["OracleDocumentID"]); ok {
+		oracle.OracleDocumentId = oracleDocID
 	}
 
 	if provider, ok := flat["Provider"].(string); ok {
@@ -1134,8 +1597,8 @@ func (m *Mapper) mapOracleSet(flat xrpltx.FlatTransaction) *pbxrpl.OracleSet {
 		oracle.AssetClass = assetClass
 	}
 
-	if lastUpdateTime, ok := flat["LastUpdateTime"].(float64); ok {
-		oracle.LastUpdateTime = uint32(lastUpdateTime)
+	if lastUpdateTime, ok := uint32FromFlat(flat["LastUpdateTime"]); ok {
+		oracle.LastUpdateTime = lastUpdateTime
 	}
 
 	if priceDataSeries, ok := flat["PriceDataSeries"].([]interface{}); ok {
@@ -1148,8 +1611,8 @@ func (m *Mapper) mapOracleSet(flat xrpltx.FlatTransaction) *pbxrpl.OracleSet {
 func (m *Mapper) mapOracleDelete(flat xrpltx.FlatTransaction) *pbxrpl.OracleDelete {
 	del := &pbxrpl.OracleDelete{}
 
-	if oracleDocID, ok := flat["OracleDocumentID"].(float64); ok {
-		del.OracleDocumentId = uint32(oracleDocID)
+	if oracleDocID, ok := uint32FromFlat(flat["OracleDocumentID"]); ok {
+		del.OracleDocumentId = oracleDocID
 	}
 
 	return del
@@ -1159,18 +1622,16 @@ func (m *Mapper) mapOracleDelete(flat xrpltx.FlatTransaction) *pbxrpl.OracleDele
 func (m *Mapper) mapMPTokenIssuanceCreate(flat xrpltx.FlatTransaction) *pbxrpl.MPTokenIssuanceCreate {
 	create := &pbxrpl.MPTokenIssuanceCreate{}
 
-	if assetScale, ok := flat["AssetScale"].(float64); ok {
-		create.AssetScale = uint32(assetScale)
+	if assetScale, ok := uint32FromFlat(flat["AssetScale"]); ok {
+		create.AssetScale = assetScale
 	}
 
-	if maxAmount, ok := flat["MaximumAmount"].(string); ok {
-		if amount, err := strconv.ParseUint(maxAmount, 10, 64); err == nil {
-			create.MaximumAmount = amount
-		}
+	if maxAmount, ok := uint64FromFlat(flat["MaximumAmount"], m.mptAmountBase); ok {
+		create.MaximumAmount = maxAmount
 	}
 
-	if transferFee, ok := flat["TransferFee"].(float64); ok {
-		create.TransferFee = uint32(transferFee)
+	if transferFee, ok := uint32FromFlat(flat["TransferFee"]); ok {
+		create.TransferFee = transferFee
 	}
 
 	if metadata, ok := flat["MPTokenMetadata"].(string); ok {
@@ -1234,8 +1695,8 @@ func (m *Mapper) mapCredentialCreate(flat xrpltx.FlatTransaction) *pbxrpl.Creden
 		cred.Uri = uri
 	}
 
-	if expiration, ok := flat["Expiration"].(float64); ok {
-		cred.Expiration = uint32(expiration)
+	if expiration, ok := uint32FromFlat(flat["Expiration"]); ok {
+		cred.Expiration = expiration
 	}
 
 	return cred
@@ -1313,19 +1774,52 @@ func (m *Mapper) mapBatch(flat xrpltx.FlatTransaction) *pbxrpl.Batch {
 		batch.BatchSigners = m.mapBatchSigners(batchSigners)
 	}
 
+	if flags, ok := uint32FromFlat(flat["Flags"]); ok {
+		batch.Flags = flags
+	}
+	batch.Mode = batchModeFromFlags(batch.Flags)
+	if batch.Mode == pbxrpl.BatchMode_BATCH_MODE_UNSPECIFIED {
+		m.warn("batch transaction does not set exactly one mode flag", zap.Uint32("flags", batch.Flags))
+	}
+
 	return batch
 }
 
+// Batch mode flags, exactly one must be set
+const (
+	tfAllOrNothing = 0x00010000
+	tfOnlyOne      = 0x00020000
+	tfUntilFailure = 0x00040000
+	tfIndependent  = 0x00080000
+)
+
+// batchModeFromFlags decodes the batch execution mode, UNSPECIFIED unless exactly one mode flag is set
+func batchModeFromFlags(flags uint32) pbxrpl.BatchMode {
+	switch flags & (tfAllOrNothing | tfOnlyOne | tfUntilFailure | tfIndependent) {
+	case tfAllOrNothing:
+		return pbxrpl.BatchMode_BATCH_MODE_ALL_OR_NOTHING
+	case tfOnlyOne:
+		return pbxrpl.BatchMode_BATCH_MODE_ONLY_ONE
+	case tfUntilFailure:
+		return pbxrpl.BatchMode_BATCH_MODE_UNTIL_FAILURE
+	case tfIndependent:
+		return pbxrpl.BatchMode_BATCH_MODE_INDEPENDENT
+	}
+
+	return pbxrpl.BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
 // System transactions (pseudo-transactions)
 func (m *Mapper) mapEnableAmendment(flat xrpltx.FlatTransaction) *pbxrpl.EnableAmendment {
 	amend := &pbxrpl.EnableAmendment{}
 
 	if amendment, ok := flat["Amendment"].(string); ok {
 		amend.Amendment = amendment
+		amend.AmendmentName = AmendmentName(amendment)
 	}
 
-	if ledgerSeq, ok := flat["LedgerSequence"].(float64); ok {
-		amend.LedgerSequence = uint32(ledgerSeq)
+	if ledgerSeq, ok := uint32FromFlat(flat["LedgerSequence"]); ok {
+		amend.LedgerSequence = ledgerSeq
 	}
 
 	return amend
@@ -1334,26 +1828,30 @@ func (m *Mapper) mapEnableAmendment(flat xrpltx.FlatTransaction) *pbxrpl.EnableA
 func (m *Mapper) mapSetFee(flat xrpltx.FlatTransaction) *pbxrpl.SetFee {
 	fee := &pbxrpl.SetFee{}
 
-	if baseFee, ok := flat["BaseFee"].(string); ok {
-		if parsed, err := strconv.ParseUint(baseFee, 10, 64); err == nil {
-			fee.BaseFee = parsed
-		}
+	// BaseFee is a UInt64, in hex from the codec and in rippled's JSON alike
+	if baseFee, ok := uint64FromFlat(flat["BaseFee"], 16); ok {
+		fee.BaseFee = baseFee
 	}
 
-	if refFeeUnits, ok := flat["ReferenceFeeUnits"].(float64); ok {
-		fee.ReferenceFeeUnits = uint32(refFeeUnits)
+	if refFeeUnits, ok := uint32FromFlat(flat["ReferenceFeeUnits"]); ok {
+		fee.ReferenceFeeUnits = refFeeUnits
 	}
 
-	if reserveBase, ok := flat["ReserveBase"].(float64); ok {
-		fee.ReserveBase = uint32(reserveBase)
+	if reserveBase, ok := uint32FromFlat(flat["ReserveBase"]); ok {
+		fee.ReserveBase = reserveBase
 	}
 
-	if reserveInc, ok := flat["ReserveIncrement"].(float64); ok {
-		fee.ReserveIncrement = uint32(reserveInc)
+	if reserveInc, ok := uint32FromFlat(flat["ReserveIncrement"]); ok {
+		fee.ReserveIncrement = reserveInc
 	}
 
-	if ledgerSeq, ok := flat["LedgerSequence"].(float64); ok {
-		fee.LedgerSequence = uint32(ledgerSeq)
+	// Since the XRPLFees amendment, fee votes use drops amounts in place of the fields above
+	fee.BaseFeeDrops = m.mapAmountFromFlat(flat["BaseFeeDrops"])
+	fee.ReserveBaseDrops = m.mapAmountFromFlat(flat["ReserveBaseDrops"])
+	fee.ReserveIncrementDrops = m.mapAmountFromFlat(flat["ReserveIncrementDrops"])
+
+	if ledgerSeq, ok := uint32FromFlat(flat["LedgerSequence"]); ok {
+		fee.LedgerSequence = ledgerSeq
 	}
 
 	return fee
@@ -1362,11 +1860,11 @@ func (m *Mapper) mapSetFee(flat xrpltx.FlatTransaction) *pbxrpl.SetFee {
 func (m *Mapper) mapUNLModify(flat xrpltx.FlatTransaction) *pbxrpl.UNLModify {
 	unl := &pbxrpl.UNLModify{}
 
-	if ledgerSeq, ok := flat["LedgerSequence"].(float64); ok {
-		unl.LedgerSequence = uint32(ledgerSeq)
+	if ledgerSeq, ok := uint32FromFlat(flat["LedgerSequence"]); ok {
+		unl.LedgerSequence = ledgerSeq
 	}
 
-	if unlModifyDisabling, ok := flat["UNLModifyDisabling"].(float64); ok {
+	if unlModifyDisabling, ok := uint32FromFlat(flat["UNLModifyDisabling"]); ok {
 		unl.UnlModifyDisabling = unlModifyDisabling != 0
 	}
 
@@ -1379,17 +1877,53 @@ func (m *Mapper) mapUNLModify(flat xrpltx.FlatTransaction) *pbxrpl.UNLModify {
 
 // Helper functions for complex nested structures
 
-func (m *Mapper) mapStringArray(arr []interface{}) []string {
-	result := make([]string, 0, len(arr))
-	for _, item := range arr {
-		if str, ok := item.(string); ok {
-			result = append(result, str)
+// uint32FromFlat reads an unsigned integer field from a decoded transaction
+// binarycodec yields uint32 for UInt32 fields and int for UInt8/UInt16 fields,
+// while JSON-decoded transactions carry float64
+func uint32FromFlat(raw interface{}) (uint32, bool) {
+	switch v := raw.(type) {
+	case uint32:
+		return v, true
+	case int:
+		return uint32(v), true
+	case float64:
+		return uint32(v), true
+	}
+
+	return 0, false
+}
+
+// uint64FromFlat reads a UInt64 field from a decoded transaction or ledger entry, a string in base
+// base: binarycodec yields every UInt64 field in hex, rippled's JSON the MPT amounts in base 10
+func uint64FromFlat(raw interface{}, base int) (uint64, bool) {
+	str, ok := raw.(string)
+	if !ok {
+		return 0, false
+	}
+
+	v, err := strconv.ParseUint(str, base, 64)
+	return v, err == nil
+}
+
+// mapStringArray reads a list of strings: a Vector256 the binary codec decodes as []string, or a JSON array
+func (m *Mapper) mapStringArray(raw interface{}) []string {
+	switch arr := raw.(type) {
+	case []string:
+		return arr
+	case []interface{}:
+		result := make([]string, 0, len(arr))
+		for _, item := range arr {
+			if str, ok := item.(string); ok {
+				result = append(result, str)
+			}
 		}
+		return result
 	}
-	return result
+	return nil
 }
 
-func (m *Mapper) mapPaths(pathsRaw []interface{}) []*pbxrpl.Path {
+// MapPaths converts JSON-shaped paths (a list of lists of account/currency/issuer steps) to protobuf
+func MapPaths(pathsRaw []interface{}) []*pbxrpl.Path {
 	result := make([]*pbxrpl.Path, 0, len(pathsRaw))
 	for _, pathRaw := range pathsRaw {
 		if pathArr, ok := pathRaw.([]interface{}); ok {
@@ -1417,6 +1951,15 @@ func (m *Mapper) mapPaths(pathsRaw []interface{}) []*pbxrpl.Path {
 	return result
 }
 
+// sameAsset reports whether two amounts are denominated in the same asset (XRP, token or MPT)
+func sameAsset(a, b *pbxrpl.Amount) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Currency == b.Currency && a.Issuer == b.Issuer && a.MptIssuanceId == b.MptIssuanceId
+}
+
 func (m *Mapper) mapAssetFromFlat(assetRaw interface{}) *pbxrpl.Asset {
 	if assetRaw == nil {
 		return nil
@@ -1448,8 +1991,8 @@ func (m *Mapper) mapSignerEntries(entriesRaw []interface{}) []*pbxrpl.SignerEntr
 				if account, ok := entry["Account"].(string); ok {
 					se.Account = account
 				}
-				if weight, ok := entry["SignerWeight"].(float64); ok {
-					se.SignerWeight = uint32(weight)
+				if weight, ok := uint32FromFlat(entry["SignerWeight"]); ok {
+					se.SignerWeight = weight
 				}
 				if walletLocator, ok := entry["WalletLocator"].(string); ok {
 					se.WalletLocator = walletLocator
@@ -1491,6 +2034,9 @@ func (m *Mapper) mapIssue(issueMap map[string]interface{}) *pbxrpl.Asset {
 	return issue
 }
 
+// maxOracleScale is the largest Scale an OracleSet PriceData entry may carry
+const maxOracleScale = 10
+
 func (m *Mapper) mapPriceDataSeries(seriesRaw []interface{}) []*pbxrpl.PriceData {
 	result := make([]*pbxrpl.PriceData, 0, len(seriesRaw))
 	for _, dataRaw := range seriesRaw {
@@ -1503,13 +2049,34 @@ func (m *Mapper) mapPriceDataSeries(seriesRaw []interface{}) []*pbxrpl.PriceData
 				if quoteAsset, ok := data["QuoteAsset"].(string); ok {
 					pd.QuoteAsset = quoteAsset
 				}
-				if assetPrice, ok := data["AssetPrice"].(string); ok {
-					if parsed, err := strconv.ParseUint(assetPrice, 10, 64); err == nil {
-						pd.AssetPrice = parsed
+				// UInt64 fields are decoded as hex strings
+				assetPriceRaw, hasPrice := data["AssetPrice"]
+				priceValid := false
+				if hasPrice {
+					if assetPrice, ok := uint64FromFlat(assetPriceRaw, 16); ok {
+						pd.AssetPrice = assetPrice
+						priceValid = true
+					} else {
+						m.warn("invalid oracle price data asset price",
+							zap.String("base_asset", pd.BaseAsset),
+							zap.String("quote_asset", pd.QuoteAsset),
+							zap.Any("asset_price", assetPriceRaw))
+					}
+				}
+				if scale, ok := uint32FromFlat(data["Scale"]); ok {
+					pd.Scale = scale
+					if scale > maxOracleScale {
+						m.warn("oracle price data scale out of range, leaving the price empty",
+							zap.String("base_asset", pd.BaseAsset),
+							zap.String("quote_asset", pd.QuoteAsset),
+							zap.Uint32("scale", scale),
+							zap.Uint32("max_scale", maxOracleScale))
+						priceValid = false
 					}
 				}
-				if scale, ok := data["Scale"].(float64); ok {
-					pd.Scale = uint32(scale)
+				pd.IsRemoval = !hasPrice
+				if priceValid {
+					pd.Price = oraclePrice(pd.AssetPrice, pd.Scale)
 				}
 				result = append(result, pd)
 			}
@@ -1518,19 +2085,80 @@ func (m *Mapper) mapPriceDataSeries(seriesRaw []interface{}) []*pbxrpl.PriceData
 	return result
 }
 
+// oraclePrice computes the effective price of an oracle pair, assetPrice * 10^-scale, as a decimal string
+func oraclePrice(assetPrice uint64, scale uint32) string {
+	price, _ := utils.NormalizeIOUValue(fmt.Sprintf("%de-%d", assetPrice, scale))
+	return price
+}
+
+// mapRawTransactions re-encodes the inner transactions of a Batch, decoded by the codec as objects,
+// to their binary blobs
 func (m *Mapper) mapRawTransactions(txsRaw []interface{}) []*pbxrpl.RawTransaction {
 	result := make([]*pbxrpl.RawTransaction, 0, len(txsRaw))
-	for _, txRaw := range txsRaw {
-		if txMap, ok := txRaw.(map[string]interface{}); ok {
-			rt := &pbxrpl.RawTransaction{}
-			// The raw transaction would be bytes/hex encoded
-			if rawTx, ok := txMap["RawTransaction"].(string); ok {
-				// Convert hex string to bytes
-				rt.RawTransaction = []byte(rawTx)
+	for _, inner := range rawTransactionObjects(txsRaw) {
+		rt := &pbxrpl.RawTransaction{}
+		if inner != nil {
+			blob, err := encodeFlat(inner)
+			if err != nil {
+				m.warn("failed to encode batch inner transaction", zap.Error(err))
 			}
-			result = append(result, rt)
+			rt.RawTransaction = blob
+		}
+		result = append(result, rt)
+	}
+	return result
+}
+
+// rawTransactionObjects returns the inner transaction object of each RawTransactions entry,
+// nil for a malformed entry so positions match the mapped raw transactions
+func rawTransactionObjects(txsRaw []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(txsRaw))
+	for _, txRaw := range txsRaw {
+		if wrapper, ok := txRaw.(map[string]interface{}); ok {
+			inner, _ := wrapper["RawTransaction"].(map[string]interface{})
+			result = append(result, inner)
+		}
+	}
+	return result
+}
+
+// encodeFlat serializes a decoded object back to its binary form
+func encodeFlat(flat map[string]interface{}) ([]byte, error) {
+	encoded, err := binarycodec.Encode(flat)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(encoded)
+}
+
+// mapBatchInnerTransactions maps the inner transactions of a Batch like top-level transactions,
+// hashing the blobs of its raw transactions
+func (m *Mapper) mapBatchInnerTransactions(flat xrpltx.FlatTransaction, batch *pbxrpl.Batch) []*pbxrpl.Transaction {
+	rawTxs, _ := flat["RawTransactions"].([]interface{})
+	inners := rawTransactionObjects(rawTxs)
+
+	result := make([]*pbxrpl.Transaction, 0, len(inners))
+	for i, inner := range inners {
+		blob := batch.RawTransactions[i].RawTransaction
+		if inner == nil || len(blob) == 0 {
+			continue
 		}
+
+		// rippled rejects nested batches, checked so a malformed one cannot recurse
+		if inner["TransactionType"] == "Batch" {
+			m.warn("batch inner transaction is itself a batch, skipping", zap.Int("inner_index", i))
+			continue
+		}
+
+		innerTx, err := m.MapTransactionToProto(inner, nil, blob, nil, utils.TransactionHash(blob), uint32(i), "")
+		if err != nil {
+			m.warn("failed to map batch inner transaction", zap.Int("inner_index", i), zap.Error(err))
+			continue
+		}
+		result = append(result, innerTx)
 	}
+
 	return result
 }
 
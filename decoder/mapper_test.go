@@ -0,0 +1,1065 @@
+package decoder
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUint64FromFlat(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    interface{}
+		base   int
+		want   uint64
+		wantOK bool
+	}{
+		{"codec hex", "000000000000000A", 16, 10, true},
+		{"codec hex of 16 digits", "00038D7EA4C68000", 16, 1_000_000_000_000_000, true},
+		{"JSON base 10", "12345", 10, 12345, true},
+		{"JSON base 10 of 16 digits", "1000000000000000", 10, 1_000_000_000_000_000, true},
+		{"hex digit in base 10", "1A", 10, 0, false},
+		{"not a string", float64(10), 10, 0, false},
+		{"absent", nil, 16, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := uint64FromFlat(tt.raw, tt.base)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMapMPTBalanceBase(t *testing.T) {
+	node := func(final, previous string) affectedNode {
+		return affectedNode{
+			Kind:            modifiedNode,
+			LedgerEntryType: "MPToken",
+			FinalFields: map[string]interface{}{
+				"Account":           "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"MPTokenIssuanceID": "00000001B5F762798A53D543A014CAF8B297CFF8F2F937E8",
+				"MPTAmount":         final,
+			},
+			PreviousFields: map[string]interface{}{"MPTAmount": previous},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		mapper       *Mapper
+		node         affectedNode
+		wantFinal    uint64
+		wantPrevious uint64
+	}{
+		{"binary codec", NewMapper(zap.NewNop()), node("00038D7EA4C68000", "0000000000000064"), 1_000_000_000_000_000, 100},
+		{"rippled JSON", newJSONMapper(zap.NewNop()), node("1000000000000000", "100"), 1_000_000_000_000_000, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balance := tt.mapper.mapMPTBalance(tt.node)
+			assert.Equal(t, tt.wantFinal, balance.FinalAmount)
+			assert.Equal(t, tt.wantPrevious, balance.PreviousAmount)
+		})
+	}
+}
+
+func TestMapDepositPreauthCredentials(t *testing.T) {
+	credentials := []interface{}{
+		map[string]interface{}{"Credential": map[string]interface{}{
+			"Issuer":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"CredentialType": "4B5943",
+		}},
+		map[string]interface{}{"NotACredential": map[string]interface{}{}},
+	}
+	want := []*pbxrpl.AuthorizeCredential{{Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", CredentialType: "4B5943"}}
+
+	tests := []struct {
+		name            string
+		flat            map[string]interface{}
+		wantAuthorize   []*pbxrpl.AuthorizeCredential
+		wantUnauthorize []*pbxrpl.AuthorizeCredential
+		wantDirection   pbxrpl.DepositAuthorizationDirection
+	}{
+		{
+			name:          "authorize credentials",
+			flat:          map[string]interface{}{"AuthorizeCredentials": credentials},
+			wantAuthorize: want,
+			wantDirection: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE,
+		},
+		{
+			name:            "unauthorize credentials",
+			flat:            map[string]interface{}{"UnauthorizeCredentials": credentials},
+			wantUnauthorize: want,
+			wantDirection:   pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dp := NewMapper(zap.NewNop()).mapDepositPreauth(tt.flat)
+			assert.Equal(t, len(tt.wantAuthorize), len(dp.AuthorizeCredentials))
+			for i, cred := range tt.wantAuthorize {
+				assert.True(t, proto.Equal(cred, dp.AuthorizeCredentials[i]))
+			}
+			assert.Equal(t, len(tt.wantUnauthorize), len(dp.UnauthorizeCredentials))
+			for i, cred := range tt.wantUnauthorize {
+				assert.True(t, proto.Equal(cred, dp.UnauthorizeCredentials[i]))
+			}
+			if assert.NotNil(t, dp.Authorization) {
+				assert.Equal(t, tt.wantDirection, dp.Authorization.Direction)
+			}
+		})
+	}
+}
+
+func TestMapPaymentUsesDefaultPath(t *testing.T) {
+	usd := map[string]interface{}{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "value": "10"}
+	paths := []interface{}{[]interface{}{map[string]interface{}{"currency": "EUR", "issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}}}
+
+	tests := []struct {
+		name string
+		flat map[string]interface{}
+		want bool
+	}{
+		{"XRP to XRP", map[string]interface{}{"Amount": "1000000"}, false},
+		{"same currency with SendMax", map[string]interface{}{"Amount": usd, "SendMax": usd}, false},
+		{"cross-currency", map[string]interface{}{"Amount": usd, "SendMax": "1000000"}, true},
+		{"cross-currency with paths", map[string]interface{}{"Amount": usd, "SendMax": "1000000", "Paths": paths}, true},
+		{"cross-currency without the direct path", map[string]interface{}{"Amount": usd, "SendMax": "1000000", "Paths": paths, "Flags": uint32(tfNoRippleDirect)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment := NewMapper(zap.NewNop()).mapPayment(tt.flat)
+			assert.Equal(t, tt.want, payment.UsesDefaultPath)
+		})
+	}
+}
+
+// testMeta wraps affected nodes, each given as {kind: fields}, into transaction metadata
+func testMeta(nodes ...map[string]interface{}) map[string]interface{} {
+	affected := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		affected = append(affected, node)
+	}
+	return map[string]interface{}{"AffectedNodes": affected}
+}
+
+func TestMapAMMCreateMeta(t *testing.T) {
+	ammNode := map[string]interface{}{createdNode: map[string]interface{}{
+		"LedgerEntryType": "AMM",
+		"NewFields": map[string]interface{}{
+			"Account": "rLjUKpwUVmz3vCTmFkXungxwzdoyrWRsFG",
+			"LPTokenBalance": map[string]interface{}{
+				"currency": "03930D02208264E2E40EC1B0C09E4DB96EE197B1",
+				"issuer":   "rLjUKpwUVmz3vCTmFkXungxwzdoyrWRsFG",
+				"value":    "1000",
+			},
+		},
+	}}
+	accountNode := map[string]interface{}{modifiedNode: map[string]interface{}{
+		"LedgerEntryType": "AccountRoot",
+		"FinalFields":     map[string]interface{}{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+	}}
+
+	tests := []struct {
+		name         string
+		meta         map[string]interface{}
+		wantAccount  string
+		wantCurrency string
+	}{
+		{"created AMM", testMeta(accountNode, ammNode), "rLjUKpwUVmz3vCTmFkXungxwzdoyrWRsFG", "03930D02208264E2E40EC1B0C09E4DB96EE197B1"},
+		{"failed AMMCreate", testMeta(accountNode), "", ""},
+		{"no metadata", nil, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amm := &pbxrpl.AMMCreate{}
+			NewMapper(zap.NewNop()).mapAMMCreateMeta(amm, tt.meta)
+			assert.Equal(t, tt.wantAccount, amm.AmmAccount)
+			assert.Equal(t, tt.wantCurrency, amm.LpTokenCurrency)
+		})
+	}
+}
+
+func TestMapSigningMode(t *testing.T) {
+	signers := []*pbxrpl.Signer{{Account: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}}
+
+	tests := []struct {
+		name            string
+		tx              *pbxrpl.Transaction
+		wantMultisigned bool
+		wantWarnings    uint64
+	}{
+		{"single-signed", &pbxrpl.Transaction{SigningPubKey: "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A"}, false, 0},
+		{"multi-signed", &pbxrpl.Transaction{Signers: signers}, true, 0},
+		{"pseudo-transaction", &pbxrpl.Transaction{}, false, 0},
+		{"both signing fields", &pbxrpl.Transaction{SigningPubKey: "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A", Signers: signers}, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := NewMapper(zap.NewNop())
+			mapper.mapSigningMode(tt.tx)
+			assert.Equal(t, tt.wantMultisigned, tt.tx.IsMultisigned)
+			assert.Equal(t, tt.wantWarnings, mapper.WarningCount())
+		})
+	}
+}
+
+func TestMapSourceTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		flat    map[string]interface{}
+		wantTag uint32
+		wantHas bool
+	}{
+		{"absent", map[string]interface{}{}, 0, false},
+		{"zero", map[string]interface{}{"SourceTag": uint32(0)}, 0, true},
+		{"set", map[string]interface{}{"SourceTag": uint32(12345)}, 12345, true},
+	}
+
+	mapper := NewMapper(zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			escrow := mapper.mapEscrowCreate(tt.flat)
+			assert.Equal(t, tt.wantTag, escrow.SourceTag)
+			assert.Equal(t, tt.wantHas, escrow.HasSourceTag)
+
+			channel := mapper.mapPaymentChannelCreate(tt.flat)
+			assert.Equal(t, tt.wantTag, channel.SourceTag)
+			assert.Equal(t, tt.wantHas, channel.HasSourceTag)
+		})
+	}
+}
+
+// priceDataSeries wraps a PriceData entry as it appears in an OracleSet PriceDataSeries
+func priceDataSeries(data map[string]interface{}) []interface{} {
+	return []interface{}{map[string]interface{}{"PriceData": data}}
+}
+
+func TestMapPriceDataScale(t *testing.T) {
+	tests := []struct {
+		name           string
+		assetPrice     string
+		scale          uint32
+		wantAssetPrice uint64
+		wantPrice      string
+		wantWarnings   uint64
+	}{
+		{"no scale", "0000000000000074", 0, 116, "116", 0},
+		{"maximum scale", "0000000000000074", maxOracleScale, 116, "0.0000000116", 0},
+		{"scale out of range", "0000000000000074", maxOracleScale + 1, 116, "", 1},
+		{"invalid asset price", "not hex", 2, 0, "", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := NewMapper(zap.NewNop())
+			series := mapper.mapPriceDataSeries(priceDataSeries(map[string]interface{}{
+				"BaseAsset":  "XRP",
+				"QuoteAsset": "USD",
+				"AssetPrice": tt.assetPrice,
+				"Scale":      tt.scale,
+			}))
+			if assert.Len(t, series, 1) {
+				assert.Equal(t, tt.scale, series[0].Scale)
+				assert.Equal(t, tt.wantAssetPrice, series[0].AssetPrice)
+				assert.Equal(t, tt.wantPrice, series[0].Price)
+				assert.False(t, series[0].IsRemoval)
+			}
+			assert.Equal(t, tt.wantWarnings, mapper.WarningCount())
+		})
+	}
+}
+
+func TestOraclePrice(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetPrice uint64
+		scale      uint32
+		want       string
+	}{
+		{"scaled", 12345, 4, "1.2345"},
+		{"unscaled", 42, 0, "42"},
+		{"trailing zeros", 1500, 3, "1.5"},
+		{"below one", 5, 10, "0.0000000005"},
+		{"zero", 0, 2, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, oraclePrice(tt.assetPrice, tt.scale))
+		})
+	}
+}
+
+func TestMapOracleSetFixture(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/oracle_set_removed_pair.hex")
+	require.NoError(t, err)
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	protoTx, err := NewDecoder(zap.NewNop()).MapTransactionToProto(strings.TrimSpace(string(fixture)), paymentMetaBlob, hash, 0)
+	require.NoError(t, err)
+	require.NotNil(t, protoTx.GetOracleSet())
+
+	series := protoTx.GetOracleSet().PriceDataSeries
+	require.Len(t, series, 3)
+
+	tests := []struct {
+		quoteAsset  string
+		assetPrice  uint64
+		scale       uint32
+		wantPrice   string
+		wantRemoval bool
+	}{
+		{"USD", 12345, 4, "1.2345", false},
+		{"EUR", 42, 0, "42", false},
+		{"JPY", 0, 0, "", true},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.quoteAsset, func(t *testing.T) {
+			pd := series[i]
+			assert.Equal(t, "XRP", pd.BaseAsset)
+			assert.Equal(t, tt.quoteAsset, pd.QuoteAsset)
+			assert.Equal(t, tt.assetPrice, pd.AssetPrice)
+			assert.Equal(t, tt.scale, pd.Scale)
+			assert.Equal(t, tt.wantPrice, pd.Price)
+			assert.Equal(t, tt.wantRemoval, pd.IsRemoval)
+		})
+	}
+}
+
+func TestBatchModeFromFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags uint32
+		want  pbxrpl.BatchMode
+	}{
+		{"all or nothing", tfAllOrNothing, pbxrpl.BatchMode_BATCH_MODE_ALL_OR_NOTHING},
+		{"only one", tfOnlyOne, pbxrpl.BatchMode_BATCH_MODE_ONLY_ONE},
+		{"until failure", tfUntilFailure, pbxrpl.BatchMode_BATCH_MODE_UNTIL_FAILURE},
+		{"independent", tfIndependent, pbxrpl.BatchMode_BATCH_MODE_INDEPENDENT},
+		{"with a universal flag", tfIndependent | 0x80000000, pbxrpl.BatchMode_BATCH_MODE_INDEPENDENT},
+		{"no mode", 0, pbxrpl.BatchMode_BATCH_MODE_UNSPECIFIED},
+		{"two modes", tfAllOrNothing | tfOnlyOne, pbxrpl.BatchMode_BATCH_MODE_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, batchModeFromFlags(tt.flags))
+		})
+	}
+}
+
+func TestMapBatchModeWarning(t *testing.T) {
+	mapper := NewMapper(zap.NewNop())
+
+	batch := mapper.mapBatch(map[string]interface{}{"Flags": uint32(tfOnlyOne)})
+	assert.Equal(t, pbxrpl.BatchMode_BATCH_MODE_ONLY_ONE, batch.Mode)
+	assert.Zero(t, mapper.WarningCount())
+
+	batch = mapper.mapBatch(map[string]interface{}{})
+	assert.Equal(t, pbxrpl.BatchMode_BATCH_MODE_UNSPECIFIED, batch.Mode)
+	assert.Equal(t, uint64(1), mapper.WarningCount())
+}
+
+func TestNormalizeDepositAuthorization(t *testing.T) {
+	credentials := []*pbxrpl.AuthorizeCredential{{Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", CredentialType: "4B5943"}}
+
+	tests := []struct {
+		name          string
+		dp            *pbxrpl.DepositPreauth
+		wantDirection pbxrpl.DepositAuthorizationDirection
+		wantAccount   string
+		wantCreds     int
+		wantNil       bool
+	}{
+		{
+			name:          "authorize account",
+			dp:            &pbxrpl.DepositPreauth{Authorize: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+			wantDirection: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE,
+			wantAccount:   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		},
+		{
+			name:          "unauthorize account",
+			dp:            &pbxrpl.DepositPreauth{Unauthorize: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+			wantDirection: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE,
+			wantAccount:   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		},
+		{
+			name:          "authorize credentials",
+			dp:            &pbxrpl.DepositPreauth{AuthorizeCredentials: credentials},
+			wantDirection: pbxrpl.DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE,
+			wantCreds:     1,
+		},
+		{
+			name:    "nothing set",
+			dp:      &pbxrpl.DepositPreauth{},
+			wantNil: true,
+		},
+		{
+			name:    "two fields set",
+			dp:      &pbxrpl.DepositPreauth{Authorize: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", UnauthorizeCredentials: credentials},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := NewMapper(zap.NewNop())
+			auth := mapper.normalizeDepositAuthorization(tt.dp)
+			if tt.wantNil {
+				assert.Nil(t, auth)
+				assert.Equal(t, uint64(1), mapper.WarningCount())
+				return
+			}
+
+			require.NotNil(t, auth)
+			assert.Equal(t, tt.wantDirection, auth.Direction)
+			assert.Equal(t, tt.wantAccount, auth.GetAccount())
+			assert.Len(t, auth.GetCredentials().GetCredentials(), tt.wantCreds)
+			assert.Zero(t, mapper.WarningCount())
+		})
+	}
+}
+
+func TestMapAMMVoteMeta(t *testing.T) {
+	ammNode := func(fields map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{modifiedNode: map[string]interface{}{
+			"LedgerEntryType": "AMM",
+			"FinalFields":     fields,
+		}}
+	}
+
+	tests := []struct {
+		name string
+		meta map[string]interface{}
+		want uint32
+	}{
+		{"binary codec fee", testMeta(ammNode(map[string]interface{}{"TradingFee": 250})), 250},
+		{"JSON fee", testMeta(ammNode(map[string]interface{}{"TradingFee": float64(600)})), 600},
+		{"fee voted down to 0", testMeta(ammNode(map[string]interface{}{})), 0},
+		{"no AMM entry", testMeta(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vote := &pbxrpl.AMMVote{TradingFee: 500}
+			NewMapper(zap.NewNop()).mapAMMVoteMeta(vote, tt.meta)
+			assert.Equal(t, tt.want, vote.EffectiveTradingFee)
+			assert.Equal(t, uint32(500), vote.TradingFee)
+		})
+	}
+}
+
+func TestMapCheckCashDeliveredAmount(t *testing.T) {
+	tests := []struct {
+		name string
+		meta map[string]interface{}
+		want *pbxrpl.Amount
+	}{
+		{
+			name: "XRP",
+			meta: map[string]interface{}{"DeliveredAmount": "1000000"},
+			want: &pbxrpl.Amount{Value: "1000000", Kind: pbxrpl.AmountKind_AMOUNT_KIND_XRP},
+		},
+		{
+			name: "token in JSON metadata",
+			meta: map[string]interface{}{"delivered_amount": map[string]interface{}{
+				"currency": "USD",
+				"issuer":   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"value":    "12.5",
+			}},
+			want: &pbxrpl.Amount{Value: "12.5", Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Kind: pbxrpl.AmountKind_AMOUNT_KIND_IOU},
+		},
+		{
+			name: "failed cash",
+			meta: map[string]interface{}{"TransactionResult": "tecNO_ENTRY"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &pbxrpl.Transaction{}
+			flat := map[string]interface{}{"CheckID": "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0", "Amount": "1000000"}
+			NewMapper(zap.NewNop()).mapTxDetails(tx, flat, tt.meta, "CheckCash")
+
+			cash := tx.GetCheckCash()
+			require.NotNil(t, cash)
+			assert.True(t, proto.Equal(tt.want, cash.DeliveredAmount), "got %v", cash.DeliveredAmount)
+		})
+	}
+}
+
+func TestMapNFTokenCreateOfferSide(t *testing.T) {
+	tests := []struct {
+		name     string
+		flags    interface{}
+		wantSell bool
+	}{
+		{"buy offer", nil, false},
+		{"sell offer", uint32(tfSellNFToken), true},
+		{"sell offer with a universal flag", uint32(tfSellNFToken | 0x80000000), true},
+		{"sell offer from JSON", float64(tfSellNFToken), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flat := map[string]interface{}{
+				"NFTokenID": "000800006203F49C21D5D6E022CB16DE3538F248662FC73C29ABA6A90000000D",
+				"Amount":    "1000000",
+			}
+			if tt.flags != nil {
+				flat["Flags"] = tt.flags
+			}
+
+			offer := NewMapper(zap.NewNop()).mapNFTokenCreateOffer(flat)
+			assert.Equal(t, tt.wantSell, offer.IsSellOffer)
+			assert.Equal(t, "1000000", offer.Amount.GetValue())
+		})
+	}
+}
+
+func TestMapSetRegularKeyRemoval(t *testing.T) {
+	tests := []struct {
+		name        string
+		flat        map[string]interface{}
+		wantKey     string
+		wantRemoval bool
+	}{
+		{"set key", map[string]interface{}{"RegularKey": "rAR8rR8sUkBoCZFawhkWzY4Y5YoyuznwD"}, "rAR8rR8sUkBoCZFawhkWzY4Y5YoyuznwD", false},
+		{"remove key", map[string]interface{}{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := NewMapper(zap.NewNop()).mapSetRegularKey(tt.flat)
+			assert.Equal(t, tt.wantKey, key.RegularKey)
+			assert.Equal(t, tt.wantRemoval, key.IsRemoval)
+		})
+	}
+}
+
+func TestMapNFTokenMintDirectSale(t *testing.T) {
+	tests := []struct {
+		name            string
+		flat            map[string]interface{}
+		wantAmount      string
+		wantDestination string
+		wantExpiration  uint32
+		wantFlags       uint32
+	}{
+		{
+			name:      "plain mint",
+			flat:      map[string]interface{}{"NFTokenTaxon": uint32(0), "Flags": uint32(0x00000008)},
+			wantFlags: 0x00000008,
+		},
+		{
+			name: "mint with a sell offer",
+			flat: map[string]interface{}{
+				"NFTokenTaxon": uint32(0),
+				"Amount":       "1000000",
+				"Destination":  "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Expiration":   uint32(800000000),
+				"Flags":        uint32(0x00000009),
+			},
+			wantAmount:      "1000000",
+			wantDestination: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			wantExpiration:  800000000,
+			wantFlags:       0x00000009,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mint := NewMapper(zap.NewNop()).mapNFTokenMint(tt.flat)
+			assert.Equal(t, tt.wantAmount, mint.Amount.GetValue())
+			assert.Equal(t, tt.wantDestination, mint.Destination)
+			assert.Equal(t, tt.wantExpiration, mint.Expiration)
+			assert.Equal(t, tt.wantFlags, mint.Flags)
+		})
+	}
+}
+
+func TestMapTrustSetMeta(t *testing.T) {
+	const account, peer = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+
+	rippleState := func(kind, low, high string, flags interface{}) map[string]interface{} {
+		fields := map[string]interface{}{
+			"LowLimit":  map[string]interface{}{"currency": "USD", "issuer": low, "value": "0"},
+			"HighLimit": map[string]interface{}{"currency": "USD", "issuer": high, "value": "100"},
+		}
+		if flags != nil {
+			fields["Flags"] = flags
+		}
+		fieldsKey := "FinalFields"
+		if kind == createdNode {
+			fieldsKey = "NewFields"
+		}
+		return map[string]interface{}{kind: map[string]interface{}{"LedgerEntryType": "RippleState", fieldsKey: fields}}
+	}
+
+	tests := []struct {
+		name            string
+		meta            map[string]interface{}
+		wantState       bool
+		wantAccountNoRp bool
+		wantPeerNoRp    bool
+	}{
+		{"account is low", testMeta(rippleState(modifiedNode, account, peer, uint32(lsfLowNoRipple))), true, true, false},
+		{"account is high", testMeta(rippleState(modifiedNode, peer, account, uint32(lsfLowNoRipple))), true, false, true},
+		{"both sides from JSON", testMeta(rippleState(modifiedNode, account, peer, float64(lsfLowNoRipple|lsfHighNoRipple))), true, true, true},
+		{"created without flags", testMeta(rippleState(createdNode, account, peer, nil)), true, false, false},
+		{"other trust line", testMeta(rippleState(modifiedNode, account, "rAR8rR8sUkBoCZFawhkWzY4Y5YoyuznwD", uint32(lsfLowNoRipple))), false, false, false},
+		{"no trust line change", testMeta(), false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trust := &pbxrpl.TrustSet{LimitAmount: &pbxrpl.Amount{Currency: "USD", Issuer: peer, Value: "100"}}
+			NewMapper(zap.NewNop()).mapTrustSetMeta(trust, account, tt.meta)
+			assert.Equal(t, tt.wantState, trust.HasTrustLineState)
+			assert.Equal(t, tt.wantAccountNoRp, trust.AccountNoRipple)
+			assert.Equal(t, tt.wantPeerNoRp, trust.PeerNoRipple)
+		})
+	}
+}
+
+func TestMapDeliveredAmount(t *testing.T) {
+	tests := []struct {
+		name string
+		meta map[string]interface{}
+		want *pbxrpl.Amount
+	}{
+		{
+			name: "binary codec XRP",
+			meta: map[string]interface{}{"DeliveredAmount": "5000000"},
+			want: &pbxrpl.Amount{Value: "5000000", Kind: pbxrpl.AmountKind_AMOUNT_KIND_XRP},
+		},
+		{
+			name: "binary codec token",
+			meta: map[string]interface{}{"DeliveredAmount": map[string]interface{}{
+				"currency": "USD",
+				"issuer":   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"value":    "0.25",
+			}},
+			want: &pbxrpl.Amount{Value: "0.25", Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Kind: pbxrpl.AmountKind_AMOUNT_KIND_IOU},
+		},
+		{
+			name: "capitalized token fields",
+			meta: map[string]interface{}{"DeliveredAmount": map[string]interface{}{
+				"Currency": "USD",
+				"Issuer":   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Value":    "0.25",
+			}},
+			want: &pbxrpl.Amount{Value: "0.25", Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Kind: pbxrpl.AmountKind_AMOUNT_KIND_IOU},
+		},
+		{
+			name: "JSON MPT",
+			meta: map[string]interface{}{"delivered_amount": map[string]interface{}{
+				"mpt_issuance_id": "00000001B5F762798A53D543A014CAF8B297CFF8F2F937E8",
+				"value":           "100",
+			}},
+			want: &pbxrpl.Amount{Value: "100", MptIssuanceId: "00000001B5F762798A53D543A014CAF8B297CFF8F2F937E8", Kind: pbxrpl.AmountKind_AMOUNT_KIND_MPT},
+		},
+		{
+			name: "unavailable before the field existed",
+			meta: map[string]interface{}{"delivered_amount": "unavailable"},
+		},
+		{
+			name: "absent",
+			meta: map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &pbxrpl.Transaction{}
+			NewMapper(zap.NewNop()).mapTxDetails(tx, map[string]interface{}{"Amount": "5000000"}, tt.meta, "Payment")
+
+			payment := tx.GetPayment()
+			require.NotNil(t, payment)
+			assert.True(t, proto.Equal(tt.want, payment.DeliveredAmount), "got %v", payment.DeliveredAmount)
+		})
+	}
+}
+
+func TestMapTransactionFee(t *testing.T) {
+	tests := []struct {
+		name         string
+		fee          string
+		want         uint64
+		wantWarnings uint64
+	}{
+		{"typical", "12", 12, 0},
+		{"above 32 bits", "4294967296", 4294967296, 0},
+		{"whole XRP supply", "100000000000000000", 100_000_000_000_000_000, 0},
+		{"above the XRP supply", "100000000000000001", 0, 1},
+		{"malformed", "12.5", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := NewMapper(zap.NewNop())
+			flat := map[string]interface{}{
+				"TransactionType": "AccountSet",
+				"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":             tt.fee,
+			}
+
+			tx, err := mapper.MapTransactionToProto(flat, nil, nil, nil, make([]byte, 32), 0, "tesSUCCESS")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tx.Fee)
+			assert.Equal(t, tt.wantWarnings, mapper.WarningCount())
+		})
+	}
+}
+
+func TestMapOfferCreateZeroAmount(t *testing.T) {
+	usd := func(value string) map[string]interface{} {
+		return map[string]interface{}{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "value": value}
+	}
+
+	tests := []struct {
+		name         string
+		takerGets    interface{}
+		takerPays    interface{}
+		wantWarnings uint64
+	}{
+		{"regular offer", "1000000", usd("1"), 0},
+		{"zero XRP", "0", usd("1"), 1},
+		{"zero token", "1000000", usd("0"), 1},
+		{"zero token in exponent form", "1000000", usd("0e-15"), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := NewMapper(zap.NewNop())
+			offer := mapper.mapOfferCreate(map[string]interface{}{
+				"TakerGets":     tt.takerGets,
+				"TakerPays":     tt.takerPays,
+				"OfferSequence": uint32(7),
+			})
+			assert.NotNil(t, offer.TakerGets)
+			assert.NotNil(t, offer.TakerPays)
+			assert.Equal(t, uint32(7), offer.OfferSequence)
+			assert.Equal(t, tt.wantWarnings, mapper.WarningCount())
+		})
+	}
+}
+
+// nftokenPage builds the NFTokens field of an NFTokenPage holding ids
+func nftokenPage(ids ...string) map[string]interface{} {
+	tokens := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		tokens = append(tokens, map[string]interface{}{"NFToken": map[string]interface{}{"NFTokenID": id}})
+	}
+	return map[string]interface{}{"NFTokens": tokens}
+}
+
+func TestMintedNFTokenID(t *testing.T) {
+	const (
+		held   = "000800006203F49C21D5D6E022CB16DE3538F248662FC73C29ABA6A90000000D"
+		minted = "000800006203F49C21D5D6E022CB16DE3538F248662FC73C29ABA6A90000000E"
+		other  = "000800006203F49C21D5D6E022CB16DE3538F248662FC73C29ABA6A90000000F"
+	)
+
+	page := func(kind string, fields map[string]map[string]interface{}) map[string]interface{} {
+		node := map[string]interface{}{"LedgerEntryType": "NFTokenPage"}
+		for name, value := range fields {
+			node[name] = value
+		}
+		return map[string]interface{}{kind: node}
+	}
+
+	tests := []struct {
+		name string
+		meta map[string]interface{}
+		want string
+	}{
+		{
+			name: "JSON metadata",
+			meta: map[string]interface{}{"nftoken_id": minted},
+			want: minted,
+		},
+		{
+			name: "token added to a page",
+			meta: testMeta(page(modifiedNode, map[string]map[string]interface{}{
+				"FinalFields":    nftokenPage(held, minted),
+				"PreviousFields": nftokenPage(held),
+			})),
+			want: minted,
+		},
+		{
+			name: "first page created",
+			meta: testMeta(page(createdNode, map[string]map[string]interface{}{"NewFields": nftokenPage(minted)})),
+			want: minted,
+		},
+		{
+			name: "page split, only the links of the other page changed",
+			meta: testMeta(
+				page(modifiedNode, map[string]map[string]interface{}{
+					"FinalFields":    nftokenPage(other),
+					"PreviousFields": {"PreviousPageMin": "00"},
+				}),
+				page(createdNode, map[string]map[string]interface{}{"NewFields": nftokenPage(held, minted)}),
+				page(deletedNode, map[string]map[string]interface{}{"FinalFields": nftokenPage(held)}),
+			),
+			want: minted,
+		},
+		{
+			name: "failed mint",
+			meta: testMeta(),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mintedNFTokenID(tt.meta))
+		})
+	}
+}
+
+func TestMapAccountSetRateAndTickSize(t *testing.T) {
+	tests := []struct {
+		name             string
+		flat             map[string]interface{}
+		wantPercent      string
+		wantInvalidRate  bool
+		wantInvalidTick  bool
+		wantWarningCount uint64
+	}{
+		{"half a percent fee", map[string]interface{}{"TransferRate": uint32(1005000000)}, "0.5", false, false, 0},
+		{"rate below parity", map[string]interface{}{"TransferRate": uint32(999999999)}, "", true, false, 1},
+		{"valid tick size", map[string]interface{}{"TickSize": 5}, "", false, false, 0},
+		{"tick size too large", map[string]interface{}{"TickSize": 16}, "", false, true, 1},
+		{"both out of range", map[string]interface{}{"TransferRate": uint32(2000000001), "TickSize": 1}, "100.0000001", true, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMapper(zap.NewNop())
+			acct := m.mapAccountSet(tt.flat)
+			assert.Equal(t, tt.wantPercent, acct.TransferRatePercent)
+			assert.Equal(t, tt.wantInvalidRate, acct.InvalidTransferRate)
+			assert.Equal(t, tt.wantInvalidTick, acct.InvalidTickSize)
+			assert.Equal(t, tt.wantWarningCount, m.WarningCount())
+		})
+	}
+}
+
+func TestMapAmountNormalizesValue(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		want         string
+		wantWarnings uint64
+	}{
+		{"exponent", "1.0E-5", "0.00001", 0},
+		{"negative", "-0.5", "-0.5", 0},
+		{"large mantissa", "1000000000000000e-3", "1000000000000", 0},
+		{"malformed kept verbatim", "1.2.3", "1.2.3", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMapper(zap.NewNop())
+			amount := m.mapAmountFromFlat(map[string]interface{}{
+				"currency": "USD",
+				"issuer":   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"value":    tt.value,
+			})
+			require.NotNil(t, amount)
+			assert.Equal(t, tt.want, amount.Value)
+			assert.Equal(t, tt.wantWarnings, m.WarningCount())
+		})
+	}
+}
+
+func TestMapStateChangesByResult(t *testing.T) {
+	meta := testMeta(map[string]interface{}{modifiedNode: map[string]interface{}{
+		"LedgerEntryType": "AccountRoot",
+		"LedgerIndex":     "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		"FinalFields":     map[string]interface{}{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Balance": "999988"},
+		"PreviousFields":  map[string]interface{}{"Balance": "1000000"},
+	}})
+
+	tests := []struct {
+		result           string
+		wantApplied      bool
+		wantStateChanges int
+	}{
+		{"tesSUCCESS", true, 1},
+		{"tecPATH_DRY", true, 1},
+		{"tefPAST_SEQ", false, 0},
+		{"telINSUF_FEE_P", false, 0},
+		{"temBAD_AMOUNT", false, 0},
+		{"terQUEUED", false, 0},
+		{"tarUNKNOWN", false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result, func(t *testing.T) {
+			flat := map[string]interface{}{"TransactionType": "AccountSet", "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Fee": "12"}
+			protoTx, err := NewMapper(zap.NewNop()).MapTransactionToProto(flat, meta, nil, nil, nil, 0, tt.result)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantApplied, protoTx.Applied)
+			assert.Len(t, protoTx.StateChanges, tt.wantStateChanges)
+		})
+	}
+}
+
+func TestMapUnknownTransactionType(t *testing.T) {
+	tests := []struct {
+		name        string
+		flat        map[string]interface{}
+		wantUnknown bool
+	}{
+		{
+			name: "synthetic unknown type",
+			flat: map[string]interface{}{
+				"TransactionType": "SyntheticUnknownTx",
+				"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":             "12",
+				"Sequence":        uint32(5),
+				"NewField":        "ABCD",
+				"NewHashes":       []string{"42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE"},
+			},
+			wantUnknown: true,
+		},
+		{
+			name:        "mapped type",
+			flat:        map[string]interface{}{"TransactionType": "AccountSet", "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Fee": "12"},
+			wantUnknown: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protoTx, err := NewMapper(zap.NewNop()).MapTransactionToProto(tt.flat, nil, nil, nil, nil, 0, "tesSUCCESS")
+			require.NoError(t, err)
+
+			details := protoTx.GetUnknownDetails()
+			if !tt.wantUnknown {
+				assert.Nil(t, details)
+				return
+			}
+			require.NotNil(t, details)
+			fields := details.AsMap()
+			assert.Equal(t, "SyntheticUnknownTx", fields["TransactionType"])
+			assert.Equal(t, "ABCD", fields["NewField"])
+			assert.Equal(t, float64(5), fields["Sequence"])
+			assert.Equal(t, []interface{}{"42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE"}, fields["NewHashes"])
+		})
+	}
+}
+
+func TestMapUnknownTransactionTypeUnconvertible(t *testing.T) {
+	mapper := NewMapper(zap.NewNop())
+
+	protoTx, err := mapper.MapTransactionToProto(map[string]interface{}{
+		"TransactionType": "SyntheticUnknownTx",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Fee":             "12",
+		"NewField":        []uint32{1, 2},
+	}, nil, nil, nil, nil, 0, "tesSUCCESS")
+	require.NoError(t, err)
+
+	assert.Nil(t, protoTx.TxDetails)
+	assert.Equal(t, uint64(1), mapper.WarningCount())
+}
+
+func TestMapAccountSetFlagNames(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want pbxrpl.AccountSetFlag
+	}{
+		{1, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_REQUIRE_DEST},
+		{2, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_REQUIRE_AUTH},
+		{3, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_XRP},
+		{4, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DISABLE_MASTER},
+		{5, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_ACCOUNT_TXN_ID},
+		{6, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_NO_FREEZE},
+		{7, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_GLOBAL_FREEZE},
+		{8, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DEFAULT_RIPPLE},
+		{9, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DEPOSIT_AUTH},
+		{10, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_AUTHORIZED_NFTOKEN_MINTER},
+		{12, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_NFTOKEN_OFFER},
+		{13, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_CHECK},
+		{14, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_PAY_CHAN},
+		{15, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_TRUSTLINE},
+		{16, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_CLAWBACK},
+		{17, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_LOCKING},
+		// Unnamed codes pass through as their number
+		{11, pbxrpl.AccountSetFlag(11)},
+		{99, pbxrpl.AccountSetFlag(99)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want.String(), func(t *testing.T) {
+			acct := NewMapper(zap.NewNop()).mapAccountSet(map[string]interface{}{"SetFlag": tt.code, "ClearFlag": tt.code})
+			assert.Equal(t, tt.code, acct.SetFlag)
+			assert.Equal(t, tt.want, acct.SetAccountFlag)
+			assert.Equal(t, tt.code, acct.ClearFlag)
+			assert.Equal(t, tt.want, acct.ClearAccountFlag)
+		})
+	}
+
+	acct := NewMapper(zap.NewNop()).mapAccountSet(map[string]interface{}{})
+	assert.Equal(t, pbxrpl.AccountSetFlag_ACCOUNT_SET_FLAG_UNSPECIFIED, acct.SetAccountFlag)
+}
+
+func TestMapMemosText(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     map[string]interface{}
+		wantText string
+	}{
+		{
+			name: "text memo",
+			memo: map[string]interface{}{
+				"MemoData":   "68656C6C6F207872706C",
+				"MemoFormat": "746578742F706C61696E",
+				"MemoType":   "636F6D6D656E74",
+			},
+			wantText: "hello xrpl",
+		},
+		{
+			name: "binary memo",
+			memo: map[string]interface{}{
+				"MemoData":   "FF00FE01",
+				"MemoFormat": "6170706C69636174696F6E2F6F637465742D73747265616D",
+			},
+			wantText: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memos := NewMapper(zap.NewNop()).mapMemosFromFlat([]interface{}{
+				map[string]interface{}{"Memo": tt.memo},
+			})
+			require.Len(t, memos, 1)
+			assert.Equal(t, tt.wantText, memos[0].MemoText)
+			// The raw hex is kept as is
+			assert.Equal(t, tt.memo["MemoData"], memos[0].MemoData)
+			assert.Equal(t, tt.memo["MemoFormat"], memos[0].MemoFormat)
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package decoder
+
+import (
+	"encoding/hex"
+
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Affected node kinds as they appear in transaction metadata
+const (
+	createdNode  = "CreatedNode"
+	modifiedNode = "ModifiedNode"
+	deletedNode  = "DeletedNode"
+)
+
+// affectedNode is a single entry of the metadata AffectedNodes array
+type affectedNode struct {
+	Kind            string
+	LedgerEntryType string
+	LedgerIndex     string
+	NewFields       map[string]interface{}
+	FinalFields     map[string]interface{}
+	PreviousFields  map[string]interface{}
+}
+
+// forEachAffectedNode walks the AffectedNodes of decoded metadata in order,
+// stopping early when fn returns false
+func forEachAffectedNode(meta map[string]interface{}, fn func(node affectedNode) bool) {
+	nodesRaw, ok := meta["AffectedNodes"].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, nodeRaw := range nodesRaw {
+		wrapper, ok := nodeRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Each wrapper holds exactly one of CreatedNode, ModifiedNode or DeletedNode
+		for kind, inner := range wrapper {
+			fields, ok := inner.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			node := affectedNode{Kind: kind}
+			if entryType, ok := fields["LedgerEntryType"].(string); ok {
+				node.LedgerEntryType = entryType
+			}
+			if ledgerIndex, ok := fields["LedgerIndex"].(string); ok {
+				node.LedgerIndex = ledgerIndex
+			}
+			if newFields, ok := fields["NewFields"].(map[string]interface{}); ok {
+				node.NewFields = newFields
+			}
+			if finalFields, ok := fields["FinalFields"].(map[string]interface{}); ok {
+				node.FinalFields = finalFields
+			}
+			if previousFields, ok := fields["PreviousFields"].(map[string]interface{}); ok {
+				node.PreviousFields = previousFields
+			}
+
+			if !fn(node) {
+				return
+			}
+		}
+	}
+}
+
+// modTypes maps affected node kinds to their protobuf enum
+var modTypes = map[string]pbxrpl.ModType{
+	createdNode:  pbxrpl.ModType_MOD_TYPE_CREATED,
+	modifiedNode: pbxrpl.ModType_MOD_TYPE_MODIFIED,
+	deletedNode:  pbxrpl.ModType_MOD_TYPE_DELETED,
+}
+
+// entryTypes maps LedgerEntryType names to their protobuf enum
+var entryTypes = map[string]pbxrpl.EntryType{
+	"AccountRoot":                     pbxrpl.EntryType_ENTRY_TYPE_ACCOUNT_ROOT,
+	"Amendments":                      pbxrpl.EntryType_ENTRY_TYPE_AMENDMENTS,
+	"AMM":                             pbxrpl.EntryType_ENTRY_TYPE_AMM,
+	"Bridge":                          pbxrpl.EntryType_ENTRY_TYPE_BRIDGE,
+	"Check":                           pbxrpl.EntryType_ENTRY_TYPE_CHECK,
+	"Credential":                      pbxrpl.EntryType_ENTRY_TYPE_CREDENTIAL,
+	"Delegate":                        pbxrpl.EntryType_ENTRY_TYPE_DELEGATE,
+	"DepositPreauth":                  pbxrpl.EntryType_ENTRY_TYPE_DEPOSIT_PREAUTH,
+	"DID":                             pbxrpl.EntryType_ENTRY_TYPE_DID,
+	"DirectoryNode":                   pbxrpl.EntryType_ENTRY_TYPE_DIRECTORY_NODE,
+	"Escrow":                          pbxrpl.EntryType_ENTRY_TYPE_ESCROW,
+	"FeeSettings":                     pbxrpl.EntryType_ENTRY_TYPE_FEE_SETTINGS,
+	"LedgerHashes":                    pbxrpl.EntryType_ENTRY_TYPE_LEDGER_HASHES,
+	"MPToken":                         pbxrpl.EntryType_ENTRY_TYPE_MPTOKEN,
+	"MPTokenIssuance":                 pbxrpl.EntryType_ENTRY_TYPE_MPTOKEN_ISSUANCE,
+	"NegativeUNL":                     pbxrpl.EntryType_ENTRY_TYPE_NEGATIVE_UNL,
+	"NFTokenOffer":                    pbxrpl.EntryType_ENTRY_TYPE_NFTOKEN_OFFER,
+	"NFTokenPage":                     pbxrpl.EntryType_ENTRY_TYPE_NFTOKEN_PAGE,
+	"Offer":                           pbxrpl.EntryType_ENTRY_TYPE_OFFER,
+	"Oracle":                          pbxrpl.EntryType_ENTRY_TYPE_ORACLE,
+	"PayChannel":                      pbxrpl.EntryType_ENTRY_TYPE_PAY_CHANNEL,
+	"PermissionedDomain":              pbxrpl.EntryType_ENTRY_TYPE_PERMISSIONED_DOMAIN,
+	"RippleState":                     pbxrpl.EntryType_ENTRY_TYPE_RIPPLE_STATE,
+	"SignerList":                      pbxrpl.EntryType_ENTRY_TYPE_SIGNER_LIST,
+	"Ticket":                          pbxrpl.EntryType_ENTRY_TYPE_TICKET,
+	"Vault":                           pbxrpl.EntryType_ENTRY_TYPE_VAULT,
+	"XChainOwnedClaimID":              pbxrpl.EntryType_ENTRY_TYPE_XCHAIN_OWNED_CLAIM_ID,
+	"XChainOwnedCreateAccountClaimID": pbxrpl.EntryType_ENTRY_TYPE_XCHAIN_OWNED_CREATE_ACCOUNT_CLAIM_ID,
+}
+
+// mapStateChanges converts every affected node of the metadata to a StateChange,
+// keeping NewFields, FinalFields and PreviousFields as Structs
+func (m *Mapper) mapStateChanges(meta map[string]interface{}) []*pbxrpl.StateChange {
+	// Size the result once, nodes are then converted one at a time without an intermediate slice
+	nodes, _ := meta["AffectedNodes"].([]interface{})
+	changes := make([]*pbxrpl.StateChange, 0, len(nodes))
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		change := &pbxrpl.StateChange{
+			ModType:         modTypes[node.Kind],
+			EntryType:       entryTypes[node.LedgerEntryType],
+			LedgerEntryType: node.LedgerEntryType,
+			NewFields:       m.fieldsToStruct(node, "NewFields", node.NewFields),
+			FinalFields:     m.fieldsToStruct(node, "FinalFields", node.FinalFields),
+			PreviousFields:  m.fieldsToStruct(node, "PreviousFields", node.PreviousFields),
+			MptBalance:      m.mapMPTBalance(node),
+		}
+
+		if key, err := hex.DecodeString(node.LedgerIndex); err == nil {
+			change.Key = key
+		} else {
+			m.warn("invalid affected node LedgerIndex",
+				zap.String("ledger_index", node.LedgerIndex),
+				zap.Error(err))
+		}
+
+		changes = append(changes, change)
+		return true
+	})
+
+	return changes
+}
+
+// mptAmountFields is the field holding the MPT amount of each MPT ledger entry type
+var mptAmountFields = map[string]string{
+	"MPToken":         "MPTAmount",
+	"MPTokenIssuance": "OutstandingAmount",
+}
+
+// mapMPTBalance extracts the holder balance of an MPToken or the outstanding supply of an
+// MPTokenIssuance, nil for other entries. Amounts absent from the entry are 0
+func (m *Mapper) mapMPTBalance(node affectedNode) *pbxrpl.MPTBalance {
+	amountField, ok := mptAmountFields[node.LedgerEntryType]
+	if !ok {
+		return nil
+	}
+
+	fields := node.FinalFields
+	if node.Kind == createdNode {
+		fields = node.NewFields
+	}
+
+	balance := &pbxrpl.MPTBalance{}
+	balance.FinalAmount, _ = uint64FromFlat(fields[amountField], m.mptAmountBase)
+	balance.PreviousAmount = balance.FinalAmount
+	if node.Kind == createdNode {
+		balance.PreviousAmount = 0
+	} else if previous, ok := node.PreviousFields[amountField]; ok {
+		balance.PreviousAmount, _ = uint64FromFlat(previous, m.mptAmountBase)
+	}
+
+	if node.LedgerEntryType == "MPToken" {
+		balance.MptIssuanceId, _ = fields["MPTokenIssuanceID"].(string)
+		balance.Holder, _ = fields["Account"].(string)
+		return balance
+	}
+
+	// An issuance does not store its ID, it is derived from the issuer and the creating sequence
+	issuer, _ := fields["Issuer"].(string)
+	sequence, _ := uint32FromFlat(fields["Sequence"])
+	issuanceID, err := utils.MPTIssuanceID(issuer, sequence)
+	if err != nil {
+		m.warn("invalid MPTokenIssuance issuer",
+			zap.String("ledger_index", node.LedgerIndex),
+			zap.Error(err))
+	}
+	balance.MptIssuanceId = issuanceID
+
+	return balance
+}
+
+// fieldsToStruct converts one of an affected node's field sets, nil when absent
+func (m *Mapper) fieldsToStruct(node affectedNode, name string, fields map[string]interface{}) *structpb.Struct {
+	if fields == nil {
+		return nil
+	}
+
+	s, err := newStruct(fields)
+	if err != nil {
+		m.warn("failed to convert affected node fields to struct",
+			zap.String("ledger_index", node.LedgerIndex),
+			zap.String("fields", name),
+			zap.Error(err))
+		return nil
+	}
+
+	return s
+}
+
+// newStruct converts decoded fields to a Struct. The binary codec decodes Vector256 fields
+// (Amendments, Hashes, NFTokenOffers...) as []string, which structpb rejects, so lists are
+// converted to []interface{} throughout first
+func newStruct(fields map[string]interface{}) (*structpb.Struct, error) {
+	return structpb.NewStruct(structFields(fields))
+}
+
+// structFields returns a copy of fields whose lists structpb can convert
+func structFields(fields map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		result[key] = structValue(value)
+	}
+	return result
+}
+
+// structValue returns value with its []string lists, nested or not, converted to []interface{}
+func structValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []string:
+		result := make([]interface{}, len(v))
+		for i, str := range v {
+			result[i] = str
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = structValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		return structFields(v)
+	}
+	return value
+}
+
+// mapNFTokenTransfers derives the NFToken owner changes of a transaction from its NFTokenPage
+// entries. Tokens are collected per owner before and after the transaction, so a page split or
+// merge within one account cancels out and only tokens that changed owner are reported
+func (m *Mapper) mapNFTokenTransfers(meta map[string]interface{}) []*pbxrpl.NFTokenTransfer {
+	before := make(map[string]string)
+	after := make(map[string]string)
+	var tokenIDs []string
+
+	hold := func(held map[string]string, owner string, ids []string) {
+		for _, tokenID := range ids {
+			if _, seen := before[tokenID]; !seen {
+				if _, seen := after[tokenID]; !seen {
+					tokenIDs = append(tokenIDs, tokenID)
+				}
+			}
+			held[tokenID] = owner
+		}
+	}
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.LedgerEntryType != "NFTokenPage" {
+			return true
+		}
+
+		owner, err := utils.NFTokenPageOwner(node.LedgerIndex)
+		if err != nil {
+			m.warn("invalid NFTokenPage LedgerIndex", zap.Error(err))
+			return true
+		}
+
+		switch node.Kind {
+		case createdNode:
+			hold(after, owner, nftokenIDs(node.NewFields))
+		case modifiedNode:
+			hold(before, owner, nftokenIDs(previousNFTokenFields(node)))
+			hold(after, owner, nftokenIDs(node.FinalFields))
+		case deletedNode:
+			hold(before, owner, nftokenIDs(previousNFTokenFields(node)))
+		}
+		return true
+	})
+
+	var transfers []*pbxrpl.NFTokenTransfer
+	for _, tokenID := range tokenIDs {
+		from, to := before[tokenID], after[tokenID]
+		if from == to {
+			continue
+		}
+		transfers = append(transfers, &pbxrpl.NFTokenTransfer{NftokenId: tokenID, From: from, To: to})
+	}
+
+	return transfers
+}
+
+// mapNFTokenOfferChanges lists the NFTokenOffer entries a transaction created or deleted
+func (m *Mapper) mapNFTokenOfferChanges(meta map[string]interface{}) []*pbxrpl.NFTokenOfferChange {
+	var changes []*pbxrpl.NFTokenOfferChange
+
+	forEachAffectedNode(meta, func(node affectedNode) bool {
+		if node.LedgerEntryType != "NFTokenOffer" {
+			return true
+		}
+
+		var fields map[string]interface{}
+		switch node.Kind {
+		case createdNode:
+			fields = node.NewFields
+		case deletedNode:
+			fields = node.FinalFields
+		default:
+			return true
+		}
+
+		change := &pbxrpl.NFTokenOfferChange{
+			ModType: modTypes[node.Kind],
+			OfferId: node.LedgerIndex,
+			Amount:  m.mapAmountFromFlat(fields["Amount"]),
+		}
+		if tokenID, ok := fields["NFTokenID"].(string); ok {
+			change.NftokenId = tokenID
+		}
+		if owner, ok := fields["Owner"].(string); ok {
+			change.Owner = owner
+		}
+		if dest, ok := fields["Destination"].(string); ok {
+			change.Destination = dest
+		}
+		if flags, ok := uint32FromFlat(fields["Flags"]); ok {
+			change.IsSellOffer = flags&lsfSellNFToken != 0
+		}
+
+		changes = append(changes, change)
+		return true
+	})
+
+	return changes
+}
@@ -0,0 +1,294 @@
+package decoder
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// largeMeta builds the metadata of a transaction touching count AccountRoot entries, the shape of
+// a large AMM or Batch transaction
+func largeMeta(count int) map[string]interface{} {
+	nodes := make([]map[string]interface{}, count)
+	for i := range nodes {
+		nodes[i] = map[string]interface{}{modifiedNode: map[string]interface{}{
+			"LedgerEntryType": "AccountRoot",
+			"LedgerIndex":     fmt.Sprintf("%064X", i+1),
+			"FinalFields": map[string]interface{}{
+				"Account":    "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Balance":    "99999988",
+				"Flags":      uint32(0),
+				"OwnerCount": uint32(0),
+				"Sequence":   uint32(i + 1),
+			},
+			"PreviousFields": map[string]interface{}{
+				"Balance":  "100000000",
+				"Sequence": uint32(i),
+			},
+		}}
+	}
+
+	meta := testMeta(nodes...)
+	meta["TransactionIndex"] = uint32(0)
+	meta["TransactionResult"] = "tesSUCCESS"
+	return meta
+}
+
+func TestMapStateChangesLarge(t *testing.T) {
+	changes := NewMapper(zap.NewNop()).mapStateChanges(largeMeta(5000))
+	require.Len(t, changes, 5000)
+
+	last := changes[4999]
+	assert.Equal(t, pbxrpl.ModType_MOD_TYPE_MODIFIED, last.ModType)
+	assert.Equal(t, pbxrpl.EntryType_ENTRY_TYPE_ACCOUNT_ROOT, last.EntryType)
+	assert.Len(t, last.Key, 32)
+	assert.Equal(t, byte(0x88), last.Key[31]) // 5000 = 0x1388
+	assert.Equal(t, "100000000", last.PreviousFields.Fields["Balance"].GetStringValue())
+}
+
+// BenchmarkMapStateChanges measures the state change mapping of a 5000 node metadata, already decoded
+func BenchmarkMapStateChanges(b *testing.B) {
+	meta := largeMeta(5000)
+	mapper := NewMapper(zap.NewNop())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		changes := mapper.mapStateChanges(meta)
+		if len(changes) != 5000 {
+			b.Fatalf("got %d state changes", len(changes))
+		}
+	}
+}
+
+// BenchmarkDecodeLargeMeta measures decoding a 5000 node metadata blob and mapping its state changes,
+// the memory a large AMM or Batch transaction costs
+func BenchmarkDecodeLargeMeta(b *testing.B) {
+	metaHex, err := binarycodec.Encode(largeMeta(5000))
+	require.NoError(b, err)
+	b.ReportMetric(float64(len(metaHex)/2), "meta-bytes")
+
+	dec := NewDecoder(zap.NewNop())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		meta, err := dec.DecodeMetadataFromHex(metaHex)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if changes := dec.DecodeStateChanges(meta); len(changes) != 5000 {
+			b.Fatalf("got %d state changes", len(changes))
+		}
+	}
+}
+
+// The NFToken moved by testdata/nftoken_accept_offer.json, from nftSeller's page to nftBuyer's
+const (
+	nftSeller  = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	nftBuyer   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	nftTokenID = "00080000B5F762798A53D543A014CAF8B297CFF8F2F937E8B7DFAC7A00000003"
+	nftOfferID = "68CD1F6F906494EA08C9CB5CAFA64DFA90D4E834B7151899B73231DE5A0C3B77"
+)
+
+// mapTxFixture maps a testdata transaction recorded as its tx_blob and meta
+func mapTxFixture(t *testing.T, name string) *pbxrpl.Transaction {
+	t.Helper()
+
+	fixture, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+
+	var tx struct {
+		TxBlob string `json:"tx_blob"`
+		Meta   string `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(fixture, &tx))
+
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	protoTx, err := NewDecoder(zap.NewNop()).MapTransactionToProto(tx.TxBlob, tx.Meta, hash, 0)
+	require.NoError(t, err)
+	return protoTx
+}
+
+func TestMapNFTokenAcceptOfferFixture(t *testing.T) {
+	protoTx := mapTxFixture(t, "nftoken_accept_offer.json")
+	require.NotNil(t, protoTx.GetNftokenAcceptOffer())
+
+	// Only the sold token moved, the one left on the seller's page did not
+	require.Len(t, protoTx.NftokenTransfers, 1)
+	transfer := protoTx.NftokenTransfers[0]
+	assert.True(t, strings.EqualFold(nftTokenID, transfer.NftokenId))
+	assert.Equal(t, nftSeller, transfer.From)
+	assert.Equal(t, nftBuyer, transfer.To)
+
+	require.Len(t, protoTx.NftokenOfferChanges, 1)
+	change := protoTx.NftokenOfferChanges[0]
+	assert.Equal(t, pbxrpl.ModType_MOD_TYPE_DELETED, change.ModType)
+	assert.True(t, strings.EqualFold(nftOfferID, change.OfferId))
+	assert.True(t, strings.EqualFold(nftTokenID, change.NftokenId))
+	assert.Equal(t, nftSeller, change.Owner)
+	assert.True(t, change.IsSellOffer)
+	assert.Equal(t, "1000000", change.Amount.Value)
+}
+
+// TestMapNFTokenAcceptOfferBrokered maps a brokered accept: the broker matches the seller's sell offer
+// with the buyer's buy offer, both offers are deleted and the token moves from seller to buyer
+func TestMapNFTokenAcceptOfferBrokered(t *testing.T) {
+	const nftBuyOfferID = "3A35B2A4EDF5F4D2BB6C6A0A3D5F2E7D8B3F4C1D2E3F4A5B6C7D8E9FA0B1C2D3"
+
+	protoTx := mapTxFixture(t, "nftoken_accept_offer_brokered.json")
+	accept := protoTx.GetNftokenAcceptOffer()
+	require.NotNil(t, accept)
+	assert.True(t, strings.EqualFold(nftOfferID, accept.NftokenSellOffer))
+	assert.True(t, strings.EqualFold(nftBuyOfferID, accept.NftokenBuyOffer))
+	assert.Equal(t, "100000", accept.NftokenBrokerFee.GetValue())
+
+	require.Len(t, protoTx.NftokenTransfers, 1)
+	transfer := protoTx.NftokenTransfers[0]
+	assert.True(t, strings.EqualFold(nftTokenID, transfer.NftokenId))
+	assert.Equal(t, nftSeller, transfer.From)
+	assert.Equal(t, nftBuyer, transfer.To)
+
+	offers := make(map[string]*pbxrpl.NFTokenOfferChange, len(protoTx.NftokenOfferChanges))
+	for _, change := range protoTx.NftokenOfferChanges {
+		offers[strings.ToUpper(change.OfferId)] = change
+	}
+	require.Len(t, offers, 2)
+
+	sell := offers[nftOfferID]
+	require.NotNil(t, sell)
+	assert.Equal(t, pbxrpl.ModType_MOD_TYPE_DELETED, sell.ModType)
+	assert.True(t, sell.IsSellOffer)
+	assert.Equal(t, nftSeller, sell.Owner)
+	assert.Equal(t, "1000000", sell.Amount.Value)
+
+	buy := offers[nftBuyOfferID]
+	require.NotNil(t, buy)
+	assert.Equal(t, pbxrpl.ModType_MOD_TYPE_DELETED, buy.ModType)
+	assert.False(t, buy.IsSellOffer)
+	assert.Equal(t, nftBuyer, buy.Owner)
+	assert.Equal(t, "1100000", buy.Amount.Value)
+	assert.True(t, strings.EqualFold(nftTokenID, buy.NftokenId))
+}
+
+func TestMapNFTokenTransfers(t *testing.T) {
+	const (
+		sellerPage  = "B5F762798A53D543A014CAF8B297CFF8F2F937E8FFFFFFFFFFFFFFFFFFFFFFFF"
+		sellerPage2 = "B5F762798A53D543A014CAF8B297CFF8F2F937E87FFFFFFFFFFFFFFFFFFFFFFF"
+		buyerPage   = "F667B0CA50CC7709A220B0561B85E53A48461FA8FFFFFFFFFFFFFFFFFFFFFFFF"
+		otherToken  = "00080000B5F762798A53D543A014CAF8B297CFF8F2F937E80A7AB19A00000001"
+	)
+	tokens := func(ids ...string) []interface{} {
+		list := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			list = append(list, map[string]interface{}{"NFToken": map[string]interface{}{"NFTokenID": id}})
+		}
+		return list
+	}
+	page := func(kind, id string, fields map[string]interface{}) map[string]interface{} {
+		node := map[string]interface{}{"LedgerEntryType": "NFTokenPage", "LedgerIndex": id}
+		for k, v := range fields {
+			node[k] = v
+		}
+		return map[string]interface{}{kind: node}
+	}
+
+	tests := []struct {
+		name string
+		meta map[string]interface{}
+		want []*pbxrpl.NFTokenTransfer
+	}{
+		{
+			name: "mint",
+			meta: testMeta(page(createdNode, sellerPage, map[string]interface{}{"NewFields": map[string]interface{}{"NFTokens": tokens(nftTokenID)}})),
+			want: []*pbxrpl.NFTokenTransfer{{NftokenId: nftTokenID, To: nftSeller}},
+		},
+		{
+			name: "burn",
+			meta: testMeta(page(deletedNode, sellerPage, map[string]interface{}{"FinalFields": map[string]interface{}{"NFTokens": tokens(nftTokenID)}})),
+			want: []*pbxrpl.NFTokenTransfer{{NftokenId: nftTokenID, From: nftSeller}},
+		},
+		{
+			name: "transfer",
+			meta: testMeta(
+				page(modifiedNode, sellerPage, map[string]interface{}{
+					"FinalFields":    map[string]interface{}{"NFTokens": tokens(otherToken)},
+					"PreviousFields": map[string]interface{}{"NFTokens": tokens(otherToken, nftTokenID)},
+				}),
+				page(createdNode, buyerPage, map[string]interface{}{"NewFields": map[string]interface{}{"NFTokens": tokens(nftTokenID)}}),
+			),
+			want: []*pbxrpl.NFTokenTransfer{{NftokenId: nftTokenID, From: nftSeller, To: nftBuyer}},
+		},
+		{
+			name: "page split within one account",
+			meta: testMeta(
+				page(modifiedNode, sellerPage, map[string]interface{}{
+					"FinalFields":    map[string]interface{}{"NFTokens": tokens(otherToken)},
+					"PreviousFields": map[string]interface{}{"NFTokens": tokens(otherToken, nftTokenID)},
+				}),
+				page(createdNode, sellerPage2, map[string]interface{}{"NewFields": map[string]interface{}{"NFTokens": tokens(nftTokenID)}}),
+			),
+			want: nil,
+		},
+		{
+			name: "page unchanged",
+			meta: testMeta(page(modifiedNode, sellerPage, map[string]interface{}{"FinalFields": map[string]interface{}{"NFTokens": tokens(otherToken)}})),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMapper(zap.NewNop()).mapNFTokenTransfers(tt.meta)
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				assert.True(t, proto.Equal(tt.want[i], got[i]), "got %v", got[i])
+			}
+		})
+	}
+}
+
+// enableAmendmentTxBlob and enableAmendmentMeta enable AMM, the binary codec decodes the Amendments
+// entry's Vector256 field as []string
+const (
+	enableAmendmentTxBlob = "1200642200000000240000000026055D4B0050138CC0774A3BF66D1D22E76BBDA8E8A232E6B6313834301B3B23E8601196AE6455684000000000000000730081140000000000000000000000000000000000000000"
+	enableAmendmentMeta   = "201C00000000F8E5110066567DB0788C020F02780A673DC74757F23823FA3014C1866E72CC4CD8B226CD6EF4E603132042426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EEE1E7220000000003134042426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE8CC0774A3BF66D1D22E76BBDA8E8A232E6B6313834301B3B23E8601196AE6455E1E1F1031000"
+)
+
+func TestMapEnableAmendmentStateChange(t *testing.T) {
+	hash, err := hex.DecodeString(paymentHash)
+	require.NoError(t, err)
+
+	dec := NewDecoder(zap.NewNop())
+	dec.SetIncludeDecodedMeta(true)
+
+	protoTx, err := dec.MapTransactionToProto(enableAmendmentTxBlob, enableAmendmentMeta, hash, 0)
+	require.NoError(t, err)
+	assert.Zero(t, dec.WarningCount())
+
+	require.Len(t, protoTx.StateChanges, 1)
+	change := protoTx.StateChanges[0]
+	assert.Equal(t, "Amendments", change.LedgerEntryType)
+	require.NotNil(t, change.FinalFields)
+	require.NotNil(t, change.PreviousFields)
+	assert.Equal(t, []interface{}{
+		"42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+		"8CC0774A3BF66D1D22E76BBDA8E8A232E6B6313834301B3B23E8601196AE6455",
+	}, change.FinalFields.AsMap()["Amendments"])
+	assert.Len(t, change.PreviousFields.AsMap()["Amendments"], 1)
+
+	require.NotNil(t, protoTx.DecodedMeta)
+	assert.Contains(t, protoTx.DecodedMeta.AsMap(), "AffectedNodes")
+}
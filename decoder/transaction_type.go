@@ -0,0 +1,95 @@
+package decoder
+
+import (
+	"sort"
+
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+)
+
+// transactionTypes maps TransactionType names to their protobuf enum, types missing from it map to
+// TRANSACTION_TYPE_UNKNOWN and are only identified by the raw tx_type string
+var transactionTypes = map[string]pbxrpl.TransactionType{
+	"Payment":                           pbxrpl.TransactionType_TRANSACTION_TYPE_PAYMENT,
+	"OfferCreate":                       pbxrpl.TransactionType_TRANSACTION_TYPE_OFFER_CREATE,
+	"OfferCancel":                       pbxrpl.TransactionType_TRANSACTION_TYPE_OFFER_CANCEL,
+	"TrustSet":                          pbxrpl.TransactionType_TRANSACTION_TYPE_TRUST_SET,
+	"AccountSet":                        pbxrpl.TransactionType_TRANSACTION_TYPE_ACCOUNT_SET,
+	"AccountDelete":                     pbxrpl.TransactionType_TRANSACTION_TYPE_ACCOUNT_DELETE,
+	"SetRegularKey":                     pbxrpl.TransactionType_TRANSACTION_TYPE_SET_REGULAR_KEY,
+	"SignerListSet":                     pbxrpl.TransactionType_TRANSACTION_TYPE_SIGNER_LIST_SET,
+	"EscrowCreate":                      pbxrpl.TransactionType_TRANSACTION_TYPE_ESCROW_CREATE,
+	"EscrowFinish":                      pbxrpl.TransactionType_TRANSACTION_TYPE_ESCROW_FINISH,
+	"EscrowCancel":                      pbxrpl.TransactionType_TRANSACTION_TYPE_ESCROW_CANCEL,
+	"PaymentChannelCreate":              pbxrpl.TransactionType_TRANSACTION_TYPE_PAYMENT_CHANNEL_CREATE,
+	"PaymentChannelFund":                pbxrpl.TransactionType_TRANSACTION_TYPE_PAYMENT_CHANNEL_FUND,
+	"PaymentChannelClaim":               pbxrpl.TransactionType_TRANSACTION_TYPE_PAYMENT_CHANNEL_CLAIM,
+	"CheckCreate":                       pbxrpl.TransactionType_TRANSACTION_TYPE_CHECK_CREATE,
+	"CheckCash":                         pbxrpl.TransactionType_TRANSACTION_TYPE_CHECK_CASH,
+	"CheckCancel":                       pbxrpl.TransactionType_TRANSACTION_TYPE_CHECK_CANCEL,
+	"DepositPreauth":                    pbxrpl.TransactionType_TRANSACTION_TYPE_DEPOSIT_PREAUTH,
+	"TicketCreate":                      pbxrpl.TransactionType_TRANSACTION_TYPE_TICKET_CREATE,
+	"NFTokenMint":                       pbxrpl.TransactionType_TRANSACTION_TYPE_NFTOKEN_MINT,
+	"NFTokenBurn":                       pbxrpl.TransactionType_TRANSACTION_TYPE_NFTOKEN_BURN,
+	"NFTokenCreateOffer":                pbxrpl.TransactionType_TRANSACTION_TYPE_NFTOKEN_CREATE_OFFER,
+	"NFTokenCancelOffer":                pbxrpl.TransactionType_TRANSACTION_TYPE_NFTOKEN_CANCEL_OFFER,
+	"NFTokenAcceptOffer":                pbxrpl.TransactionType_TRANSACTION_TYPE_NFTOKEN_ACCEPT_OFFER,
+	"Clawback":                          pbxrpl.TransactionType_TRANSACTION_TYPE_CLAWBACK,
+	"AMMCreate":                         pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_CREATE,
+	"AMMDeposit":                        pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_DEPOSIT,
+	"AMMWithdraw":                       pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_WITHDRAW,
+	"AMMVote":                           pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_VOTE,
+	"AMMBid":                            pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_BID,
+	"AMMDelete":                         pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_DELETE,
+	"AMMClawback":                       pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_CLAWBACK,
+	"DIDSet":                            pbxrpl.TransactionType_TRANSACTION_TYPE_DID_SET,
+	"DIDDelete":                         pbxrpl.TransactionType_TRANSACTION_TYPE_DID_DELETE,
+	"OracleSet":                         pbxrpl.TransactionType_TRANSACTION_TYPE_ORACLE_SET,
+	"OracleDelete":                      pbxrpl.TransactionType_TRANSACTION_TYPE_ORACLE_DELETE,
+	"MPTokenIssuanceCreate":             pbxrpl.TransactionType_TRANSACTION_TYPE_MPTOKEN_ISSUANCE_CREATE,
+	"MPTokenIssuanceDestroy":            pbxrpl.TransactionType_TRANSACTION_TYPE_MPTOKEN_ISSUANCE_DESTROY,
+	"MPTokenIssuanceSet":                pbxrpl.TransactionType_TRANSACTION_TYPE_MPTOKEN_ISSUANCE_SET,
+	"MPTokenAuthorize":                  pbxrpl.TransactionType_TRANSACTION_TYPE_MPTOKEN_AUTHORIZE,
+	"CredentialCreate":                  pbxrpl.TransactionType_TRANSACTION_TYPE_CREDENTIAL_CREATE,
+	"CredentialAccept":                  pbxrpl.TransactionType_TRANSACTION_TYPE_CREDENTIAL_ACCEPT,
+	"CredentialDelete":                  pbxrpl.TransactionType_TRANSACTION_TYPE_CREDENTIAL_DELETE,
+	"PermissionedDomainSet":             pbxrpl.TransactionType_TRANSACTION_TYPE_PERMISSIONED_DOMAIN_SET,
+	"PermissionedDomainDelete":          pbxrpl.TransactionType_TRANSACTION_TYPE_PERMISSIONED_DOMAIN_DELETE,
+	"DelegateSet":                       pbxrpl.TransactionType_TRANSACTION_TYPE_DELEGATE_SET,
+	"Batch":                             pbxrpl.TransactionType_TRANSACTION_TYPE_BATCH,
+	"EnableAmendment":                   pbxrpl.TransactionType_TRANSACTION_TYPE_ENABLE_AMENDMENT,
+	"SetFee":                            pbxrpl.TransactionType_TRANSACTION_TYPE_SET_FEE,
+	"UNLModify":                         pbxrpl.TransactionType_TRANSACTION_TYPE_UNL_MODIFY,
+	"NFTokenModify":                     pbxrpl.TransactionType_TRANSACTION_TYPE_NFTOKEN_MODIFY,
+	"LedgerStateFix":                    pbxrpl.TransactionType_TRANSACTION_TYPE_LEDGER_STATE_FIX,
+	"XChainCreateBridge":                pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_CREATE_BRIDGE,
+	"XChainModifyBridge":                pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_MODIFY_BRIDGE,
+	"XChainCreateClaimID":               pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_CREATE_CLAIM_ID,
+	"XChainCommit":                      pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_COMMIT,
+	"XChainClaim":                       pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_CLAIM,
+	"XChainAccountCreateCommit":         pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_ACCOUNT_CREATE_COMMIT,
+	"XChainAddClaimAttestation":         pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_ADD_CLAIM_ATTESTATION,
+	"XChainAddAccountCreateAttestation": pbxrpl.TransactionType_TRANSACTION_TYPE_XCHAIN_ADD_ACCOUNT_CREATE_ATTESTATION,
+	"VaultCreate":                       pbxrpl.TransactionType_TRANSACTION_TYPE_VAULT_CREATE,
+	"VaultSet":                          pbxrpl.TransactionType_TRANSACTION_TYPE_VAULT_SET,
+	"VaultDelete":                       pbxrpl.TransactionType_TRANSACTION_TYPE_VAULT_DELETE,
+	"VaultDeposit":                      pbxrpl.TransactionType_TRANSACTION_TYPE_VAULT_DEPOSIT,
+	"VaultWithdraw":                     pbxrpl.TransactionType_TRANSACTION_TYPE_VAULT_WITHDRAW,
+	"VaultClawback":                     pbxrpl.TransactionType_TRANSACTION_TYPE_VAULT_CLAWBACK,
+}
+
+// IsKnownTransactionType reports whether txType is a transaction type name this schema knows
+func IsKnownTransactionType(txType string) bool {
+	_, ok := transactionTypes[txType]
+	return ok
+}
+
+// KnownTransactionTypes returns the sorted transaction type names this schema knows
+func KnownTransactionTypes() []string {
+	txTypes := make([]string, 0, len(transactionTypes))
+	for txType := range transactionTypes {
+		txTypes = append(txTypes, txType)
+	}
+	sort.Strings(txTypes)
+
+	return txTypes
+}
@@ -0,0 +1,45 @@
+package decoder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+)
+
+// Every name maps to its own enum value, a copy-paste slip would map two names to the same one
+func TestTransactionTypesDistinct(t *testing.T) {
+	seen := make(map[pbxrpl.TransactionType]string)
+	for name, txType := range transactionTypes {
+		assert.NotEqual(t, pbxrpl.TransactionType_TRANSACTION_TYPE_UNKNOWN, txType, name)
+		if other, ok := seen[txType]; ok {
+			t.Errorf("%s and %s both map to %s", name, other, txType)
+		}
+		seen[txType] = name
+	}
+}
+
+func TestMapTransactionTypeEnum(t *testing.T) {
+	tests := []struct {
+		txType string
+		want   pbxrpl.TransactionType
+	}{
+		{"Payment", pbxrpl.TransactionType_TRANSACTION_TYPE_PAYMENT},
+		{"AMMCreate", pbxrpl.TransactionType_TRANSACTION_TYPE_AMM_CREATE},
+		{"UNLModify", pbxrpl.TransactionType_TRANSACTION_TYPE_UNL_MODIFY},
+		{"SomeFutureType", pbxrpl.TransactionType_TRANSACTION_TYPE_UNKNOWN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.txType, func(t *testing.T) {
+			flat := map[string]interface{}{"TransactionType": tt.txType, "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}
+			tx, err := NewMapper(zap.NewNop()).MapTransactionToProto(flat, nil, nil, nil, make([]byte, 32), 0, "tesSUCCESS")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tx.TransactionType)
+			assert.Equal(t, tt.txType, tx.TxType)
+			assert.Equal(t, tt.want != pbxrpl.TransactionType_TRANSACTION_TYPE_UNKNOWN, IsKnownTransactionType(tt.txType))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sf/xrpl/service/v1/service.proto
+
+package pbxrplsvc
+
+import (
+	v1 "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// First ledger index to stream
+	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// Last ledger index to stream (inclusive), 0 follows the validated chain head
+	StopBlock     uint64 `protobuf:"varint,2,opt,name=stop_block,json=stopBlock,proto3" json:"stop_block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlocksRequest) Reset() {
+	*x = BlocksRequest{}
+	mi := &file_sf_xrpl_service_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlocksRequest) ProtoMessage() {}
+
+func (x *BlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_service_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlocksRequest.ProtoReflect.Descriptor instead.
+func (*BlocksRequest) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_service_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlocksRequest) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *BlocksRequest) GetStopBlock() uint64 {
+	if x != nil {
+		return x.StopBlock
+	}
+	return 0
+}
+
+type BlocksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Decoded ledger
+	Block         *v1.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlocksResponse) Reset() {
+	*x = BlocksResponse{}
+	mi := &file_sf_xrpl_service_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlocksResponse) ProtoMessage() {}
+
+func (x *BlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_service_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlocksResponse.ProtoReflect.Descriptor instead.
+func (*BlocksResponse) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_service_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlocksResponse) GetBlock() *v1.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+var File_sf_xrpl_service_v1_service_proto protoreflect.FileDescriptor
+
+const file_sf_xrpl_service_v1_service_proto_rawDesc = "" +
+	"\n" +
+	" sf/xrpl/service/v1/service.proto\x12\x12sf.xrpl.service.v1\x1a\x1bsf/xrpl/type/v1/block.proto\"O\n" +
+	"\rBlocksRequest\x12\x1f\n" +
+	"\vstart_block\x18\x01 \x01(\x04R\n" +
+	"startBlock\x12\x1d\n" +
+	"\n" +
+	"stop_block\x18\x02 \x01(\x04R\tstopBlock\">\n" +
+	"\x0eBlocksResponse\x12,\n" +
+	"\x05block\x18\x01 \x01(\v2\x16.sf.xrpl.type.v1.BlockR\x05block2`\n" +
+	"\vBlockStream\x12Q\n" +
+	"\x06Blocks\x12!.sf.xrpl.service.v1.BlocksRequest\x1a\".sf.xrpl.service.v1.BlocksResponse0\x01BGZEgithub.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/service/v1;pbxrplsvcb\x06proto3"
+
+var (
+	file_sf_xrpl_service_v1_service_proto_rawDescOnce sync.Once
+	file_sf_xrpl_service_v1_service_proto_rawDescData []byte
+)
+
+func file_sf_xrpl_service_v1_service_proto_rawDescGZIP() []byte {
+	file_sf_xrpl_service_v1_service_proto_rawDescOnce.Do(func() {
+		file_sf_xrpl_service_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sf_xrpl_service_v1_service_proto_rawDesc), len(file_sf_xrpl_service_v1_service_proto_rawDesc)))
+	})
+	return file_sf_xrpl_service_v1_service_proto_rawDescData
+}
+
+var file_sf_xrpl_service_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_xrpl_service_v1_service_proto_goTypes = []any{
+	(*BlocksRequest)(nil),  // 0: sf.xrpl.service.v1.BlocksRequest
+	(*BlocksResponse)(nil), // 1: sf.xrpl.service.v1.BlocksResponse
+	(*v1.Block)(nil),       // 2: sf.xrpl.type.v1.Block
+}
+var file_sf_xrpl_service_v1_service_proto_depIdxs = []int32{
+	2, // 0: sf.xrpl.service.v1.BlocksResponse.block:type_name -> sf.xrpl.type.v1.Block
+	0, // 1: sf.xrpl.service.v1.BlockStream.Blocks:input_type -> sf.xrpl.service.v1.BlocksRequest
+	1, // 2: sf.xrpl.service.v1.BlockStream.Blocks:output_type -> sf.xrpl.service.v1.BlocksResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_sf_xrpl_service_v1_service_proto_init() }
+func file_sf_xrpl_service_v1_service_proto_init() {
+	if File_sf_xrpl_service_v1_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_service_v1_service_proto_rawDesc), len(file_sf_xrpl_service_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sf_xrpl_service_v1_service_proto_goTypes,
+		DependencyIndexes: file_sf_xrpl_service_v1_service_proto_depIdxs,
+		MessageInfos:      file_sf_xrpl_service_v1_service_proto_msgTypes,
+	}.Build()
+	File_sf_xrpl_service_v1_service_proto = out.File
+	file_sf_xrpl_service_v1_service_proto_goTypes = nil
+	file_sf_xrpl_service_v1_service_proto_depIdxs = nil
+}
@@ -21,6 +21,101 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AccountSetFlag - AccountSet SetFlag and ClearFlag codes (asf flags), values match the codes
+// Reference: https://xrpl.org/docs/references/protocol/transactions/types/accountset#accountset-flags
+type AccountSetFlag int32
+
+const (
+	// No flag set or cleared
+	AccountSetFlag_ACCOUNT_SET_FLAG_UNSPECIFIED               AccountSetFlag = 0
+	AccountSetFlag_ACCOUNT_SET_FLAG_REQUIRE_DEST              AccountSetFlag = 1
+	AccountSetFlag_ACCOUNT_SET_FLAG_REQUIRE_AUTH              AccountSetFlag = 2
+	AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_XRP              AccountSetFlag = 3
+	AccountSetFlag_ACCOUNT_SET_FLAG_DISABLE_MASTER            AccountSetFlag = 4
+	AccountSetFlag_ACCOUNT_SET_FLAG_ACCOUNT_TXN_ID            AccountSetFlag = 5
+	AccountSetFlag_ACCOUNT_SET_FLAG_NO_FREEZE                 AccountSetFlag = 6
+	AccountSetFlag_ACCOUNT_SET_FLAG_GLOBAL_FREEZE             AccountSetFlag = 7
+	AccountSetFlag_ACCOUNT_SET_FLAG_DEFAULT_RIPPLE            AccountSetFlag = 8
+	AccountSetFlag_ACCOUNT_SET_FLAG_DEPOSIT_AUTH              AccountSetFlag = 9
+	AccountSetFlag_ACCOUNT_SET_FLAG_AUTHORIZED_NFTOKEN_MINTER AccountSetFlag = 10
+	// 11 is reserved (asfTshCollect on Hooks networks)
+	AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_NFTOKEN_OFFER AccountSetFlag = 12
+	AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_CHECK         AccountSetFlag = 13
+	AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_PAY_CHAN      AccountSetFlag = 14
+	AccountSetFlag_ACCOUNT_SET_FLAG_DISALLOW_INCOMING_TRUSTLINE     AccountSetFlag = 15
+	AccountSetFlag_ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_CLAWBACK       AccountSetFlag = 16
+	AccountSetFlag_ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_LOCKING        AccountSetFlag = 17
+)
+
+// Enum value maps for AccountSetFlag.
+var (
+	AccountSetFlag_name = map[int32]string{
+		0:  "ACCOUNT_SET_FLAG_UNSPECIFIED",
+		1:  "ACCOUNT_SET_FLAG_REQUIRE_DEST",
+		2:  "ACCOUNT_SET_FLAG_REQUIRE_AUTH",
+		3:  "ACCOUNT_SET_FLAG_DISALLOW_XRP",
+		4:  "ACCOUNT_SET_FLAG_DISABLE_MASTER",
+		5:  "ACCOUNT_SET_FLAG_ACCOUNT_TXN_ID",
+		6:  "ACCOUNT_SET_FLAG_NO_FREEZE",
+		7:  "ACCOUNT_SET_FLAG_GLOBAL_FREEZE",
+		8:  "ACCOUNT_SET_FLAG_DEFAULT_RIPPLE",
+		9:  "ACCOUNT_SET_FLAG_DEPOSIT_AUTH",
+		10: "ACCOUNT_SET_FLAG_AUTHORIZED_NFTOKEN_MINTER",
+		12: "ACCOUNT_SET_FLAG_DISALLOW_INCOMING_NFTOKEN_OFFER",
+		13: "ACCOUNT_SET_FLAG_DISALLOW_INCOMING_CHECK",
+		14: "ACCOUNT_SET_FLAG_DISALLOW_INCOMING_PAY_CHAN",
+		15: "ACCOUNT_SET_FLAG_DISALLOW_INCOMING_TRUSTLINE",
+		16: "ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_CLAWBACK",
+		17: "ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_LOCKING",
+	}
+	AccountSetFlag_value = map[string]int32{
+		"ACCOUNT_SET_FLAG_UNSPECIFIED":                     0,
+		"ACCOUNT_SET_FLAG_REQUIRE_DEST":                    1,
+		"ACCOUNT_SET_FLAG_REQUIRE_AUTH":                    2,
+		"ACCOUNT_SET_FLAG_DISALLOW_XRP":                    3,
+		"ACCOUNT_SET_FLAG_DISABLE_MASTER":                  4,
+		"ACCOUNT_SET_FLAG_ACCOUNT_TXN_ID":                  5,
+		"ACCOUNT_SET_FLAG_NO_FREEZE":                       6,
+		"ACCOUNT_SET_FLAG_GLOBAL_FREEZE":                   7,
+		"ACCOUNT_SET_FLAG_DEFAULT_RIPPLE":                  8,
+		"ACCOUNT_SET_FLAG_DEPOSIT_AUTH":                    9,
+		"ACCOUNT_SET_FLAG_AUTHORIZED_NFTOKEN_MINTER":       10,
+		"ACCOUNT_SET_FLAG_DISALLOW_INCOMING_NFTOKEN_OFFER": 12,
+		"ACCOUNT_SET_FLAG_DISALLOW_INCOMING_CHECK":         13,
+		"ACCOUNT_SET_FLAG_DISALLOW_INCOMING_PAY_CHAN":      14,
+		"ACCOUNT_SET_FLAG_DISALLOW_INCOMING_TRUSTLINE":     15,
+		"ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_CLAWBACK":       16,
+		"ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_LOCKING":        17,
+	}
+)
+
+func (x AccountSetFlag) Enum() *AccountSetFlag {
+	p := new(AccountSetFlag)
+	*p = x
+	return p
+}
+
+func (x AccountSetFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountSetFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_account_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountSetFlag) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_account_proto_enumTypes[0]
+}
+
+func (x AccountSetFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountSetFlag.Descriptor instead.
+func (AccountSetFlag) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
 // AccountSet - Modifies account settings
 // Reference: https://xrpl.org/accountset.html
 type AccountSet struct {
@@ -44,9 +139,28 @@ type AccountSet struct {
 	// (Optional) Arbitrary 256-bit value stored with the account
 	WalletLocator string `protobuf:"bytes,9,opt,name=wallet_locator,json=walletLocator,proto3" json:"wallet_locator,omitempty"`
 	// (Optional) Not used - valid but has no effect
-	WalletSize    uint32 `protobuf:"varint,10,opt,name=wallet_size,json=walletSize,proto3" json:"wallet_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	WalletSize uint32 `protobuf:"varint,10,opt,name=wallet_size,json=walletSize,proto3" json:"wallet_size,omitempty"`
+	// Derived: transfer fee as a decimal percentage (e.g., "0.5" for 1005000000)
+	// "0" when transfer_rate is 0 (reset) or exactly 1e9 (no fee)
+	TransferRatePercent string `protobuf:"bytes,11,opt,name=transfer_rate_percent,json=transferRatePercent,proto3" json:"transfer_rate_percent,omitempty"`
+	// Derived: true when transfer_rate is set outside 0 or 1e9 to 2e9, which rippled rejects
+	InvalidTransferRate bool `protobuf:"varint,12,opt,name=invalid_transfer_rate,json=invalidTransferRate,proto3" json:"invalid_transfer_rate,omitempty"`
+	// Derived: true when tick_size is set outside 0 or 3 to 15, which rippled rejects
+	InvalidTickSize bool `protobuf:"varint,13,opt,name=invalid_tick_size,json=invalidTickSize,proto3" json:"invalid_tick_size,omitempty"`
+	// (Optional) Transaction flags, superseded by set_flag and clear_flag
+	// tfRequireDestTag = 65536 (0x00010000) - Require a destination tag
+	// tfOptionalDestTag = 131072 (0x00020000) - Make destination tags optional
+	// tfRequireAuth = 262144 (0x00040000) - Require authorization for trust lines
+	// tfOptionalAuth = 524288 (0x00080000) - Make trust line authorization optional
+	// tfDisallowXRP = 1048576 (0x00100000) - Discourage incoming XRP
+	// tfAllowXRP = 2097152 (0x00200000) - Allow incoming XRP
+	Flags uint32 `protobuf:"varint,14,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Derived: set_flag and clear_flag as enums, whose values are the asf codes themselves
+	// Codes this schema does not name yet pass through as their number
+	SetAccountFlag   AccountSetFlag `protobuf:"varint,15,opt,name=set_account_flag,json=setAccountFlag,proto3,enum=sf.xrpl.type.v1.AccountSetFlag" json:"set_account_flag,omitempty"`
+	ClearAccountFlag AccountSetFlag `protobuf:"varint,16,opt,name=clear_account_flag,json=clearAccountFlag,proto3,enum=sf.xrpl.type.v1.AccountSetFlag" json:"clear_account_flag,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *AccountSet) Reset() {
@@ -149,6 +263,48 @@ func (x *AccountSet) GetWalletSize() uint32 {
 	return 0
 }
 
+func (x *AccountSet) GetTransferRatePercent() string {
+	if x != nil {
+		return x.TransferRatePercent
+	}
+	return ""
+}
+
+func (x *AccountSet) GetInvalidTransferRate() bool {
+	if x != nil {
+		return x.InvalidTransferRate
+	}
+	return false
+}
+
+func (x *AccountSet) GetInvalidTickSize() bool {
+	if x != nil {
+		return x.InvalidTickSize
+	}
+	return false
+}
+
+func (x *AccountSet) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *AccountSet) GetSetAccountFlag() AccountSetFlag {
+	if x != nil {
+		return x.SetAccountFlag
+	}
+	return AccountSetFlag_ACCOUNT_SET_FLAG_UNSPECIFIED
+}
+
+func (x *AccountSet) GetClearAccountFlag() AccountSetFlag {
+	if x != nil {
+		return x.ClearAccountFlag
+	}
+	return AccountSetFlag_ACCOUNT_SET_FLAG_UNSPECIFIED
+}
+
 // AccountDelete - Deletes an account
 // Reference: https://xrpl.org/accountdelete.html
 type AccountDelete struct {
@@ -219,7 +375,9 @@ func (x *AccountDelete) GetCredentialIds() []string {
 type SetRegularKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Regular key to set (omit to remove)
-	RegularKey    string `protobuf:"bytes,1,opt,name=regular_key,json=regularKey,proto3" json:"regular_key,omitempty"`
+	RegularKey string `protobuf:"bytes,1,opt,name=regular_key,json=regularKey,proto3" json:"regular_key,omitempty"`
+	// Derived: true when RegularKey is absent, removing the regular key
+	IsRemoval     bool `protobuf:"varint,2,opt,name=is_removal,json=isRemoval,proto3" json:"is_removal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,6 +419,13 @@ func (x *SetRegularKey) GetRegularKey() string {
 	return ""
 }
 
+func (x *SetRegularKey) GetIsRemoval() bool {
+	if x != nil {
+		return x.IsRemoval
+	}
+	return false
+}
+
 // SignerListSet - Modifies multi-signing settings
 // Reference: https://xrpl.org/signerlistset.html
 type SignerListSet struct {
@@ -384,7 +549,7 @@ var File_sf_xrpl_type_v1_account_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_account_proto_rawDesc = "" +
 	"\n" +
-	"\x1dsf/xrpl/type/v1/account.proto\x12\x0fsf.xrpl.type.v1\"\xcf\x02\n" +
+	"\x1dsf/xrpl/type/v1/account.proto\x12\x0fsf.xrpl.type.v1\"\x93\x05\n" +
 	"\n" +
 	"AccountSet\x12\x19\n" +
 	"\bset_flag\x18\x01 \x01(\rR\asetFlag\x12\x1d\n" +
@@ -401,21 +566,48 @@ const file_sf_xrpl_type_v1_account_proto_rawDesc = "" +
 	"\x0ewallet_locator\x18\t \x01(\tR\rwalletLocator\x12\x1f\n" +
 	"\vwallet_size\x18\n" +
 	" \x01(\rR\n" +
-	"walletSize\"\x81\x01\n" +
+	"walletSize\x122\n" +
+	"\x15transfer_rate_percent\x18\v \x01(\tR\x13transferRatePercent\x122\n" +
+	"\x15invalid_transfer_rate\x18\f \x01(\bR\x13invalidTransferRate\x12*\n" +
+	"\x11invalid_tick_size\x18\r \x01(\bR\x0finvalidTickSize\x12\x14\n" +
+	"\x05flags\x18\x0e \x01(\rR\x05flags\x12I\n" +
+	"\x10set_account_flag\x18\x0f \x01(\x0e2\x1f.sf.xrpl.type.v1.AccountSetFlagR\x0esetAccountFlag\x12M\n" +
+	"\x12clear_account_flag\x18\x10 \x01(\x0e2\x1f.sf.xrpl.type.v1.AccountSetFlagR\x10clearAccountFlag\"\x81\x01\n" +
 	"\rAccountDelete\x12 \n" +
 	"\vdestination\x18\x01 \x01(\tR\vdestination\x12'\n" +
 	"\x0fdestination_tag\x18\x02 \x01(\rR\x0edestinationTag\x12%\n" +
-	"\x0ecredential_ids\x18\x03 \x03(\tR\rcredentialIds\"0\n" +
+	"\x0ecredential_ids\x18\x03 \x03(\tR\rcredentialIds\"O\n" +
 	"\rSetRegularKey\x12\x1f\n" +
 	"\vregular_key\x18\x01 \x01(\tR\n" +
-	"regularKey\"y\n" +
+	"regularKey\x12\x1d\n" +
+	"\n" +
+	"is_removal\x18\x02 \x01(\bR\tisRemoval\"y\n" +
 	"\rSignerListSet\x12#\n" +
 	"\rsigner_quorum\x18\x01 \x01(\rR\fsignerQuorum\x12C\n" +
 	"\x0esigner_entries\x18\x02 \x03(\v2\x1c.sf.xrpl.type.v1.SignerEntryR\rsignerEntries\"s\n" +
 	"\vSignerEntry\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12#\n" +
 	"\rsigner_weight\x18\x02 \x01(\rR\fsignerWeight\x12%\n" +
-	"\x0ewallet_locator\x18\x03 \x01(\tR\rwalletLocatorBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\x0ewallet_locator\x18\x03 \x01(\tR\rwalletLocator*\xc7\x05\n" +
+	"\x0eAccountSetFlag\x12 \n" +
+	"\x1cACCOUNT_SET_FLAG_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dACCOUNT_SET_FLAG_REQUIRE_DEST\x10\x01\x12!\n" +
+	"\x1dACCOUNT_SET_FLAG_REQUIRE_AUTH\x10\x02\x12!\n" +
+	"\x1dACCOUNT_SET_FLAG_DISALLOW_XRP\x10\x03\x12#\n" +
+	"\x1fACCOUNT_SET_FLAG_DISABLE_MASTER\x10\x04\x12#\n" +
+	"\x1fACCOUNT_SET_FLAG_ACCOUNT_TXN_ID\x10\x05\x12\x1e\n" +
+	"\x1aACCOUNT_SET_FLAG_NO_FREEZE\x10\x06\x12\"\n" +
+	"\x1eACCOUNT_SET_FLAG_GLOBAL_FREEZE\x10\a\x12#\n" +
+	"\x1fACCOUNT_SET_FLAG_DEFAULT_RIPPLE\x10\b\x12!\n" +
+	"\x1dACCOUNT_SET_FLAG_DEPOSIT_AUTH\x10\t\x12.\n" +
+	"*ACCOUNT_SET_FLAG_AUTHORIZED_NFTOKEN_MINTER\x10\n" +
+	"\x124\n" +
+	"0ACCOUNT_SET_FLAG_DISALLOW_INCOMING_NFTOKEN_OFFER\x10\f\x12,\n" +
+	"(ACCOUNT_SET_FLAG_DISALLOW_INCOMING_CHECK\x10\r\x12/\n" +
+	"+ACCOUNT_SET_FLAG_DISALLOW_INCOMING_PAY_CHAN\x10\x0e\x120\n" +
+	",ACCOUNT_SET_FLAG_DISALLOW_INCOMING_TRUSTLINE\x10\x0f\x12.\n" +
+	"*ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_CLAWBACK\x10\x10\x12-\n" +
+	")ACCOUNT_SET_FLAG_ALLOW_TRUST_LINE_LOCKING\x10\x11BAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_account_proto_rawDescOnce sync.Once
@@ -429,21 +621,25 @@ func file_sf_xrpl_type_v1_account_proto_rawDescGZIP() []byte {
 	return file_sf_xrpl_type_v1_account_proto_rawDescData
 }
 
+var file_sf_xrpl_type_v1_account_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_sf_xrpl_type_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_sf_xrpl_type_v1_account_proto_goTypes = []any{
-	(*AccountSet)(nil),    // 0: sf.xrpl.type.v1.AccountSet
-	(*AccountDelete)(nil), // 1: sf.xrpl.type.v1.AccountDelete
-	(*SetRegularKey)(nil), // 2: sf.xrpl.type.v1.SetRegularKey
-	(*SignerListSet)(nil), // 3: sf.xrpl.type.v1.SignerListSet
-	(*SignerEntry)(nil),   // 4: sf.xrpl.type.v1.SignerEntry
+	(AccountSetFlag)(0),   // 0: sf.xrpl.type.v1.AccountSetFlag
+	(*AccountSet)(nil),    // 1: sf.xrpl.type.v1.AccountSet
+	(*AccountDelete)(nil), // 2: sf.xrpl.type.v1.AccountDelete
+	(*SetRegularKey)(nil), // 3: sf.xrpl.type.v1.SetRegularKey
+	(*SignerListSet)(nil), // 4: sf.xrpl.type.v1.SignerListSet
+	(*SignerEntry)(nil),   // 5: sf.xrpl.type.v1.SignerEntry
 }
 var file_sf_xrpl_type_v1_account_proto_depIdxs = []int32{
-	4, // 0: sf.xrpl.type.v1.SignerListSet.signer_entries:type_name -> sf.xrpl.type.v1.SignerEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: sf.xrpl.type.v1.AccountSet.set_account_flag:type_name -> sf.xrpl.type.v1.AccountSetFlag
+	0, // 1: sf.xrpl.type.v1.AccountSet.clear_account_flag:type_name -> sf.xrpl.type.v1.AccountSetFlag
+	5, // 2: sf.xrpl.type.v1.SignerListSet.signer_entries:type_name -> sf.xrpl.type.v1.SignerEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_account_proto_init() }
@@ -456,13 +652,14 @@ func file_sf_xrpl_type_v1_account_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_account_proto_rawDesc), len(file_sf_xrpl_type_v1_account_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sf_xrpl_type_v1_account_proto_goTypes,
 		DependencyIndexes: file_sf_xrpl_type_v1_account_proto_depIdxs,
+		EnumInfos:         file_sf_xrpl_type_v1_account_proto_enumTypes,
 		MessageInfos:      file_sf_xrpl_type_v1_account_proto_msgTypes,
 	}.Build()
 	File_sf_xrpl_type_v1_account_proto = out.File
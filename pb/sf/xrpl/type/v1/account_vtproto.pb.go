@@ -35,6 +35,12 @@ func (m *AccountSet) CloneVT() *AccountSet {
 	r.NftokenMinter = m.NftokenMinter
 	r.WalletLocator = m.WalletLocator
 	r.WalletSize = m.WalletSize
+	r.TransferRatePercent = m.TransferRatePercent
+	r.InvalidTransferRate = m.InvalidTransferRate
+	r.InvalidTickSize = m.InvalidTickSize
+	r.Flags = m.Flags
+	r.SetAccountFlag = m.SetAccountFlag
+	r.ClearAccountFlag = m.ClearAccountFlag
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -75,6 +81,7 @@ func (m *SetRegularKey) CloneVT() *SetRegularKey {
 	}
 	r := new(SetRegularKey)
 	r.RegularKey = m.RegularKey
+	r.IsRemoval = m.IsRemoval
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -165,6 +172,24 @@ func (this *AccountSet) EqualVT(that *AccountSet) bool {
 	if this.WalletSize != that.WalletSize {
 		return false
 	}
+	if this.TransferRatePercent != that.TransferRatePercent {
+		return false
+	}
+	if this.InvalidTransferRate != that.InvalidTransferRate {
+		return false
+	}
+	if this.InvalidTickSize != that.InvalidTickSize {
+		return false
+	}
+	if this.Flags != that.Flags {
+		return false
+	}
+	if this.SetAccountFlag != that.SetAccountFlag {
+		return false
+	}
+	if this.ClearAccountFlag != that.ClearAccountFlag {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -215,6 +240,9 @@ func (this *SetRegularKey) EqualVT(that *SetRegularKey) bool {
 	if this.RegularKey != that.RegularKey {
 		return false
 	}
+	if this.IsRemoval != that.IsRemoval {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -316,6 +344,50 @@ func (m *AccountSet) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.ClearAccountFlag != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ClearAccountFlag))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SetAccountFlag != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SetAccountFlag))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Flags != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Flags))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.InvalidTickSize {
+		i--
+		if m.InvalidTickSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.InvalidTransferRate {
+		i--
+		if m.InvalidTransferRate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TransferRatePercent) > 0 {
+		i -= len(m.TransferRatePercent)
+		copy(dAtA[i:], m.TransferRatePercent)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.TransferRatePercent)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.WalletSize != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.WalletSize))
 		i--
@@ -463,6 +535,16 @@ func (m *SetRegularKey) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.IsRemoval {
+		i--
+		if m.IsRemoval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.RegularKey) > 0 {
 		i -= len(m.RegularKey)
 		copy(dAtA[i:], m.RegularKey)
@@ -605,6 +687,50 @@ func (m *AccountSet) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.ClearAccountFlag != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ClearAccountFlag))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SetAccountFlag != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SetAccountFlag))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Flags != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Flags))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.InvalidTickSize {
+		i--
+		if m.InvalidTickSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.InvalidTransferRate {
+		i--
+		if m.InvalidTransferRate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TransferRatePercent) > 0 {
+		i -= len(m.TransferRatePercent)
+		copy(dAtA[i:], m.TransferRatePercent)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.TransferRatePercent)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.WalletSize != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.WalletSize))
 		i--
@@ -752,6 +878,16 @@ func (m *SetRegularKey) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.IsRemoval {
+		i--
+		if m.IsRemoval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.RegularKey) > 0 {
 		i -= len(m.RegularKey)
 		copy(dAtA[i:], m.RegularKey)
@@ -905,6 +1041,25 @@ func (m *AccountSet) SizeVT() (n int) {
 	if m.WalletSize != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.WalletSize))
 	}
+	l = len(m.TransferRatePercent)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.InvalidTransferRate {
+		n += 2
+	}
+	if m.InvalidTickSize {
+		n += 2
+	}
+	if m.Flags != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.Flags))
+	}
+	if m.SetAccountFlag != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.SetAccountFlag))
+	}
+	if m.ClearAccountFlag != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.ClearAccountFlag))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -942,6 +1097,9 @@ func (m *SetRegularKey) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.IsRemoval {
+		n += 2
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -1270,6 +1428,135 @@ func (m *AccountSet) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRatePercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRatePercent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTransferRate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvalidTransferRate = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTickSize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvalidTickSize = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAccountFlag", wireType)
+			}
+			m.SetAccountFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetAccountFlag |= AccountSetFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearAccountFlag", wireType)
+			}
+			m.ClearAccountFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClearAccountFlag |= AccountSetFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -1487,6 +1774,26 @@ func (m *SetRegularKey) UnmarshalVT(dAtA []byte) error {
 			}
 			m.RegularKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoval = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -2051,6 +2358,139 @@ func (m *AccountSet) UnmarshalVTUnsafe(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRatePercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.TransferRatePercent = stringValue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTransferRate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvalidTransferRate = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTickSize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvalidTickSize = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAccountFlag", wireType)
+			}
+			m.SetAccountFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetAccountFlag |= AccountSetFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearAccountFlag", wireType)
+			}
+			m.ClearAccountFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClearAccountFlag |= AccountSetFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -2280,6 +2720,26 @@ func (m *SetRegularKey) UnmarshalVTUnsafe(dAtA []byte) error {
 			}
 			m.RegularKey = stringValue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoval = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -30,9 +30,14 @@ type AMMCreate struct {
 	// Second asset to deposit
 	Amount2 *Amount `protobuf:"bytes,2,opt,name=amount2,proto3" json:"amount2,omitempty"`
 	// Trading fee (0-1000, representing 0-1%)
-	TradingFee    uint32 `protobuf:"varint,3,opt,name=trading_fee,json=tradingFee,proto3" json:"trading_fee,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TradingFee uint32 `protobuf:"varint,3,opt,name=trading_fee,json=tradingFee,proto3" json:"trading_fee,omitempty"`
+	// --- From metadata ---
+	// AMM pseudo-account created to hold the pool's assets
+	AmmAccount string `protobuf:"bytes,20,opt,name=amm_account,json=ammAccount,proto3" json:"amm_account,omitempty"`
+	// Currency code of the LP token issued by the AMM account (40-char hex)
+	LpTokenCurrency string `protobuf:"bytes,21,opt,name=lp_token_currency,json=lpTokenCurrency,proto3" json:"lp_token_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AMMCreate) Reset() {
@@ -86,6 +91,20 @@ func (x *AMMCreate) GetTradingFee() uint32 {
 	return 0
 }
 
+func (x *AMMCreate) GetAmmAccount() string {
+	if x != nil {
+		return x.AmmAccount
+	}
+	return ""
+}
+
+func (x *AMMCreate) GetLpTokenCurrency() string {
+	if x != nil {
+		return x.LpTokenCurrency
+	}
+	return ""
+}
+
 // AMMDeposit - Deposits assets into an AMM
 // Reference: https://xrpl.org/ammdeposit.html
 type AMMDeposit struct {
@@ -319,9 +338,11 @@ type AMMVote struct {
 	// Second asset identifier
 	Asset2 *Asset `protobuf:"bytes,2,opt,name=asset2,proto3" json:"asset2,omitempty"`
 	// Proposed trading fee (0-1000)
-	TradingFee    uint32 `protobuf:"varint,3,opt,name=trading_fee,json=tradingFee,proto3" json:"trading_fee,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TradingFee uint32 `protobuf:"varint,3,opt,name=trading_fee,json=tradingFee,proto3" json:"trading_fee,omitempty"`
+	// AMM trading fee after the vote-weighted average was recomputed (0-1000)
+	EffectiveTradingFee uint32 `protobuf:"varint,20,opt,name=effective_trading_fee,json=effectiveTradingFee,proto3" json:"effective_trading_fee,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *AMMVote) Reset() {
@@ -375,6 +396,13 @@ func (x *AMMVote) GetTradingFee() uint32 {
 	return 0
 }
 
+func (x *AMMVote) GetEffectiveTradingFee() uint32 {
+	if x != nil {
+		return x.EffectiveTradingFee
+	}
+	return 0
+}
+
 // AMMBid - Bids on the auction slot of an AMM
 // Reference: https://xrpl.org/ammbid.html
 type AMMBid struct {
@@ -646,12 +674,15 @@ var File_sf_xrpl_type_v1_amm_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_amm_proto_rawDesc = "" +
 	"\n" +
-	"\x19sf/xrpl/type/v1/amm.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\x90\x01\n" +
+	"\x19sf/xrpl/type/v1/amm.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xdd\x01\n" +
 	"\tAMMCreate\x12/\n" +
 	"\x06amount\x18\x01 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x121\n" +
 	"\aamount2\x18\x02 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\aamount2\x12\x1f\n" +
 	"\vtrading_fee\x18\x03 \x01(\rR\n" +
-	"tradingFee\"\xf2\x02\n" +
+	"tradingFee\x12\x1f\n" +
+	"\vamm_account\x18\x14 \x01(\tR\n" +
+	"ammAccount\x12*\n" +
+	"\x11lp_token_currency\x18\x15 \x01(\tR\x0flpTokenCurrency\"\xf2\x02\n" +
 	"\n" +
 	"AMMDeposit\x12,\n" +
 	"\x05asset\x18\x01 \x01(\v2\x16.sf.xrpl.type.v1.AssetR\x05asset\x12.\n" +
@@ -671,12 +702,13 @@ const file_sf_xrpl_type_v1_amm_proto_rawDesc = "" +
 	"\aamount2\x18\x04 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\aamount2\x120\n" +
 	"\ae_price\x18\x05 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06ePrice\x127\n" +
 	"\vlp_token_in\x18\x06 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\tlpTokenIn\x12\x14\n" +
-	"\x05flags\x18\a \x01(\rR\x05flags\"\x88\x01\n" +
+	"\x05flags\x18\a \x01(\rR\x05flags\"\xbc\x01\n" +
 	"\aAMMVote\x12,\n" +
 	"\x05asset\x18\x01 \x01(\v2\x16.sf.xrpl.type.v1.AssetR\x05asset\x12.\n" +
 	"\x06asset2\x18\x02 \x01(\v2\x16.sf.xrpl.type.v1.AssetR\x06asset2\x12\x1f\n" +
 	"\vtrading_fee\x18\x03 \x01(\rR\n" +
-	"tradingFee\"\x8d\x02\n" +
+	"tradingFee\x122\n" +
+	"\x15effective_trading_fee\x18\x14 \x01(\rR\x13effectiveTradingFee\"\x8d\x02\n" +
 	"\x06AMMBid\x12,\n" +
 	"\x05asset\x18\x01 \x01(\v2\x16.sf.xrpl.type.v1.AssetR\x05asset\x12.\n" +
 	"\x06asset2\x18\x02 \x01(\v2\x16.sf.xrpl.type.v1.AssetR\x06asset2\x120\n" +
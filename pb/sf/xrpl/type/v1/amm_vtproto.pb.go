@@ -28,6 +28,8 @@ func (m *AMMCreate) CloneVT() *AMMCreate {
 	r.Amount = m.Amount.CloneVT()
 	r.Amount2 = m.Amount2.CloneVT()
 	r.TradingFee = m.TradingFee
+	r.AmmAccount = m.AmmAccount
+	r.LpTokenCurrency = m.LpTokenCurrency
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -94,6 +96,7 @@ func (m *AMMVote) CloneVT() *AMMVote {
 	r.Asset = m.Asset.CloneVT()
 	r.Asset2 = m.Asset2.CloneVT()
 	r.TradingFee = m.TradingFee
+	r.EffectiveTradingFee = m.EffectiveTradingFee
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -203,6 +206,12 @@ func (this *AMMCreate) EqualVT(that *AMMCreate) bool {
 	if this.TradingFee != that.TradingFee {
 		return false
 	}
+	if this.AmmAccount != that.AmmAccount {
+		return false
+	}
+	if this.LpTokenCurrency != that.LpTokenCurrency {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -305,6 +314,9 @@ func (this *AMMVote) EqualVT(that *AMMVote) bool {
 	if this.TradingFee != that.TradingFee {
 		return false
 	}
+	if this.EffectiveTradingFee != that.EffectiveTradingFee {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -462,6 +474,24 @@ func (m *AMMCreate) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.LpTokenCurrency) > 0 {
+		i -= len(m.LpTokenCurrency)
+		copy(dAtA[i:], m.LpTokenCurrency)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.LpTokenCurrency)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.AmmAccount) > 0 {
+		i -= len(m.AmmAccount)
+		copy(dAtA[i:], m.AmmAccount)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.AmmAccount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.TradingFee != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TradingFee))
 		i--
@@ -721,6 +751,13 @@ func (m *AMMVote) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.EffectiveTradingFee != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.EffectiveTradingFee))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.TradingFee != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TradingFee))
 		i--
@@ -1032,6 +1069,24 @@ func (m *AMMCreate) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.LpTokenCurrency) > 0 {
+		i -= len(m.LpTokenCurrency)
+		copy(dAtA[i:], m.LpTokenCurrency)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.LpTokenCurrency)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.AmmAccount) > 0 {
+		i -= len(m.AmmAccount)
+		copy(dAtA[i:], m.AmmAccount)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.AmmAccount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.TradingFee != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TradingFee))
 		i--
@@ -1291,6 +1346,13 @@ func (m *AMMVote) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.EffectiveTradingFee != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.EffectiveTradingFee))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.TradingFee != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TradingFee))
 		i--
@@ -1589,6 +1651,14 @@ func (m *AMMCreate) SizeVT() (n int) {
 	if m.TradingFee != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.TradingFee))
 	}
+	l = len(m.AmmAccount)
+	if l > 0 {
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.LpTokenCurrency)
+	if l > 0 {
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -1687,6 +1757,9 @@ func (m *AMMVote) SizeVT() (n int) {
 	if m.TradingFee != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.TradingFee))
 	}
+	if m.EffectiveTradingFee != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.EffectiveTradingFee))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -1904,6 +1977,70 @@ func (m *AMMCreate) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmmAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpTokenCurrency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpTokenCurrency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -2637,6 +2774,25 @@ func (m *AMMVote) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTradingFee", wireType)
+			}
+			m.EffectiveTradingFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTradingFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -3424,6 +3580,78 @@ func (m *AMMCreate) UnmarshalVTUnsafe(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.AmmAccount = stringValue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpTokenCurrency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.LpTokenCurrency = stringValue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -4157,6 +4385,25 @@ func (m *AMMVote) UnmarshalVTUnsafe(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTradingFee", wireType)
+			}
+			m.EffectiveTradingFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTradingFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
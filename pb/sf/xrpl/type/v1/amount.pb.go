@@ -21,6 +21,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AmountKind - Kind of an Amount
+type AmountKind int32
+
+const (
+	AmountKind_AMOUNT_KIND_UNSPECIFIED AmountKind = 0
+	// XRP in drops (value only)
+	AmountKind_AMOUNT_KIND_XRP AmountKind = 1
+	// Issued token (value, currency and issuer)
+	AmountKind_AMOUNT_KIND_IOU AmountKind = 2
+	// Multi-purpose token (value and mpt_issuance_id)
+	AmountKind_AMOUNT_KIND_MPT AmountKind = 3
+)
+
+// Enum value maps for AmountKind.
+var (
+	AmountKind_name = map[int32]string{
+		0: "AMOUNT_KIND_UNSPECIFIED",
+		1: "AMOUNT_KIND_XRP",
+		2: "AMOUNT_KIND_IOU",
+		3: "AMOUNT_KIND_MPT",
+	}
+	AmountKind_value = map[string]int32{
+		"AMOUNT_KIND_UNSPECIFIED": 0,
+		"AMOUNT_KIND_XRP":         1,
+		"AMOUNT_KIND_IOU":         2,
+		"AMOUNT_KIND_MPT":         3,
+	}
+)
+
+func (x AmountKind) Enum() *AmountKind {
+	p := new(AmountKind)
+	*p = x
+	return p
+}
+
+func (x AmountKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmountKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_amount_proto_enumTypes[0].Descriptor()
+}
+
+func (AmountKind) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_amount_proto_enumTypes[0]
+}
+
+func (x AmountKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmountKind.Descriptor instead.
+func (AmountKind) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_amount_proto_rawDescGZIP(), []int{0}
+}
+
 // Amount can be XRP (drops), issued currency/token, or MPT
 // The format depends on which fields are populated:
 // - XRP: Only value field (drops as string)
@@ -30,7 +86,7 @@ type Amount struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Value of the amount
 	// For XRP: drops as string (e.g., "13100000" for 13.1 XRP)
-	// For token: decimal value as string, may use scientific notation (e.g., "153.75" or "1.23e11")
+	// For token: plain decimal value as string, without exponent or superfluous zeros (e.g., "153.75" or "-0.5")
 	// For MPT: positive integer as string (0x0 to 0x7FFFFFFFFFFFFFFF)
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	// Currency code for tokens (3-char or 40-hex)
@@ -44,6 +100,9 @@ type Amount struct {
 	// Only used for MPT amounts
 	// Empty for XRP and tokens
 	MptIssuanceId string `protobuf:"bytes,4,opt,name=mpt_issuance_id,json=mptIssuanceId,proto3" json:"mpt_issuance_id,omitempty"`
+	// Derived: which of XRP, token or MPT the amount is, so consumers need not infer it
+	// from the fields left empty
+	Kind          AmountKind `protobuf:"varint,5,opt,name=kind,proto3,enum=sf.xrpl.type.v1.AmountKind" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +165,13 @@ func (x *Amount) GetMptIssuanceId() string {
 	return ""
 }
 
+func (x *Amount) GetKind() AmountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AmountKind_AMOUNT_KIND_UNSPECIFIED
+}
+
 // Currency asset identifier (for AMM, specifying without amounts, etc.)
 // Can represent XRP, tokens, or MPTs:
 // - XRP: Only currency field set to "XRP"
@@ -288,12 +354,13 @@ var File_sf_xrpl_type_v1_amount_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_amount_proto_rawDesc = "" +
 	"\n" +
-	"\x1csf/xrpl/type/v1/amount.proto\x12\x0fsf.xrpl.type.v1\"z\n" +
+	"\x1csf/xrpl/type/v1/amount.proto\x12\x0fsf.xrpl.type.v1\"\xab\x01\n" +
 	"\x06Amount\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12&\n" +
-	"\x0fmpt_issuance_id\x18\x04 \x01(\tR\rmptIssuanceId\"c\n" +
+	"\x0fmpt_issuance_id\x18\x04 \x01(\tR\rmptIssuanceId\x12/\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1b.sf.xrpl.type.v1.AmountKindR\x04kind\"c\n" +
 	"\x05Asset\x12\x1a\n" +
 	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12&\n" +
@@ -303,7 +370,13 @@ const file_sf_xrpl_type_v1_amount_proto_rawDesc = "" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06issuer\x18\x03 \x01(\tR\x06issuer\"@\n" +
 	"\x04Path\x128\n" +
-	"\belements\x18\x01 \x03(\v2\x1c.sf.xrpl.type.v1.PathElementR\belementsBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\belements\x18\x01 \x03(\v2\x1c.sf.xrpl.type.v1.PathElementR\belements*h\n" +
+	"\n" +
+	"AmountKind\x12\x1b\n" +
+	"\x17AMOUNT_KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fAMOUNT_KIND_XRP\x10\x01\x12\x13\n" +
+	"\x0fAMOUNT_KIND_IOU\x10\x02\x12\x13\n" +
+	"\x0fAMOUNT_KIND_MPT\x10\x03BAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_amount_proto_rawDescOnce sync.Once
@@ -317,20 +390,23 @@ func file_sf_xrpl_type_v1_amount_proto_rawDescGZIP() []byte {
 	return file_sf_xrpl_type_v1_amount_proto_rawDescData
 }
 
+var file_sf_xrpl_type_v1_amount_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_sf_xrpl_type_v1_amount_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_sf_xrpl_type_v1_amount_proto_goTypes = []any{
-	(*Amount)(nil),      // 0: sf.xrpl.type.v1.Amount
-	(*Asset)(nil),       // 1: sf.xrpl.type.v1.Asset
-	(*PathElement)(nil), // 2: sf.xrpl.type.v1.PathElement
-	(*Path)(nil),        // 3: sf.xrpl.type.v1.Path
+	(AmountKind)(0),     // 0: sf.xrpl.type.v1.AmountKind
+	(*Amount)(nil),      // 1: sf.xrpl.type.v1.Amount
+	(*Asset)(nil),       // 2: sf.xrpl.type.v1.Asset
+	(*PathElement)(nil), // 3: sf.xrpl.type.v1.PathElement
+	(*Path)(nil),        // 4: sf.xrpl.type.v1.Path
 }
 var file_sf_xrpl_type_v1_amount_proto_depIdxs = []int32{
-	2, // 0: sf.xrpl.type.v1.Path.elements:type_name -> sf.xrpl.type.v1.PathElement
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: sf.xrpl.type.v1.Amount.kind:type_name -> sf.xrpl.type.v1.AmountKind
+	3, // 1: sf.xrpl.type.v1.Path.elements:type_name -> sf.xrpl.type.v1.PathElement
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_amount_proto_init() }
@@ -343,13 +419,14 @@ func file_sf_xrpl_type_v1_amount_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_amount_proto_rawDesc), len(file_sf_xrpl_type_v1_amount_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sf_xrpl_type_v1_amount_proto_goTypes,
 		DependencyIndexes: file_sf_xrpl_type_v1_amount_proto_depIdxs,
+		EnumInfos:         file_sf_xrpl_type_v1_amount_proto_enumTypes,
 		MessageInfos:      file_sf_xrpl_type_v1_amount_proto_msgTypes,
 	}.Build()
 	File_sf_xrpl_type_v1_amount_proto = out.File
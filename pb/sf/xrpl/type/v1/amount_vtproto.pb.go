@@ -29,6 +29,7 @@ func (m *Amount) CloneVT() *Amount {
 	r.Currency = m.Currency
 	r.Issuer = m.Issuer
 	r.MptIssuanceId = m.MptIssuanceId
+	r.Kind = m.Kind
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -119,6 +120,9 @@ func (this *Amount) EqualVT(that *Amount) bool {
 	if this.MptIssuanceId != that.MptIssuanceId {
 		return false
 	}
+	if this.Kind != that.Kind {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -242,6 +246,11 @@ func (m *Amount) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.Kind != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.MptIssuanceId) > 0 {
 		i -= len(m.MptIssuanceId)
 		copy(dAtA[i:], m.MptIssuanceId)
@@ -456,6 +465,11 @@ func (m *Amount) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.Kind != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.MptIssuanceId) > 0 {
 		i -= len(m.MptIssuanceId)
 		copy(dAtA[i:], m.MptIssuanceId)
@@ -662,6 +676,9 @@ func (m *Amount) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.Kind != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.Kind))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -883,6 +900,25 @@ func (m *Amount) UnmarshalVT(dAtA []byte) error {
 			}
 			m.MptIssuanceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= AmountKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -1457,6 +1493,25 @@ func (m *Amount) UnmarshalVTUnsafe(dAtA []byte) error {
 			}
 			m.MptIssuanceId = stringValue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= AmountKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
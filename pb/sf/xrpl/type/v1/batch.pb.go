@@ -21,6 +21,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BatchMode - Execution semantics of a batch's inner transactions
+type BatchMode int32
+
+const (
+	// No mode flag set, or more than one
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// tfAllOrNothing - all inner transactions succeed or none are applied
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 1
+	// tfOnlyOne - only the first successful inner transaction is applied
+	BatchMode_BATCH_MODE_ONLY_ONE BatchMode = 2
+	// tfUntilFailure - inner transactions are applied until the first failure
+	BatchMode_BATCH_MODE_UNTIL_FAILURE BatchMode = 3
+	// tfIndependent - every inner transaction is applied regardless of failures
+	BatchMode_BATCH_MODE_INDEPENDENT BatchMode = 4
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ALL_OR_NOTHING",
+		2: "BATCH_MODE_ONLY_ONE",
+		3: "BATCH_MODE_UNTIL_FAILURE",
+		4: "BATCH_MODE_INDEPENDENT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED":    0,
+		"BATCH_MODE_ALL_OR_NOTHING": 1,
+		"BATCH_MODE_ONLY_ONE":       2,
+		"BATCH_MODE_UNTIL_FAILURE":  3,
+		"BATCH_MODE_INDEPENDENT":    4,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_batch_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_batch_proto_enumTypes[0]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_batch_proto_rawDescGZIP(), []int{0}
+}
+
 // Batch - Submit multiple transactions atomically
 // Reference: https://xrpl.org/batch.html
 type Batch struct {
@@ -35,7 +96,9 @@ type Batch struct {
 	// transaction is applied tfUntilFailure = 262144 (0x00040000) - Apply until
 	// first failure, skip rest tfIndependent = 524288 (0x00080000) - Apply all
 	// transactions regardless of failure
-	Flags         uint32 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	Flags uint32 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Derived: execution mode decoded from flags
+	Mode          BatchMode `protobuf:"varint,4,opt,name=mode,proto3,enum=sf.xrpl.type.v1.BatchMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,10 +154,18 @@ func (x *Batch) GetFlags() uint32 {
 	return 0
 }
 
+func (x *Batch) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
 // RawTransaction - Inner transaction within a batch
 type RawTransaction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The serialized transaction data (JSON encoded)
+	// The serialized inner transaction (binary), decoded in the batch
+	// transaction's inner_transactions
 	// Must have tfInnerBatchTxn flag (1073741824 / 0x40000000)
 	// Must have Fee = "0"
 	// Must have SigningPubKey = ""
@@ -218,18 +289,25 @@ var File_sf_xrpl_type_v1_batch_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_batch_proto_rawDesc = "" +
 	"\n" +
-	"\x1bsf/xrpl/type/v1/batch.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/signer.proto\"\xac\x01\n" +
+	"\x1bsf/xrpl/type/v1/batch.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/signer.proto\"\xdc\x01\n" +
 	"\x05Batch\x12J\n" +
 	"\x10raw_transactions\x18\x01 \x03(\v2\x1f.sf.xrpl.type.v1.RawTransactionR\x0frawTransactions\x12A\n" +
 	"\rbatch_signers\x18\x02 \x03(\v2\x1c.sf.xrpl.type.v1.BatchSignerR\fbatchSigners\x12\x14\n" +
-	"\x05flags\x18\x03 \x01(\rR\x05flags\"9\n" +
+	"\x05flags\x18\x03 \x01(\rR\x05flags\x12.\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x1a.sf.xrpl.type.v1.BatchModeR\x04mode\"9\n" +
 	"\x0eRawTransaction\x12'\n" +
 	"\x0fraw_transaction\x18\x01 \x01(\fR\x0erawTransaction\"\xa7\x01\n" +
 	"\vBatchSigner\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12&\n" +
 	"\x0fsigning_pub_key\x18\x02 \x01(\tR\rsigningPubKey\x12#\n" +
 	"\rtxn_signature\x18\x03 \x01(\tR\ftxnSignature\x121\n" +
-	"\asigners\x18\x04 \x03(\v2\x17.sf.xrpl.type.v1.SignerR\asignersBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\asigners\x18\x04 \x03(\v2\x17.sf.xrpl.type.v1.SignerR\asigners*\x99\x01\n" +
+	"\tBatchMode\x12\x1a\n" +
+	"\x16BATCH_MODE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BATCH_MODE_ALL_OR_NOTHING\x10\x01\x12\x17\n" +
+	"\x13BATCH_MODE_ONLY_ONE\x10\x02\x12\x1c\n" +
+	"\x18BATCH_MODE_UNTIL_FAILURE\x10\x03\x12\x1a\n" +
+	"\x16BATCH_MODE_INDEPENDENT\x10\x04BAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_batch_proto_rawDescOnce sync.Once
@@ -243,22 +321,25 @@ func file_sf_xrpl_type_v1_batch_proto_rawDescGZIP() []byte {
 	return file_sf_xrpl_type_v1_batch_proto_rawDescData
 }
 
+var file_sf_xrpl_type_v1_batch_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_sf_xrpl_type_v1_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_sf_xrpl_type_v1_batch_proto_goTypes = []any{
-	(*Batch)(nil),          // 0: sf.xrpl.type.v1.Batch
-	(*RawTransaction)(nil), // 1: sf.xrpl.type.v1.RawTransaction
-	(*BatchSigner)(nil),    // 2: sf.xrpl.type.v1.BatchSigner
-	(*Signer)(nil),         // 3: sf.xrpl.type.v1.Signer
+	(BatchMode)(0),         // 0: sf.xrpl.type.v1.BatchMode
+	(*Batch)(nil),          // 1: sf.xrpl.type.v1.Batch
+	(*RawTransaction)(nil), // 2: sf.xrpl.type.v1.RawTransaction
+	(*BatchSigner)(nil),    // 3: sf.xrpl.type.v1.BatchSigner
+	(*Signer)(nil),         // 4: sf.xrpl.type.v1.Signer
 }
 var file_sf_xrpl_type_v1_batch_proto_depIdxs = []int32{
-	1, // 0: sf.xrpl.type.v1.Batch.raw_transactions:type_name -> sf.xrpl.type.v1.RawTransaction
-	2, // 1: sf.xrpl.type.v1.Batch.batch_signers:type_name -> sf.xrpl.type.v1.BatchSigner
-	3, // 2: sf.xrpl.type.v1.BatchSigner.signers:type_name -> sf.xrpl.type.v1.Signer
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 0: sf.xrpl.type.v1.Batch.raw_transactions:type_name -> sf.xrpl.type.v1.RawTransaction
+	3, // 1: sf.xrpl.type.v1.Batch.batch_signers:type_name -> sf.xrpl.type.v1.BatchSigner
+	0, // 2: sf.xrpl.type.v1.Batch.mode:type_name -> sf.xrpl.type.v1.BatchMode
+	4, // 3: sf.xrpl.type.v1.BatchSigner.signers:type_name -> sf.xrpl.type.v1.Signer
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_batch_proto_init() }
@@ -272,13 +353,14 @@ func file_sf_xrpl_type_v1_batch_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_batch_proto_rawDesc), len(file_sf_xrpl_type_v1_batch_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sf_xrpl_type_v1_batch_proto_goTypes,
 		DependencyIndexes: file_sf_xrpl_type_v1_batch_proto_depIdxs,
+		EnumInfos:         file_sf_xrpl_type_v1_batch_proto_enumTypes,
 		MessageInfos:      file_sf_xrpl_type_v1_batch_proto_msgTypes,
 	}.Build()
 	File_sf_xrpl_type_v1_batch_proto = out.File
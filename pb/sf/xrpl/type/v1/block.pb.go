@@ -9,6 +9,7 @@ package pbxrpl
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -126,9 +127,25 @@ type Header struct {
 	// Close time resolution in seconds
 	CloseTimeResolution uint32 `protobuf:"varint,5,opt,name=close_time_resolution,json=closeTimeResolution,proto3" json:"close_time_resolution,omitempty"`
 	// Close flags
-	CloseFlags    uint32 `protobuf:"varint,6,opt,name=close_flags,json=closeFlags,proto3" json:"close_flags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CloseFlags uint32 `protobuf:"varint,6,opt,name=close_flags,json=closeFlags,proto3" json:"close_flags,omitempty"`
+	// Derived: fee and reserve settings in effect (drops), from the FeeSettings ledger entry
+	// They only change on flag ledgers, 0 when the ledger has no FeeSettings entry
+	BaseFee          uint64 `protobuf:"varint,7,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	ReserveBase      uint64 `protobuf:"varint,8,opt,name=reserve_base,json=reserveBase,proto3" json:"reserve_base,omitempty"`
+	ReserveIncrement uint64 `protobuf:"varint,9,opt,name=reserve_increment,json=reserveIncrement,proto3" json:"reserve_increment,omitempty"`
+	// Number of transactions in the ledger, including any left out of the block
+	// by a transaction type filter, because they failed to decode or because they
+	// exceed the fetcher's maximum transaction size
+	TransactionCount uint32 `protobuf:"varint,10,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	// True when the fetcher left transactions out of the block by type, the block's
+	// transactions are then a subset of the ledger's (each keeping its ledger index)
+	Filtered bool `protobuf:"varint,11,opt,name=filtered,proto3" json:"filtered,omitempty"`
+	// Number of the ledger's transactions missing from the block because they failed
+	// to decode or exceed the fetcher's maximum transaction size, 0 when the block
+	// holds every transaction (transactions left out by type are not counted)
+	SkippedTransactionCount uint32 `protobuf:"varint,12,opt,name=skipped_transaction_count,json=skippedTransactionCount,proto3" json:"skipped_transaction_count,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *Header) Reset() {
@@ -203,6 +220,48 @@ func (x *Header) GetCloseFlags() uint32 {
 	return 0
 }
 
+func (x *Header) GetBaseFee() uint64 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
+}
+
+func (x *Header) GetReserveBase() uint64 {
+	if x != nil {
+		return x.ReserveBase
+	}
+	return 0
+}
+
+func (x *Header) GetReserveIncrement() uint64 {
+	if x != nil {
+		return x.ReserveIncrement
+	}
+	return 0
+}
+
+func (x *Header) GetTransactionCount() uint32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *Header) GetFiltered() bool {
+	if x != nil {
+		return x.Filtered
+	}
+	return false
+}
+
+func (x *Header) GetSkippedTransactionCount() uint32 {
+	if x != nil {
+		return x.SkippedTransactionCount
+	}
+	return 0
+}
+
 type Transaction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Transaction hash (32 bytes)
@@ -210,7 +269,9 @@ type Transaction struct {
 	// Transaction result code (e.g., "tesSUCCESS", "tecPATH_DRY", "temMALFORMED")
 	// Future-proof: supports any result code XRPL adds without schema updates
 	Result string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	// Position in ledger (0-indexed)
+	// Position in ledger (0-indexed): the metadata TransactionIndex, the order transactions
+	// were applied in, which block transactions are sorted by. Transactions whose metadata fails
+	// to decode come last, numbered on from the last known index
 	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
 	// Raw transaction blob (XRPL binary format)
 	// Kept for backward compatibility and advanced decoding
@@ -256,6 +317,47 @@ type Transaction struct {
 	TicketSequence uint32 `protobuf:"varint,19,opt,name=ticket_sequence,json=ticketSequence,proto3" json:"ticket_sequence,omitempty"`
 	// Signature that verifies this transaction as originating from the account
 	TxnSignature string `protobuf:"bytes,20,opt,name=txn_signature,json=txnSignature,proto3" json:"txn_signature,omitempty"`
+	// Derived: true when signing_pub_key is empty and signers is non-empty
+	IsMultisigned bool `protobuf:"varint,21,opt,name=is_multisigned,json=isMultisigned,proto3" json:"is_multisigned,omitempty"`
+	// Derived: last_ledger_sequence minus the ledger the transaction was included in
+	// Only set when last_ledger_sequence is present
+	LedgersUntilExpiry int64 `protobuf:"varint,22,opt,name=ledgers_until_expiry,json=ledgersUntilExpiry,proto3" json:"ledgers_until_expiry,omitempty"`
+	// True when the codec could not decode the tx or meta blob because it is
+	// older than the network (unknown field or type). Only the raw blobs, hash
+	// and index are set in that case
+	CodecOutdated bool `protobuf:"varint,23,opt,name=codec_outdated,json=codecOutdated,proto3" json:"codec_outdated,omitempty"`
+	// (Optional) Decoded metadata (AffectedNodes, TransactionResult, ...) as
+	// produced by the binary codec, only set when enabled on the fetcher
+	DecodedMeta *structpb.Struct `protobuf:"bytes,24,opt,name=decoded_meta,json=decodedMeta,proto3" json:"decoded_meta,omitempty"`
+	// Derived: names of the flags set in flags (type-specific and universal),
+	// empty when flags is absent or 0
+	SetFlags []string `protobuf:"bytes,25,rep,name=set_flags,json=setFlags,proto3" json:"set_flags,omitempty"`
+	// Ledger entries created, modified or deleted by the transaction, in
+	// metadata AffectedNodes order
+	StateChanges []*StateChange `protobuf:"bytes,26,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	// Derived: tx_type as an enum, TRANSACTION_TYPE_UNKNOWN for types this schema
+	// does not know yet (tx_type still carries the name)
+	TransactionType TransactionType `protobuf:"varint,27,opt,name=transaction_type,json=transactionType,proto3,enum=sf.xrpl.type.v1.TransactionType" json:"transaction_type,omitempty"`
+	// Derived: inner transactions of a Batch, decoded from its raw_transactions, index being
+	// the position in the batch. They carry no result, metadata or state changes: every
+	// inner transaction applied is also in the ledger as a transaction of its own
+	InnerTransactions []*Transaction `protobuf:"bytes,28,rep,name=inner_transactions,json=innerTransactions,proto3" json:"inner_transactions,omitempty"`
+	// Derived: true for tes and tec results, the transaction was applied to the ledger
+	// (a tec only claims the fee but may still create or delete entries). Other results
+	// were not applied and carry no state changes
+	Applied bool `protobuf:"varint,29,opt,name=applied,proto3" json:"applied,omitempty"`
+	// Ledger the transaction was included in and its close time, copied from the
+	// enclosing block so a transaction extracted from it stays self-describing
+	// Not set on inner_transactions
+	LedgerIndex uint64                 `protobuf:"varint,31,opt,name=ledger_index,json=ledgerIndex,proto3" json:"ledger_index,omitempty"`
+	CloseTime   *timestamppb.Timestamp `protobuf:"bytes,32,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	// Derived: NFToken owner changes (mints, transfers and burns) from the NFTokenPage entries
+	// the transaction changed, netted per owner so tokens moved between pages of one account
+	// are left out. Only set for applied transactions, like state_changes
+	NftokenTransfers []*NFTokenTransfer `protobuf:"bytes,33,rep,name=nftoken_transfers,json=nftokenTransfers,proto3" json:"nftoken_transfers,omitempty"`
+	// Derived: NFTokenOffer entries created or deleted by the transaction (created by
+	// NFTokenCreateOffer or NFTokenMint, deleted when accepted, cancelled or their token burned)
+	NftokenOfferChanges []*NFTokenOfferChange `protobuf:"bytes,34,rep,name=nftoken_offer_changes,json=nftokenOfferChanges,proto3" json:"nftoken_offer_changes,omitempty"`
 	// Decoded transaction details based on tx_type
 	//
 	// Types that are valid to be assigned to TxDetails:
@@ -312,6 +414,7 @@ type Transaction struct {
 	//	*Transaction_SetFee
 	//	*Transaction_UnlModify
 	//	*Transaction_LedgerStateFix
+	//	*Transaction_UnknownDetails
 	TxDetails     isTransaction_TxDetails `protobuf_oneof:"tx_details"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -487,6 +590,97 @@ func (x *Transaction) GetTxnSignature() string {
 	return ""
 }
 
+func (x *Transaction) GetIsMultisigned() bool {
+	if x != nil {
+		return x.IsMultisigned
+	}
+	return false
+}
+
+func (x *Transaction) GetLedgersUntilExpiry() int64 {
+	if x != nil {
+		return x.LedgersUntilExpiry
+	}
+	return 0
+}
+
+func (x *Transaction) GetCodecOutdated() bool {
+	if x != nil {
+		return x.CodecOutdated
+	}
+	return false
+}
+
+func (x *Transaction) GetDecodedMeta() *structpb.Struct {
+	if x != nil {
+		return x.DecodedMeta
+	}
+	return nil
+}
+
+func (x *Transaction) GetSetFlags() []string {
+	if x != nil {
+		return x.SetFlags
+	}
+	return nil
+}
+
+func (x *Transaction) GetStateChanges() []*StateChange {
+	if x != nil {
+		return x.StateChanges
+	}
+	return nil
+}
+
+func (x *Transaction) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNKNOWN
+}
+
+func (x *Transaction) GetInnerTransactions() []*Transaction {
+	if x != nil {
+		return x.InnerTransactions
+	}
+	return nil
+}
+
+func (x *Transaction) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *Transaction) GetLedgerIndex() uint64 {
+	if x != nil {
+		return x.LedgerIndex
+	}
+	return 0
+}
+
+func (x *Transaction) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *Transaction) GetNftokenTransfers() []*NFTokenTransfer {
+	if x != nil {
+		return x.NftokenTransfers
+	}
+	return nil
+}
+
+func (x *Transaction) GetNftokenOfferChanges() []*NFTokenOfferChange {
+	if x != nil {
+		return x.NftokenOfferChanges
+	}
+	return nil
+}
+
 func (x *Transaction) GetTxDetails() isTransaction_TxDetails {
 	if x != nil {
 		return x.TxDetails
@@ -962,6 +1156,15 @@ func (x *Transaction) GetLedgerStateFix() *LedgerStateFix {
 	return nil
 }
 
+func (x *Transaction) GetUnknownDetails() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.TxDetails.(*Transaction_UnknownDetails); ok {
+			return x.UnknownDetails
+		}
+	}
+	return nil
+}
+
 type isTransaction_TxDetails interface {
 	isTransaction_TxDetails()
 }
@@ -1193,6 +1396,12 @@ type Transaction_LedgerStateFix struct {
 	LedgerStateFix *LedgerStateFix `protobuf:"bytes,903,opt,name=ledger_state_fix,json=ledgerStateFix,proto3,oneof"`
 }
 
+type Transaction_UnknownDetails struct {
+	// Transaction type this schema has no mapping for yet (see tx_type): all its fields
+	// as decoded by the binary codec, so nothing is lost until a mapping is added
+	UnknownDetails *structpb.Struct `protobuf:"bytes,1000,opt,name=unknown_details,json=unknownDetails,proto3,oneof"`
+}
+
 func (*Transaction_Payment) isTransaction_TxDetails() {}
 
 func (*Transaction_OfferCreate) isTransaction_TxDetails() {}
@@ -1297,6 +1506,8 @@ func (*Transaction_UnlModify) isTransaction_TxDetails() {}
 
 func (*Transaction_LedgerStateFix) isTransaction_TxDetails() {}
 
+func (*Transaction_UnknownDetails) isTransaction_TxDetails() {}
+
 // Memo attached to a transaction
 type Memo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1307,7 +1518,10 @@ type Memo struct {
 	MemoFormat string `protobuf:"bytes,2,opt,name=memo_format,json=memoFormat,proto3" json:"memo_format,omitempty"`
 	// Hex value representing characters allowed in URLs
 	// Conventionally, a unique relation that defines the format of this memo
-	MemoType      string `protobuf:"bytes,3,opt,name=memo_type,json=memoType,proto3" json:"memo_type,omitempty"`
+	MemoType string `protobuf:"bytes,3,opt,name=memo_type,json=memoType,proto3" json:"memo_type,omitempty"`
+	// Derived: memo_data decoded as UTF-8 text when memo_format is a text/ media type
+	// (e.g., "text/plain") or absent, empty for binary or invalid UTF-8 data
+	MemoText      string `protobuf:"bytes,4,opt,name=memo_text,json=memoText,proto3" json:"memo_text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1363,11 +1577,18 @@ func (x *Memo) GetMemoType() string {
 	return ""
 }
 
+func (x *Memo) GetMemoText() string {
+	if x != nil {
+		return x.MemoText
+	}
+	return ""
+}
+
 var File_sf_xrpl_type_v1_block_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_block_proto_rawDesc = "" +
 	"\n" +
-	"\x1bsf/xrpl/type/v1/block.proto\x12\x0fsf.xrpl.type.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1csf/xrpl/type/v1/signer.proto\x1a\x1dsf/xrpl/type/v1/payment.proto\x1a\x1bsf/xrpl/type/v1/offer.proto\x1a\x1fsf/xrpl/type/v1/trustline.proto\x1a\x1dsf/xrpl/type/v1/account.proto\x1a\x1csf/xrpl/type/v1/escrow.proto\x1a\x19sf/xrpl/type/v1/nft.proto\x1a%sf/xrpl/type/v1/payment_channel.proto\x1a\x1bsf/xrpl/type/v1/check.proto\x1a%sf/xrpl/type/v1/deposit_preauth.proto\x1a\x1csf/xrpl/type/v1/ticket.proto\x1a\x1esf/xrpl/type/v1/clawback.proto\x1a\x19sf/xrpl/type/v1/amm.proto\x1a\x19sf/xrpl/type/v1/did.proto\x1a\x1csf/xrpl/type/v1/oracle.proto\x1a\x1dsf/xrpl/type/v1/mptoken.proto\x1a sf/xrpl/type/v1/credential.proto\x1a)sf/xrpl/type/v1/permissioned_domain.proto\x1a\x1esf/xrpl/type/v1/delegate.proto\x1a\x1csf/xrpl/type/v1/system.proto\x1a\x1bsf/xrpl/type/v1/batch.proto\"\xfb\x01\n" +
+	"\x1bsf/xrpl/type/v1/block.proto\x12\x0fsf.xrpl.type.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1csf/xrpl/type/v1/signer.proto\x1a\"sf/xrpl/type/v1/state_change.proto\x1a&sf/xrpl/type/v1/transaction_type.proto\x1a\x1dsf/xrpl/type/v1/payment.proto\x1a\x1bsf/xrpl/type/v1/offer.proto\x1a\x1fsf/xrpl/type/v1/trustline.proto\x1a\x1dsf/xrpl/type/v1/account.proto\x1a\x1csf/xrpl/type/v1/escrow.proto\x1a\x19sf/xrpl/type/v1/nft.proto\x1a%sf/xrpl/type/v1/payment_channel.proto\x1a\x1bsf/xrpl/type/v1/check.proto\x1a%sf/xrpl/type/v1/deposit_preauth.proto\x1a\x1csf/xrpl/type/v1/ticket.proto\x1a\x1esf/xrpl/type/v1/clawback.proto\x1a\x19sf/xrpl/type/v1/amm.proto\x1a\x19sf/xrpl/type/v1/did.proto\x1a\x1csf/xrpl/type/v1/oracle.proto\x1a\x1dsf/xrpl/type/v1/mptoken.proto\x1a sf/xrpl/type/v1/credential.proto\x1a)sf/xrpl/type/v1/permissioned_domain.proto\x1a\x1esf/xrpl/type/v1/delegate.proto\x1a\x1csf/xrpl/type/v1/system.proto\x1a\x1bsf/xrpl/type/v1/batch.proto\"\xfb\x01\n" +
 	"\x05Block\x12\x16\n" +
 	"\x06number\x18\x01 \x01(\x04R\x06number\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\fR\x04hash\x12/\n" +
@@ -1375,7 +1596,7 @@ const file_sf_xrpl_type_v1_block_proto_rawDesc = "" +
 	"\aversion\x18\x04 \x01(\x03R\aversion\x12@\n" +
 	"\ftransactions\x18\x05 \x03(\v2\x1c.sf.xrpl.type.v1.TransactionR\ftransactions\x129\n" +
 	"\n" +
-	"close_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\"\xed\x01\n" +
+	"close_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\"\xdd\x03\n" +
 	"\x06Header\x12\x1f\n" +
 	"\vparent_hash\x18\x01 \x01(\fR\n" +
 	"parentHash\x12\x1f\n" +
@@ -1385,7 +1606,14 @@ const file_sf_xrpl_type_v1_block_proto_rawDesc = "" +
 	"\x10transaction_hash\x18\x04 \x01(\fR\x0ftransactionHash\x122\n" +
 	"\x15close_time_resolution\x18\x05 \x01(\rR\x13closeTimeResolution\x12\x1f\n" +
 	"\vclose_flags\x18\x06 \x01(\rR\n" +
-	"closeFlags\"\xe1\"\n" +
+	"closeFlags\x12\x19\n" +
+	"\bbase_fee\x18\a \x01(\x04R\abaseFee\x12!\n" +
+	"\freserve_base\x18\b \x01(\x04R\vreserveBase\x12+\n" +
+	"\x11reserve_increment\x18\t \x01(\x04R\x10reserveIncrement\x12+\n" +
+	"\x11transaction_count\x18\n" +
+	" \x01(\rR\x10transactionCount\x12\x1a\n" +
+	"\bfiltered\x18\v \x01(\bR\bfiltered\x12:\n" +
+	"\x19skipped_transaction_count\x18\f \x01(\rR\x17skippedTransactionCount\"\xfc(\n" +
 	"\vTransaction\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x16\n" +
 	"\x06result\x18\x02 \x01(\tR\x06result\x12\x14\n" +
@@ -1409,7 +1637,21 @@ const file_sf_xrpl_type_v1_block_proto_rawDesc = "" +
 	"source_tag\x18\x11 \x01(\rR\tsourceTag\x12&\n" +
 	"\x0fsigning_pub_key\x18\x12 \x01(\tR\rsigningPubKey\x12'\n" +
 	"\x0fticket_sequence\x18\x13 \x01(\rR\x0eticketSequence\x12#\n" +
-	"\rtxn_signature\x18\x14 \x01(\tR\ftxnSignature\x124\n" +
+	"\rtxn_signature\x18\x14 \x01(\tR\ftxnSignature\x12%\n" +
+	"\x0eis_multisigned\x18\x15 \x01(\bR\risMultisigned\x120\n" +
+	"\x14ledgers_until_expiry\x18\x16 \x01(\x03R\x12ledgersUntilExpiry\x12%\n" +
+	"\x0ecodec_outdated\x18\x17 \x01(\bR\rcodecOutdated\x12:\n" +
+	"\fdecoded_meta\x18\x18 \x01(\v2\x17.google.protobuf.StructR\vdecodedMeta\x12\x1b\n" +
+	"\tset_flags\x18\x19 \x03(\tR\bsetFlags\x12A\n" +
+	"\rstate_changes\x18\x1a \x03(\v2\x1c.sf.xrpl.type.v1.StateChangeR\fstateChanges\x12K\n" +
+	"\x10transaction_type\x18\x1b \x01(\x0e2 .sf.xrpl.type.v1.TransactionTypeR\x0ftransactionType\x12K\n" +
+	"\x12inner_transactions\x18\x1c \x03(\v2\x1c.sf.xrpl.type.v1.TransactionR\x11innerTransactions\x12\x18\n" +
+	"\aapplied\x18\x1d \x01(\bR\aapplied\x12!\n" +
+	"\fledger_index\x18\x1f \x01(\x04R\vledgerIndex\x129\n" +
+	"\n" +
+	"close_time\x18  \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x12M\n" +
+	"\x11nftoken_transfers\x18! \x03(\v2 .sf.xrpl.type.v1.NFTokenTransferR\x10nftokenTransfers\x12W\n" +
+	"\x15nftoken_offer_changes\x18\" \x03(\v2#.sf.xrpl.type.v1.NFTokenOfferChangeR\x13nftokenOfferChanges\x124\n" +
 	"\apayment\x18\x1e \x01(\v2\x18.sf.xrpl.type.v1.PaymentH\x00R\apayment\x12A\n" +
 	"\foffer_create\x18( \x01(\v2\x1c.sf.xrpl.type.v1.OfferCreateH\x00R\vofferCreate\x12A\n" +
 	"\foffer_cancel\x18) \x01(\v2\x1c.sf.xrpl.type.v1.OfferCancelH\x00R\vofferCancel\x128\n" +
@@ -1469,14 +1711,16 @@ const file_sf_xrpl_type_v1_block_proto_rawDesc = "" +
 	"\aset_fee\x18\x85\a \x01(\v2\x17.sf.xrpl.type.v1.SetFeeH\x00R\x06setFee\x12<\n" +
 	"\n" +
 	"unl_modify\x18\x86\a \x01(\v2\x1a.sf.xrpl.type.v1.UNLModifyH\x00R\tunlModify\x12L\n" +
-	"\x10ledger_state_fix\x18\x87\a \x01(\v2\x1f.sf.xrpl.type.v1.LedgerStateFixH\x00R\x0eledgerStateFixB\f\n" +
+	"\x10ledger_state_fix\x18\x87\a \x01(\v2\x1f.sf.xrpl.type.v1.LedgerStateFixH\x00R\x0eledgerStateFix\x12C\n" +
+	"\x0funknown_details\x18\xe8\a \x01(\v2\x17.google.protobuf.StructH\x00R\x0eunknownDetailsB\f\n" +
 	"\n" +
-	"tx_details\"a\n" +
+	"tx_details\"~\n" +
 	"\x04Memo\x12\x1b\n" +
 	"\tmemo_data\x18\x01 \x01(\tR\bmemoData\x12\x1f\n" +
 	"\vmemo_format\x18\x02 \x01(\tR\n" +
 	"memoFormat\x12\x1b\n" +
-	"\tmemo_type\x18\x03 \x01(\tR\bmemoTypeBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\tmemo_type\x18\x03 \x01(\tR\bmemoType\x12\x1b\n" +
+	"\tmemo_text\x18\x04 \x01(\tR\bmemoTextBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_block_proto_rawDescOnce sync.Once
@@ -1498,58 +1742,63 @@ var file_sf_xrpl_type_v1_block_proto_goTypes = []any{
 	(*Memo)(nil),                     // 3: sf.xrpl.type.v1.Memo
 	(*timestamppb.Timestamp)(nil),    // 4: google.protobuf.Timestamp
 	(*Signer)(nil),                   // 5: sf.xrpl.type.v1.Signer
-	(*Payment)(nil),                  // 6: sf.xrpl.type.v1.Payment
-	(*OfferCreate)(nil),              // 7: sf.xrpl.type.v1.OfferCreate
-	(*OfferCancel)(nil),              // 8: sf.xrpl.type.v1.OfferCancel
-	(*TrustSet)(nil),                 // 9: sf.xrpl.type.v1.TrustSet
-	(*AccountSet)(nil),               // 10: sf.xrpl.type.v1.AccountSet
-	(*AccountDelete)(nil),            // 11: sf.xrpl.type.v1.AccountDelete
-	(*SetRegularKey)(nil),            // 12: sf.xrpl.type.v1.SetRegularKey
-	(*SignerListSet)(nil),            // 13: sf.xrpl.type.v1.SignerListSet
-	(*EscrowCreate)(nil),             // 14: sf.xrpl.type.v1.EscrowCreate
-	(*EscrowFinish)(nil),             // 15: sf.xrpl.type.v1.EscrowFinish
-	(*EscrowCancel)(nil),             // 16: sf.xrpl.type.v1.EscrowCancel
-	(*PaymentChannelCreate)(nil),     // 17: sf.xrpl.type.v1.PaymentChannelCreate
-	(*PaymentChannelFund)(nil),       // 18: sf.xrpl.type.v1.PaymentChannelFund
-	(*PaymentChannelClaim)(nil),      // 19: sf.xrpl.type.v1.PaymentChannelClaim
-	(*CheckCreate)(nil),              // 20: sf.xrpl.type.v1.CheckCreate
-	(*CheckCash)(nil),                // 21: sf.xrpl.type.v1.CheckCash
-	(*CheckCancel)(nil),              // 22: sf.xrpl.type.v1.CheckCancel
-	(*DepositPreauth)(nil),           // 23: sf.xrpl.type.v1.DepositPreauth
-	(*TicketCreate)(nil),             // 24: sf.xrpl.type.v1.TicketCreate
-	(*NFTokenMint)(nil),              // 25: sf.xrpl.type.v1.NFTokenMint
-	(*NFTokenBurn)(nil),              // 26: sf.xrpl.type.v1.NFTokenBurn
-	(*NFTokenCreateOffer)(nil),       // 27: sf.xrpl.type.v1.NFTokenCreateOffer
-	(*NFTokenCancelOffer)(nil),       // 28: sf.xrpl.type.v1.NFTokenCancelOffer
-	(*NFTokenAcceptOffer)(nil),       // 29: sf.xrpl.type.v1.NFTokenAcceptOffer
-	(*NFTokenModify)(nil),            // 30: sf.xrpl.type.v1.NFTokenModify
-	(*Clawback)(nil),                 // 31: sf.xrpl.type.v1.Clawback
-	(*AMMCreate)(nil),                // 32: sf.xrpl.type.v1.AMMCreate
-	(*AMMDeposit)(nil),               // 33: sf.xrpl.type.v1.AMMDeposit
-	(*AMMWithdraw)(nil),              // 34: sf.xrpl.type.v1.AMMWithdraw
-	(*AMMVote)(nil),                  // 35: sf.xrpl.type.v1.AMMVote
-	(*AMMBid)(nil),                   // 36: sf.xrpl.type.v1.AMMBid
-	(*AMMDelete)(nil),                // 37: sf.xrpl.type.v1.AMMDelete
-	(*AMMClawback)(nil),              // 38: sf.xrpl.type.v1.AMMClawback
-	(*DIDSet)(nil),                   // 39: sf.xrpl.type.v1.DIDSet
-	(*DIDDelete)(nil),                // 40: sf.xrpl.type.v1.DIDDelete
-	(*OracleSet)(nil),                // 41: sf.xrpl.type.v1.OracleSet
-	(*OracleDelete)(nil),             // 42: sf.xrpl.type.v1.OracleDelete
-	(*MPTokenIssuanceCreate)(nil),    // 43: sf.xrpl.type.v1.MPTokenIssuanceCreate
-	(*MPTokenIssuanceDestroy)(nil),   // 44: sf.xrpl.type.v1.MPTokenIssuanceDestroy
-	(*MPTokenIssuanceSet)(nil),       // 45: sf.xrpl.type.v1.MPTokenIssuanceSet
-	(*MPTokenAuthorize)(nil),         // 46: sf.xrpl.type.v1.MPTokenAuthorize
-	(*CredentialCreate)(nil),         // 47: sf.xrpl.type.v1.CredentialCreate
-	(*CredentialAccept)(nil),         // 48: sf.xrpl.type.v1.CredentialAccept
-	(*CredentialDelete)(nil),         // 49: sf.xrpl.type.v1.CredentialDelete
-	(*PermissionedDomainSet)(nil),    // 50: sf.xrpl.type.v1.PermissionedDomainSet
-	(*PermissionedDomainDelete)(nil), // 51: sf.xrpl.type.v1.PermissionedDomainDelete
-	(*DelegateSet)(nil),              // 52: sf.xrpl.type.v1.DelegateSet
-	(*Batch)(nil),                    // 53: sf.xrpl.type.v1.Batch
-	(*EnableAmendment)(nil),          // 54: sf.xrpl.type.v1.EnableAmendment
-	(*SetFee)(nil),                   // 55: sf.xrpl.type.v1.SetFee
-	(*UNLModify)(nil),                // 56: sf.xrpl.type.v1.UNLModify
-	(*LedgerStateFix)(nil),           // 57: sf.xrpl.type.v1.LedgerStateFix
+	(*structpb.Struct)(nil),          // 6: google.protobuf.Struct
+	(*StateChange)(nil),              // 7: sf.xrpl.type.v1.StateChange
+	(TransactionType)(0),             // 8: sf.xrpl.type.v1.TransactionType
+	(*NFTokenTransfer)(nil),          // 9: sf.xrpl.type.v1.NFTokenTransfer
+	(*NFTokenOfferChange)(nil),       // 10: sf.xrpl.type.v1.NFTokenOfferChange
+	(*Payment)(nil),                  // 11: sf.xrpl.type.v1.Payment
+	(*OfferCreate)(nil),              // 12: sf.xrpl.type.v1.OfferCreate
+	(*OfferCancel)(nil),              // 13: sf.xrpl.type.v1.OfferCancel
+	(*TrustSet)(nil),                 // 14: sf.xrpl.type.v1.TrustSet
+	(*AccountSet)(nil),               // 15: sf.xrpl.type.v1.AccountSet
+	(*AccountDelete)(nil),            // 16: sf.xrpl.type.v1.AccountDelete
+	(*SetRegularKey)(nil),            // 17: sf.xrpl.type.v1.SetRegularKey
+	(*SignerListSet)(nil),            // 18: sf.xrpl.type.v1.SignerListSet
+	(*EscrowCreate)(nil),             // 19: sf.xrpl.type.v1.EscrowCreate
+	(*EscrowFinish)(nil),             // 20: sf.xrpl.type.v1.EscrowFinish
+	(*EscrowCancel)(nil),             // 21: sf.xrpl.type.v1.EscrowCancel
+	(*PaymentChannelCreate)(nil),     // 22: sf.xrpl.type.v1.PaymentChannelCreate
+	(*PaymentChannelFund)(nil),       // 23: sf.xrpl.type.v1.PaymentChannelFund
+	(*PaymentChannelClaim)(nil),      // 24: sf.xrpl.type.v1.PaymentChannelClaim
+	(*CheckCreate)(nil),              // 25: sf.xrpl.type.v1.CheckCreate
+	(*CheckCash)(nil),                // 26: sf.xrpl.type.v1.CheckCash
+	(*CheckCancel)(nil),              // 27: sf.xrpl.type.v1.CheckCancel
+	(*DepositPreauth)(nil),           // 28: sf.xrpl.type.v1.DepositPreauth
+	(*TicketCreate)(nil),             // 29: sf.xrpl.type.v1.TicketCreate
+	(*NFTokenMint)(nil),              // 30: sf.xrpl.type.v1.NFTokenMint
+	(*NFTokenBurn)(nil),              // 31: sf.xrpl.type.v1.NFTokenBurn
+	(*NFTokenCreateOffer)(nil),       // 32: sf.xrpl.type.v1.NFTokenCreateOffer
+	(*NFTokenCancelOffer)(nil),       // 33: sf.xrpl.type.v1.NFTokenCancelOffer
+	(*NFTokenAcceptOffer)(nil),       // 34: sf.xrpl.type.v1.NFTokenAcceptOffer
+	(*NFTokenModify)(nil),            // 35: sf.xrpl.type.v1.NFTokenModify
+	(*Clawback)(nil),                 // 36: sf.xrpl.type.v1.Clawback
+	(*AMMCreate)(nil),                // 37: sf.xrpl.type.v1.AMMCreate
+	(*AMMDeposit)(nil),               // 38: sf.xrpl.type.v1.AMMDeposit
+	(*AMMWithdraw)(nil),              // 39: sf.xrpl.type.v1.AMMWithdraw
+	(*AMMVote)(nil),                  // 40: sf.xrpl.type.v1.AMMVote
+	(*AMMBid)(nil),                   // 41: sf.xrpl.type.v1.AMMBid
+	(*AMMDelete)(nil),                // 42: sf.xrpl.type.v1.AMMDelete
+	(*AMMClawback)(nil),              // 43: sf.xrpl.type.v1.AMMClawback
+	(*DIDSet)(nil),                   // 44: sf.xrpl.type.v1.DIDSet
+	(*DIDDelete)(nil),                // 45: sf.xrpl.type.v1.DIDDelete
+	(*OracleSet)(nil),                // 46: sf.xrpl.type.v1.OracleSet
+	(*OracleDelete)(nil),             // 47: sf.xrpl.type.v1.OracleDelete
+	(*MPTokenIssuanceCreate)(nil),    // 48: sf.xrpl.type.v1.MPTokenIssuanceCreate
+	(*MPTokenIssuanceDestroy)(nil),   // 49: sf.xrpl.type.v1.MPTokenIssuanceDestroy
+	(*MPTokenIssuanceSet)(nil),       // 50: sf.xrpl.type.v1.MPTokenIssuanceSet
+	(*MPTokenAuthorize)(nil),         // 51: sf.xrpl.type.v1.MPTokenAuthorize
+	(*CredentialCreate)(nil),         // 52: sf.xrpl.type.v1.CredentialCreate
+	(*CredentialAccept)(nil),         // 53: sf.xrpl.type.v1.CredentialAccept
+	(*CredentialDelete)(nil),         // 54: sf.xrpl.type.v1.CredentialDelete
+	(*PermissionedDomainSet)(nil),    // 55: sf.xrpl.type.v1.PermissionedDomainSet
+	(*PermissionedDomainDelete)(nil), // 56: sf.xrpl.type.v1.PermissionedDomainDelete
+	(*DelegateSet)(nil),              // 57: sf.xrpl.type.v1.DelegateSet
+	(*Batch)(nil),                    // 58: sf.xrpl.type.v1.Batch
+	(*EnableAmendment)(nil),          // 59: sf.xrpl.type.v1.EnableAmendment
+	(*SetFee)(nil),                   // 60: sf.xrpl.type.v1.SetFee
+	(*UNLModify)(nil),                // 61: sf.xrpl.type.v1.UNLModify
+	(*LedgerStateFix)(nil),           // 62: sf.xrpl.type.v1.LedgerStateFix
 }
 var file_sf_xrpl_type_v1_block_proto_depIdxs = []int32{
 	1,  // 0: sf.xrpl.type.v1.Block.header:type_name -> sf.xrpl.type.v1.Header
@@ -1557,63 +1806,71 @@ var file_sf_xrpl_type_v1_block_proto_depIdxs = []int32{
 	4,  // 2: sf.xrpl.type.v1.Block.close_time:type_name -> google.protobuf.Timestamp
 	3,  // 3: sf.xrpl.type.v1.Transaction.memos:type_name -> sf.xrpl.type.v1.Memo
 	5,  // 4: sf.xrpl.type.v1.Transaction.signers:type_name -> sf.xrpl.type.v1.Signer
-	6,  // 5: sf.xrpl.type.v1.Transaction.payment:type_name -> sf.xrpl.type.v1.Payment
-	7,  // 6: sf.xrpl.type.v1.Transaction.offer_create:type_name -> sf.xrpl.type.v1.OfferCreate
-	8,  // 7: sf.xrpl.type.v1.Transaction.offer_cancel:type_name -> sf.xrpl.type.v1.OfferCancel
-	9,  // 8: sf.xrpl.type.v1.Transaction.trust_set:type_name -> sf.xrpl.type.v1.TrustSet
-	10, // 9: sf.xrpl.type.v1.Transaction.account_set:type_name -> sf.xrpl.type.v1.AccountSet
-	11, // 10: sf.xrpl.type.v1.Transaction.account_delete:type_name -> sf.xrpl.type.v1.AccountDelete
-	12, // 11: sf.xrpl.type.v1.Transaction.set_regular_key:type_name -> sf.xrpl.type.v1.SetRegularKey
-	13, // 12: sf.xrpl.type.v1.Transaction.signer_list_set:type_name -> sf.xrpl.type.v1.SignerListSet
-	14, // 13: sf.xrpl.type.v1.Transaction.escrow_create:type_name -> sf.xrpl.type.v1.EscrowCreate
-	15, // 14: sf.xrpl.type.v1.Transaction.escrow_finish:type_name -> sf.xrpl.type.v1.EscrowFinish
-	16, // 15: sf.xrpl.type.v1.Transaction.escrow_cancel:type_name -> sf.xrpl.type.v1.EscrowCancel
-	17, // 16: sf.xrpl.type.v1.Transaction.payment_channel_create:type_name -> sf.xrpl.type.v1.PaymentChannelCreate
-	18, // 17: sf.xrpl.type.v1.Transaction.payment_channel_fund:type_name -> sf.xrpl.type.v1.PaymentChannelFund
-	19, // 18: sf.xrpl.type.v1.Transaction.payment_channel_claim:type_name -> sf.xrpl.type.v1.PaymentChannelClaim
-	20, // 19: sf.xrpl.type.v1.Transaction.check_create:type_name -> sf.xrpl.type.v1.CheckCreate
-	21, // 20: sf.xrpl.type.v1.Transaction.check_cash:type_name -> sf.xrpl.type.v1.CheckCash
-	22, // 21: sf.xrpl.type.v1.Transaction.check_cancel:type_name -> sf.xrpl.type.v1.CheckCancel
-	23, // 22: sf.xrpl.type.v1.Transaction.deposit_preauth:type_name -> sf.xrpl.type.v1.DepositPreauth
-	24, // 23: sf.xrpl.type.v1.Transaction.ticket_create:type_name -> sf.xrpl.type.v1.TicketCreate
-	25, // 24: sf.xrpl.type.v1.Transaction.nftoken_mint:type_name -> sf.xrpl.type.v1.NFTokenMint
-	26, // 25: sf.xrpl.type.v1.Transaction.nftoken_burn:type_name -> sf.xrpl.type.v1.NFTokenBurn
-	27, // 26: sf.xrpl.type.v1.Transaction.nftoken_create_offer:type_name -> sf.xrpl.type.v1.NFTokenCreateOffer
-	28, // 27: sf.xrpl.type.v1.Transaction.nftoken_cancel_offer:type_name -> sf.xrpl.type.v1.NFTokenCancelOffer
-	29, // 28: sf.xrpl.type.v1.Transaction.nftoken_accept_offer:type_name -> sf.xrpl.type.v1.NFTokenAcceptOffer
-	30, // 29: sf.xrpl.type.v1.Transaction.nftoken_modify:type_name -> sf.xrpl.type.v1.NFTokenModify
-	31, // 30: sf.xrpl.type.v1.Transaction.clawback:type_name -> sf.xrpl.type.v1.Clawback
-	32, // 31: sf.xrpl.type.v1.Transaction.amm_create:type_name -> sf.xrpl.type.v1.AMMCreate
-	33, // 32: sf.xrpl.type.v1.Transaction.amm_deposit:type_name -> sf.xrpl.type.v1.AMMDeposit
-	34, // 33: sf.xrpl.type.v1.Transaction.amm_withdraw:type_name -> sf.xrpl.type.v1.AMMWithdraw
-	35, // 34: sf.xrpl.type.v1.Transaction.amm_vote:type_name -> sf.xrpl.type.v1.AMMVote
-	36, // 35: sf.xrpl.type.v1.Transaction.amm_bid:type_name -> sf.xrpl.type.v1.AMMBid
-	37, // 36: sf.xrpl.type.v1.Transaction.amm_delete:type_name -> sf.xrpl.type.v1.AMMDelete
-	38, // 37: sf.xrpl.type.v1.Transaction.amm_clawback:type_name -> sf.xrpl.type.v1.AMMClawback
-	39, // 38: sf.xrpl.type.v1.Transaction.did_set:type_name -> sf.xrpl.type.v1.DIDSet
-	40, // 39: sf.xrpl.type.v1.Transaction.did_delete:type_name -> sf.xrpl.type.v1.DIDDelete
-	41, // 40: sf.xrpl.type.v1.Transaction.oracle_set:type_name -> sf.xrpl.type.v1.OracleSet
-	42, // 41: sf.xrpl.type.v1.Transaction.oracle_delete:type_name -> sf.xrpl.type.v1.OracleDelete
-	43, // 42: sf.xrpl.type.v1.Transaction.mptoken_issuance_create:type_name -> sf.xrpl.type.v1.MPTokenIssuanceCreate
-	44, // 43: sf.xrpl.type.v1.Transaction.mptoken_issuance_destroy:type_name -> sf.xrpl.type.v1.MPTokenIssuanceDestroy
-	45, // 44: sf.xrpl.type.v1.Transaction.mptoken_issuance_set:type_name -> sf.xrpl.type.v1.MPTokenIssuanceSet
-	46, // 45: sf.xrpl.type.v1.Transaction.mptoken_authorize:type_name -> sf.xrpl.type.v1.MPTokenAuthorize
-	47, // 46: sf.xrpl.type.v1.Transaction.credential_create:type_name -> sf.xrpl.type.v1.CredentialCreate
-	48, // 47: sf.xrpl.type.v1.Transaction.credential_accept:type_name -> sf.xrpl.type.v1.CredentialAccept
-	49, // 48: sf.xrpl.type.v1.Transaction.credential_delete:type_name -> sf.xrpl.type.v1.CredentialDelete
-	50, // 49: sf.xrpl.type.v1.Transaction.permissioned_domain_set:type_name -> sf.xrpl.type.v1.PermissionedDomainSet
-	51, // 50: sf.xrpl.type.v1.Transaction.permissioned_domain_delete:type_name -> sf.xrpl.type.v1.PermissionedDomainDelete
-	52, // 51: sf.xrpl.type.v1.Transaction.delegate_set:type_name -> sf.xrpl.type.v1.DelegateSet
-	53, // 52: sf.xrpl.type.v1.Transaction.batch:type_name -> sf.xrpl.type.v1.Batch
-	54, // 53: sf.xrpl.type.v1.Transaction.enable_amendment:type_name -> sf.xrpl.type.v1.EnableAmendment
-	55, // 54: sf.xrpl.type.v1.Transaction.set_fee:type_name -> sf.xrpl.type.v1.SetFee
-	56, // 55: sf.xrpl.type.v1.Transaction.unl_modify:type_name -> sf.xrpl.type.v1.UNLModify
-	57, // 56: sf.xrpl.type.v1.Transaction.ledger_state_fix:type_name -> sf.xrpl.type.v1.LedgerStateFix
-	57, // [57:57] is the sub-list for method output_type
-	57, // [57:57] is the sub-list for method input_type
-	57, // [57:57] is the sub-list for extension type_name
-	57, // [57:57] is the sub-list for extension extendee
-	0,  // [0:57] is the sub-list for field type_name
+	6,  // 5: sf.xrpl.type.v1.Transaction.decoded_meta:type_name -> google.protobuf.Struct
+	7,  // 6: sf.xrpl.type.v1.Transaction.state_changes:type_name -> sf.xrpl.type.v1.StateChange
+	8,  // 7: sf.xrpl.type.v1.Transaction.transaction_type:type_name -> sf.xrpl.type.v1.TransactionType
+	2,  // 8: sf.xrpl.type.v1.Transaction.inner_transactions:type_name -> sf.xrpl.type.v1.Transaction
+	4,  // 9: sf.xrpl.type.v1.Transaction.close_time:type_name -> google.protobuf.Timestamp
+	9,  // 10: sf.xrpl.type.v1.Transaction.nftoken_transfers:type_name -> sf.xrpl.type.v1.NFTokenTransfer
+	10, // 11: sf.xrpl.type.v1.Transaction.nftoken_offer_changes:type_name -> sf.xrpl.type.v1.NFTokenOfferChange
+	11, // 12: sf.xrpl.type.v1.Transaction.payment:type_name -> sf.xrpl.type.v1.Payment
+	12, // 13: sf.xrpl.type.v1.Transaction.offer_create:type_name -> sf.xrpl.type.v1.OfferCreate
+	13, // 14: sf.xrpl.type.v1.Transaction.offer_cancel:type_name -> sf.xrpl.type.v1.OfferCancel
+	14, // 15: sf.xrpl.type.v1.Transaction.trust_set:type_name -> sf.xrpl.type.v1.TrustSet
+	15, // 16: sf.xrpl.type.v1.Transaction.account_set:type_name -> sf.xrpl.type.v1.AccountSet
+	16, // 17: sf.xrpl.type.v1.Transaction.account_delete:type_name -> sf.xrpl.type.v1.AccountDelete
+	17, // 18: sf.xrpl.type.v1.Transaction.set_regular_key:type_name -> sf.xrpl.type.v1.SetRegularKey
+	18, // 19: sf.xrpl.type.v1.Transaction.signer_list_set:type_name -> sf.xrpl.type.v1.SignerListSet
+	19, // 20: sf.xrpl.type.v1.Transaction.escrow_create:type_name -> sf.xrpl.type.v1.EscrowCreate
+	20, // 21: sf.xrpl.type.v1.Transaction.escrow_finish:type_name -> sf.xrpl.type.v1.EscrowFinish
+	21, // 22: sf.xrpl.type.v1.Transaction.escrow_cancel:type_name -> sf.xrpl.type.v1.EscrowCancel
+	22, // 23: sf.xrpl.type.v1.Transaction.payment_channel_create:type_name -> sf.xrpl.type.v1.PaymentChannelCreate
+	23, // 24: sf.xrpl.type.v1.Transaction.payment_channel_fund:type_name -> sf.xrpl.type.v1.PaymentChannelFund
+	24, // 25: sf.xrpl.type.v1.Transaction.payment_channel_claim:type_name -> sf.xrpl.type.v1.PaymentChannelClaim
+	25, // 26: sf.xrpl.type.v1.Transaction.check_create:type_name -> sf.xrpl.type.v1.CheckCreate
+	26, // 27: sf.xrpl.type.v1.Transaction.check_cash:type_name -> sf.xrpl.type.v1.CheckCash
+	27, // 28: sf.xrpl.type.v1.Transaction.check_cancel:type_name -> sf.xrpl.type.v1.CheckCancel
+	28, // 29: sf.xrpl.type.v1.Transaction.deposit_preauth:type_name -> sf.xrpl.type.v1.DepositPreauth
+	29, // 30: sf.xrpl.type.v1.Transaction.ticket_create:type_name -> sf.xrpl.type.v1.TicketCreate
+	30, // 31: sf.xrpl.type.v1.Transaction.nftoken_mint:type_name -> sf.xrpl.type.v1.NFTokenMint
+	31, // 32: sf.xrpl.type.v1.Transaction.nftoken_burn:type_name -> sf.xrpl.type.v1.NFTokenBurn
+	32, // 33: sf.xrpl.type.v1.Transaction.nftoken_create_offer:type_name -> sf.xrpl.type.v1.NFTokenCreateOffer
+	33, // 34: sf.xrpl.type.v1.Transaction.nftoken_cancel_offer:type_name -> sf.xrpl.type.v1.NFTokenCancelOffer
+	34, // 35: sf.xrpl.type.v1.Transaction.nftoken_accept_offer:type_name -> sf.xrpl.type.v1.NFTokenAcceptOffer
+	35, // 36: sf.xrpl.type.v1.Transaction.nftoken_modify:type_name -> sf.xrpl.type.v1.NFTokenModify
+	36, // 37: sf.xrpl.type.v1.Transaction.clawback:type_name -> sf.xrpl.type.v1.Clawback
+	37, // 38: sf.xrpl.type.v1.Transaction.amm_create:type_name -> sf.xrpl.type.v1.AMMCreate
+	38, // 39: sf.xrpl.type.v1.Transaction.amm_deposit:type_name -> sf.xrpl.type.v1.AMMDeposit
+	39, // 40: sf.xrpl.type.v1.Transaction.amm_withdraw:type_name -> sf.xrpl.type.v1.AMMWithdraw
+	40, // 41: sf.xrpl.type.v1.Transaction.amm_vote:type_name -> sf.xrpl.type.v1.AMMVote
+	41, // 42: sf.xrpl.type.v1.Transaction.amm_bid:type_name -> sf.xrpl.type.v1.AMMBid
+	42, // 43: sf.xrpl.type.v1.Transaction.amm_delete:type_name -> sf.xrpl.type.v1.AMMDelete
+	43, // 44: sf.xrpl.type.v1.Transaction.amm_clawback:type_name -> sf.xrpl.type.v1.AMMClawback
+	44, // 45: sf.xrpl.type.v1.Transaction.did_set:type_name -> sf.xrpl.type.v1.DIDSet
+	45, // 46: sf.xrpl.type.v1.Transaction.did_delete:type_name -> sf.xrpl.type.v1.DIDDelete
+	46, // 47: sf.xrpl.type.v1.Transaction.oracle_set:type_name -> sf.xrpl.type.v1.OracleSet
+	47, // 48: sf.xrpl.type.v1.Transaction.oracle_delete:type_name -> sf.xrpl.type.v1.OracleDelete
+	48, // 49: sf.xrpl.type.v1.Transaction.mptoken_issuance_create:type_name -> sf.xrpl.type.v1.MPTokenIssuanceCreate
+	49, // 50: sf.xrpl.type.v1.Transaction.mptoken_issuance_destroy:type_name -> sf.xrpl.type.v1.MPTokenIssuanceDestroy
+	50, // 51: sf.xrpl.type.v1.Transaction.mptoken_issuance_set:type_name -> sf.xrpl.type.v1.MPTokenIssuanceSet
+	51, // 52: sf.xrpl.type.v1.Transaction.mptoken_authorize:type_name -> sf.xrpl.type.v1.MPTokenAuthorize
+	52, // 53: sf.xrpl.type.v1.Transaction.credential_create:type_name -> sf.xrpl.type.v1.CredentialCreate
+	53, // 54: sf.xrpl.type.v1.Transaction.credential_accept:type_name -> sf.xrpl.type.v1.CredentialAccept
+	54, // 55: sf.xrpl.type.v1.Transaction.credential_delete:type_name -> sf.xrpl.type.v1.CredentialDelete
+	55, // 56: sf.xrpl.type.v1.Transaction.permissioned_domain_set:type_name -> sf.xrpl.type.v1.PermissionedDomainSet
+	56, // 57: sf.xrpl.type.v1.Transaction.permissioned_domain_delete:type_name -> sf.xrpl.type.v1.PermissionedDomainDelete
+	57, // 58: sf.xrpl.type.v1.Transaction.delegate_set:type_name -> sf.xrpl.type.v1.DelegateSet
+	58, // 59: sf.xrpl.type.v1.Transaction.batch:type_name -> sf.xrpl.type.v1.Batch
+	59, // 60: sf.xrpl.type.v1.Transaction.enable_amendment:type_name -> sf.xrpl.type.v1.EnableAmendment
+	60, // 61: sf.xrpl.type.v1.Transaction.set_fee:type_name -> sf.xrpl.type.v1.SetFee
+	61, // 62: sf.xrpl.type.v1.Transaction.unl_modify:type_name -> sf.xrpl.type.v1.UNLModify
+	62, // 63: sf.xrpl.type.v1.Transaction.ledger_state_fix:type_name -> sf.xrpl.type.v1.LedgerStateFix
+	6,  // 64: sf.xrpl.type.v1.Transaction.unknown_details:type_name -> google.protobuf.Struct
+	65, // [65:65] is the sub-list for method output_type
+	65, // [65:65] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_block_proto_init() }
@@ -1622,6 +1879,8 @@ func file_sf_xrpl_type_v1_block_proto_init() {
 		return
 	}
 	file_sf_xrpl_type_v1_signer_proto_init()
+	file_sf_xrpl_type_v1_state_change_proto_init()
+	file_sf_xrpl_type_v1_transaction_type_proto_init()
 	file_sf_xrpl_type_v1_payment_proto_init()
 	file_sf_xrpl_type_v1_offer_proto_init()
 	file_sf_xrpl_type_v1_trustline_proto_init()
@@ -1695,6 +1954,7 @@ func file_sf_xrpl_type_v1_block_proto_init() {
 		(*Transaction_SetFee)(nil),
 		(*Transaction_UnlModify)(nil),
 		(*Transaction_LedgerStateFix)(nil),
+		(*Transaction_UnknownDetails)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
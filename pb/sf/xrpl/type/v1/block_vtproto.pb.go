@@ -7,9 +7,11 @@ package pbxrpl
 import (
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	structpb1 "github.com/planetscale/vtprotobuf/types/known/structpb"
 	timestamppb1 "github.com/planetscale/vtprotobuf/types/known/timestamppb"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	unsafe "unsafe"
@@ -62,6 +64,12 @@ func (m *Header) CloneVT() *Header {
 	r.TotalDrops = m.TotalDrops
 	r.CloseTimeResolution = m.CloseTimeResolution
 	r.CloseFlags = m.CloseFlags
+	r.BaseFee = m.BaseFee
+	r.ReserveBase = m.ReserveBase
+	r.ReserveIncrement = m.ReserveIncrement
+	r.TransactionCount = m.TransactionCount
+	r.Filtered = m.Filtered
+	r.SkippedTransactionCount = m.SkippedTransactionCount
 	if rhs := m.ParentHash; rhs != nil {
 		tmpBytes := make([]byte, len(rhs))
 		copy(tmpBytes, rhs)
@@ -108,6 +116,14 @@ func (m *Transaction) CloneVT() *Transaction {
 	r.SigningPubKey = m.SigningPubKey
 	r.TicketSequence = m.TicketSequence
 	r.TxnSignature = m.TxnSignature
+	r.IsMultisigned = m.IsMultisigned
+	r.LedgersUntilExpiry = m.LedgersUntilExpiry
+	r.CodecOutdated = m.CodecOutdated
+	r.DecodedMeta = (*structpb.Struct)((*structpb1.Struct)(m.DecodedMeta).CloneVT())
+	r.TransactionType = m.TransactionType
+	r.Applied = m.Applied
+	r.LedgerIndex = m.LedgerIndex
+	r.CloseTime = (*timestamppb.Timestamp)((*timestamppb1.Timestamp)(m.CloseTime).CloneVT())
 	if rhs := m.Hash; rhs != nil {
 		tmpBytes := make([]byte, len(rhs))
 		copy(tmpBytes, rhs)
@@ -137,6 +153,39 @@ func (m *Transaction) CloneVT() *Transaction {
 		}
 		r.Signers = tmpContainer
 	}
+	if rhs := m.SetFlags; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.SetFlags = tmpContainer
+	}
+	if rhs := m.StateChanges; rhs != nil {
+		tmpContainer := make([]*StateChange, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.StateChanges = tmpContainer
+	}
+	if rhs := m.InnerTransactions; rhs != nil {
+		tmpContainer := make([]*Transaction, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.InnerTransactions = tmpContainer
+	}
+	if rhs := m.NftokenTransfers; rhs != nil {
+		tmpContainer := make([]*NFTokenTransfer, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.NftokenTransfers = tmpContainer
+	}
+	if rhs := m.NftokenOfferChanges; rhs != nil {
+		tmpContainer := make([]*NFTokenOfferChange, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.NftokenOfferChanges = tmpContainer
+	}
 	if m.TxDetails != nil {
 		r.TxDetails = m.TxDetails.(interface {
 			CloneVT() isTransaction_TxDetails
@@ -621,6 +670,15 @@ func (m *Transaction_LedgerStateFix) CloneVT() isTransaction_TxDetails {
 	return r
 }
 
+func (m *Transaction_UnknownDetails) CloneVT() isTransaction_TxDetails {
+	if m == nil {
+		return (*Transaction_UnknownDetails)(nil)
+	}
+	r := new(Transaction_UnknownDetails)
+	r.UnknownDetails = (*structpb.Struct)((*structpb1.Struct)(m.UnknownDetails).CloneVT())
+	return r
+}
+
 func (m *Memo) CloneVT() *Memo {
 	if m == nil {
 		return (*Memo)(nil)
@@ -629,6 +687,7 @@ func (m *Memo) CloneVT() *Memo {
 	r.MemoData = m.MemoData
 	r.MemoFormat = m.MemoFormat
 	r.MemoType = m.MemoType
+	r.MemoText = m.MemoText
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -712,6 +771,24 @@ func (this *Header) EqualVT(that *Header) bool {
 	if this.CloseFlags != that.CloseFlags {
 		return false
 	}
+	if this.BaseFee != that.BaseFee {
+		return false
+	}
+	if this.ReserveBase != that.ReserveBase {
+		return false
+	}
+	if this.ReserveIncrement != that.ReserveIncrement {
+		return false
+	}
+	if this.TransactionCount != that.TransactionCount {
+		return false
+	}
+	if this.Filtered != that.Filtered {
+		return false
+	}
+	if this.SkippedTransactionCount != that.SkippedTransactionCount {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -828,6 +905,107 @@ func (this *Transaction) EqualVT(that *Transaction) bool {
 	if this.TxnSignature != that.TxnSignature {
 		return false
 	}
+	if this.IsMultisigned != that.IsMultisigned {
+		return false
+	}
+	if this.LedgersUntilExpiry != that.LedgersUntilExpiry {
+		return false
+	}
+	if this.CodecOutdated != that.CodecOutdated {
+		return false
+	}
+	if !(*structpb1.Struct)(this.DecodedMeta).EqualVT((*structpb1.Struct)(that.DecodedMeta)) {
+		return false
+	}
+	if len(this.SetFlags) != len(that.SetFlags) {
+		return false
+	}
+	for i, vx := range this.SetFlags {
+		vy := that.SetFlags[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.StateChanges) != len(that.StateChanges) {
+		return false
+	}
+	for i, vx := range this.StateChanges {
+		vy := that.StateChanges[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &StateChange{}
+			}
+			if q == nil {
+				q = &StateChange{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if this.TransactionType != that.TransactionType {
+		return false
+	}
+	if len(this.InnerTransactions) != len(that.InnerTransactions) {
+		return false
+	}
+	for i, vx := range this.InnerTransactions {
+		vy := that.InnerTransactions[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Transaction{}
+			}
+			if q == nil {
+				q = &Transaction{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if this.Applied != that.Applied {
+		return false
+	}
+	if this.LedgerIndex != that.LedgerIndex {
+		return false
+	}
+	if !(*timestamppb1.Timestamp)(this.CloseTime).EqualVT((*timestamppb1.Timestamp)(that.CloseTime)) {
+		return false
+	}
+	if len(this.NftokenTransfers) != len(that.NftokenTransfers) {
+		return false
+	}
+	for i, vx := range this.NftokenTransfers {
+		vy := that.NftokenTransfers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &NFTokenTransfer{}
+			}
+			if q == nil {
+				q = &NFTokenTransfer{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.NftokenOfferChanges) != len(that.NftokenOfferChanges) {
+		return false
+	}
+	for i, vx := range this.NftokenOfferChanges {
+		vy := that.NftokenOfferChanges[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &NFTokenOfferChange{}
+			}
+			if q == nil {
+				q = &NFTokenOfferChange{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -2138,6 +2316,31 @@ func (this *Transaction_LedgerStateFix) EqualVT(thatIface isTransaction_TxDetail
 	return true
 }
 
+func (this *Transaction_UnknownDetails) EqualVT(thatIface isTransaction_TxDetails) bool {
+	that, ok := thatIface.(*Transaction_UnknownDetails)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.UnknownDetails, that.UnknownDetails; p != q {
+		if p == nil {
+			p = &structpb.Struct{}
+		}
+		if q == nil {
+			q = &structpb.Struct{}
+		}
+		if !(*structpb1.Struct)(p).EqualVT((*structpb1.Struct)(q)) {
+			return false
+		}
+	}
+	return true
+}
+
 func (this *Memo) EqualVT(that *Memo) bool {
 	if this == that {
 		return true
@@ -2153,6 +2356,9 @@ func (this *Memo) EqualVT(that *Memo) bool {
 	if this.MemoType != that.MemoType {
 		return false
 	}
+	if this.MemoText != that.MemoText {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -2275,6 +2481,41 @@ func (m *Header) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.SkippedTransactionCount != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SkippedTransactionCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Filtered {
+		i--
+		if m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TransactionCount != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TransactionCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReserveIncrement != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ReserveIncrement))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReserveBase != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ReserveBase))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BaseFee != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.BaseFee))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.CloseFlags != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.CloseFlags))
 		i--
@@ -2353,6 +2594,154 @@ func (m *Transaction) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 		i -= size
 	}
+	if len(m.NftokenOfferChanges) > 0 {
+		for iNdEx := len(m.NftokenOfferChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.NftokenOfferChanges[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.NftokenTransfers) > 0 {
+		for iNdEx := len(m.NftokenTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.NftokenTransfers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.CloseTime != nil {
+		size, err := (*timestamppb1.Timestamp)(m.CloseTime).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.LedgerIndex != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.LedgerIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.InnerTransactions) > 0 {
+		for iNdEx := len(m.InnerTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.InnerTransactions[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.TransactionType != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TransactionType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.StateChanges[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.SetFlags) > 0 {
+		for iNdEx := len(m.SetFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SetFlags[iNdEx])
+			copy(dAtA[i:], m.SetFlags[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.SetFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.DecodedMeta != nil {
+		size, err := (*structpb1.Struct)(m.DecodedMeta).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.CodecOutdated {
+		i--
+		if m.CodecOutdated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.LedgersUntilExpiry != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.LedgersUntilExpiry))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.IsMultisigned {
+		i--
+		if m.IsMultisigned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.TxnSignature) > 0 {
 		i -= len(m.TxnSignature)
 		copy(dAtA[i:], m.TxnSignature)
@@ -3592,20 +3981,41 @@ func (m *Transaction_LedgerStateFix) MarshalToSizedBufferVT(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Memo) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Memo) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Transaction_UnknownDetails) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Transaction_UnknownDetails) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnknownDetails != nil {
+		size, err := (*structpb1.Struct)(m.UnknownDetails).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Memo) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Memo) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
@@ -3622,6 +4032,13 @@ func (m *Memo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.MemoText) > 0 {
+		i -= len(m.MemoText)
+		copy(dAtA[i:], m.MemoText)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.MemoText)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.MemoType) > 0 {
 		i -= len(m.MemoType)
 		copy(dAtA[i:], m.MemoType)
@@ -3758,6 +4175,41 @@ func (m *Header) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.SkippedTransactionCount != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SkippedTransactionCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Filtered {
+		i--
+		if m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TransactionCount != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TransactionCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReserveIncrement != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ReserveIncrement))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReserveBase != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ReserveBase))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BaseFee != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.BaseFee))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.CloseFlags != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.CloseFlags))
 		i--
@@ -3827,6 +4279,13 @@ func (m *Transaction) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if msg, ok := m.TxDetails.(*Transaction_UnknownDetails); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
 	if msg, ok := m.TxDetails.(*Transaction_LedgerStateFix); ok {
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
@@ -4184,6 +4643,53 @@ func (m *Transaction) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		}
 		i -= size
 	}
+	if len(m.NftokenOfferChanges) > 0 {
+		for iNdEx := len(m.NftokenOfferChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.NftokenOfferChanges[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.NftokenTransfers) > 0 {
+		for iNdEx := len(m.NftokenTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.NftokenTransfers[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.CloseTime != nil {
+		size, err := (*timestamppb1.Timestamp)(m.CloseTime).MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.LedgerIndex != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.LedgerIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
 	if msg, ok := m.TxDetails.(*Transaction_Payment); ok {
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
@@ -4191,6 +4697,107 @@ func (m *Transaction) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		}
 		i -= size
 	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.InnerTransactions) > 0 {
+		for iNdEx := len(m.InnerTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.InnerTransactions[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.TransactionType != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TransactionType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.StateChanges[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.SetFlags) > 0 {
+		for iNdEx := len(m.SetFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SetFlags[iNdEx])
+			copy(dAtA[i:], m.SetFlags[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.SetFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.DecodedMeta != nil {
+		size, err := (*structpb1.Struct)(m.DecodedMeta).MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.CodecOutdated {
+		i--
+		if m.CodecOutdated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.LedgersUntilExpiry != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.LedgersUntilExpiry))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.IsMultisigned {
+		i--
+		if m.IsMultisigned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.TxnSignature) > 0 {
 		i -= len(m.TxnSignature)
 		copy(dAtA[i:], m.TxnSignature)
@@ -5430,6 +6037,27 @@ func (m *Transaction_LedgerStateFix) MarshalToSizedBufferVTStrict(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Transaction_UnknownDetails) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Transaction_UnknownDetails) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnknownDetails != nil {
+		size, err := (*structpb1.Struct)(m.UnknownDetails).MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Memo) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -5460,6 +6088,13 @@ func (m *Memo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.MemoText) > 0 {
+		i -= len(m.MemoText)
+		copy(dAtA[i:], m.MemoText)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.MemoText)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.MemoType) > 0 {
 		i -= len(m.MemoType)
 		copy(dAtA[i:], m.MemoType)
@@ -5545,6 +6180,24 @@ func (m *Header) SizeVT() (n int) {
 	if m.CloseFlags != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.CloseFlags))
 	}
+	if m.BaseFee != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.BaseFee))
+	}
+	if m.ReserveBase != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.ReserveBase))
+	}
+	if m.ReserveIncrement != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.ReserveIncrement))
+	}
+	if m.TransactionCount != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.TransactionCount))
+	}
+	if m.Filtered {
+		n += 2
+	}
+	if m.SkippedTransactionCount != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.SkippedTransactionCount))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -5631,9 +6284,65 @@ func (m *Transaction) SizeVT() (n int) {
 	if l > 0 {
 		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.IsMultisigned {
+		n += 3
+	}
+	if m.LedgersUntilExpiry != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.LedgersUntilExpiry))
+	}
+	if m.CodecOutdated {
+		n += 3
+	}
+	if m.DecodedMeta != nil {
+		l = (*structpb1.Struct)(m.DecodedMeta).SizeVT()
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if len(m.SetFlags) > 0 {
+		for _, s := range m.SetFlags {
+			l = len(s)
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.SizeVT()
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if m.TransactionType != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.TransactionType))
+	}
+	if len(m.InnerTransactions) > 0 {
+		for _, e := range m.InnerTransactions {
+			l = e.SizeVT()
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if m.Applied {
+		n += 3
+	}
 	if vtmsg, ok := m.TxDetails.(interface{ SizeVT() int }); ok {
 		n += vtmsg.SizeVT()
 	}
+	if m.LedgerIndex != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.LedgerIndex))
+	}
+	if m.CloseTime != nil {
+		l = (*timestamppb1.Timestamp)(m.CloseTime).SizeVT()
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if len(m.NftokenTransfers) > 0 {
+		for _, e := range m.NftokenTransfers {
+			l = e.SizeVT()
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.NftokenOfferChanges) > 0 {
+		for _, e := range m.NftokenOfferChanges {
+			l = e.SizeVT()
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -6262,6 +6971,18 @@ func (m *Transaction_LedgerStateFix) SizeVT() (n int) {
 	}
 	return n
 }
+func (m *Transaction_UnknownDetails) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnknownDetails != nil {
+		l = (*structpb1.Struct)(m.UnknownDetails).SizeVT()
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	return n
+}
 func (m *Memo) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -6280,6 +7001,10 @@ func (m *Memo) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	l = len(m.MemoText)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -6701,29 +7426,144 @@ func (m *Header) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return protohelpers.ErrInvalidLength
+			m.BaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBase", wireType)
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Transaction) UnmarshalVT(dAtA []byte) error {
+			m.ReserveBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveBase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveIncrement", wireType)
+			}
+			m.ReserveIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveIncrement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCount", wireType)
+			}
+			m.TransactionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filtered = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedTransactionCount", wireType)
+			}
+			m.SkippedTransactionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedTransactionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7298,11 +8138,11 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			}
 			m.TxnSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMultisigned", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -7312,36 +8152,54 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return protohelpers.ErrInvalidLength
+			m.IsMultisigned = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgersUntilExpiry", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return protohelpers.ErrInvalidLength
+			m.LedgersUntilExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgersUntilExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodecOutdated", wireType)
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_Payment); ok {
-				if err := oneof.Payment.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
 				}
-			} else {
-				v := &Payment{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.TxDetails = &Transaction_Payment{Payment: v}
 			}
-			iNdEx = postIndex
-		case 40:
+			m.CodecOutdated = bool(v != 0)
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7368,23 +8226,18 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_OfferCreate); ok {
-				if err := oneof.OfferCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &OfferCreate{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.TxDetails = &Transaction_OfferCreate{OfferCreate: v}
+			if m.DecodedMeta == nil {
+				m.DecodedMeta = &structpb.Struct{}
+			}
+			if err := (*structpb1.Struct)(m.DecodedMeta).UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 41:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCancel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetFlags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -7394,36 +8247,27 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_OfferCancel); ok {
-				if err := oneof.OfferCancel.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &OfferCancel{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.TxDetails = &Transaction_OfferCancel{OfferCancel: v}
-			}
+			m.SetFlags = append(m.SetFlags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 50:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7450,21 +8294,33 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_TrustSet); ok {
-				if err := oneof.TrustSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
+			m.StateChanges = append(m.StateChanges, &StateChange{})
+			if err := m.StateChanges[len(m.StateChanges)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionType", wireType)
+			}
+			m.TransactionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
 				}
-			} else {
-				v := &TrustSet{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionType |= TransactionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.TxDetails = &Transaction_TrustSet{TrustSet: v}
 			}
-			iNdEx = postIndex
-		case 60:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InnerTransactions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7491,21 +8347,34 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AccountSet); ok {
-				if err := oneof.AccountSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
+			m.InnerTransactions = append(m.InnerTransactions, &Transaction{})
+			if err := m.InnerTransactions[len(m.InnerTransactions)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
 				}
-			} else {
-				v := &AccountSet{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.TxDetails = &Transaction_AccountSet{AccountSet: v}
 			}
-			iNdEx = postIndex
-		case 61:
+			m.Applied = bool(v != 0)
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7532,23 +8401,23 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AccountDelete); ok {
-				if err := oneof.AccountDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_Payment); ok {
+				if err := oneof.Payment.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AccountDelete{}
+				v := &Payment{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AccountDelete{AccountDelete: v}
+				m.TxDetails = &Transaction_Payment{Payment: v}
 			}
 			iNdEx = postIndex
-		case 62:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetRegularKey", wireType)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerIndex", wireType)
 			}
-			var msglen int
+			m.LedgerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -7558,13 +8427,32 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LedgerIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return protohelpers.ErrInvalidLength
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -7573,21 +8461,16 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_SetRegularKey); ok {
-				if err := oneof.SetRegularKey.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &SetRegularKey{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.TxDetails = &Transaction_SetRegularKey{SetRegularKey: v}
+			if m.CloseTime == nil {
+				m.CloseTime = &timestamppb.Timestamp{}
+			}
+			if err := (*timestamppb1.Timestamp)(m.CloseTime).UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 63:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerListSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7614,21 +8497,14 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_SignerListSet); ok {
-				if err := oneof.SignerListSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &SignerListSet{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.TxDetails = &Transaction_SignerListSet{SignerListSet: v}
+			m.NftokenTransfers = append(m.NftokenTransfers, &NFTokenTransfer{})
+			if err := m.NftokenTransfers[len(m.NftokenTransfers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 70:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenOfferChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7655,21 +8531,14 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_EscrowCreate); ok {
-				if err := oneof.EscrowCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &EscrowCreate{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.TxDetails = &Transaction_EscrowCreate{EscrowCreate: v}
+			m.NftokenOfferChanges = append(m.NftokenOfferChanges, &NFTokenOfferChange{})
+			if err := m.NftokenOfferChanges[len(m.NftokenOfferChanges)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 71:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowFinish", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7696,21 +8565,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_EscrowFinish); ok {
-				if err := oneof.EscrowFinish.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_OfferCreate); ok {
+				if err := oneof.OfferCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &EscrowFinish{}
+				v := &OfferCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_EscrowFinish{EscrowFinish: v}
+				m.TxDetails = &Transaction_OfferCreate{OfferCreate: v}
 			}
 			iNdEx = postIndex
-		case 72:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCancel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCancel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7737,21 +8606,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_EscrowCancel); ok {
-				if err := oneof.EscrowCancel.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_OfferCancel); ok {
+				if err := oneof.OfferCancel.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &EscrowCancel{}
+				v := &OfferCancel{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_EscrowCancel{EscrowCancel: v}
+				m.TxDetails = &Transaction_OfferCancel{OfferCancel: v}
 			}
 			iNdEx = postIndex
-		case 80:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentChannelCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7778,21 +8647,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_PaymentChannelCreate); ok {
-				if err := oneof.PaymentChannelCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_TrustSet); ok {
+				if err := oneof.TrustSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &PaymentChannelCreate{}
+				v := &TrustSet{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_PaymentChannelCreate{PaymentChannelCreate: v}
+				m.TxDetails = &Transaction_TrustSet{TrustSet: v}
 			}
 			iNdEx = postIndex
-		case 81:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentChannelFund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7819,21 +8688,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_PaymentChannelFund); ok {
-				if err := oneof.PaymentChannelFund.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AccountSet); ok {
+				if err := oneof.AccountSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &PaymentChannelFund{}
+				v := &AccountSet{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_PaymentChannelFund{PaymentChannelFund: v}
+				m.TxDetails = &Transaction_AccountSet{AccountSet: v}
 			}
 			iNdEx = postIndex
-		case 82:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentChannelClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDelete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7860,21 +8729,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_PaymentChannelClaim); ok {
-				if err := oneof.PaymentChannelClaim.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AccountDelete); ok {
+				if err := oneof.AccountDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &PaymentChannelClaim{}
+				v := &AccountDelete{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_PaymentChannelClaim{PaymentChannelClaim: v}
+				m.TxDetails = &Transaction_AccountDelete{AccountDelete: v}
 			}
 			iNdEx = postIndex
-		case 90:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetRegularKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7901,21 +8770,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_CheckCreate); ok {
-				if err := oneof.CheckCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_SetRegularKey); ok {
+				if err := oneof.SetRegularKey.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &CheckCreate{}
+				v := &SetRegularKey{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_CheckCreate{CheckCreate: v}
+				m.TxDetails = &Transaction_SetRegularKey{SetRegularKey: v}
 			}
 			iNdEx = postIndex
-		case 91:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckCash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerListSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7942,21 +8811,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_CheckCash); ok {
-				if err := oneof.CheckCash.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_SignerListSet); ok {
+				if err := oneof.SignerListSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &CheckCash{}
+				v := &SignerListSet{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_CheckCash{CheckCash: v}
+				m.TxDetails = &Transaction_SignerListSet{SignerListSet: v}
 			}
 			iNdEx = postIndex
-		case 92:
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckCancel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7983,21 +8852,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_CheckCancel); ok {
-				if err := oneof.CheckCancel.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_EscrowCreate); ok {
+				if err := oneof.EscrowCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &CheckCancel{}
+				v := &EscrowCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_CheckCancel{CheckCancel: v}
+				m.TxDetails = &Transaction_EscrowCreate{EscrowCreate: v}
 			}
 			iNdEx = postIndex
-		case 100:
+		case 71:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositPreauth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowFinish", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8024,21 +8893,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_DepositPreauth); ok {
-				if err := oneof.DepositPreauth.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_EscrowFinish); ok {
+				if err := oneof.EscrowFinish.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &DepositPreauth{}
+				v := &EscrowFinish{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_DepositPreauth{DepositPreauth: v}
+				m.TxDetails = &Transaction_EscrowFinish{EscrowFinish: v}
 			}
 			iNdEx = postIndex
-		case 101:
+		case 72:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TicketCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCancel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8065,21 +8934,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_TicketCreate); ok {
-				if err := oneof.TicketCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_EscrowCancel); ok {
+				if err := oneof.EscrowCancel.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &TicketCreate{}
+				v := &EscrowCancel{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_TicketCreate{TicketCreate: v}
+				m.TxDetails = &Transaction_EscrowCancel{EscrowCancel: v}
 			}
 			iNdEx = postIndex
-		case 110:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftokenMint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentChannelCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8106,21 +8975,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_NftokenMint); ok {
-				if err := oneof.NftokenMint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_PaymentChannelCreate); ok {
+				if err := oneof.PaymentChannelCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &NFTokenMint{}
+				v := &PaymentChannelCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_NftokenMint{NftokenMint: v}
+				m.TxDetails = &Transaction_PaymentChannelCreate{PaymentChannelCreate: v}
 			}
 			iNdEx = postIndex
-		case 111:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftokenBurn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentChannelFund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8147,21 +9016,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_NftokenBurn); ok {
-				if err := oneof.NftokenBurn.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_PaymentChannelFund); ok {
+				if err := oneof.PaymentChannelFund.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &NFTokenBurn{}
+				v := &PaymentChannelFund{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_NftokenBurn{NftokenBurn: v}
+				m.TxDetails = &Transaction_PaymentChannelFund{PaymentChannelFund: v}
 			}
 			iNdEx = postIndex
-		case 112:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftokenCreateOffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentChannelClaim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8188,21 +9057,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_NftokenCreateOffer); ok {
-				if err := oneof.NftokenCreateOffer.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_PaymentChannelClaim); ok {
+				if err := oneof.PaymentChannelClaim.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &NFTokenCreateOffer{}
+				v := &PaymentChannelClaim{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_NftokenCreateOffer{NftokenCreateOffer: v}
+				m.TxDetails = &Transaction_PaymentChannelClaim{PaymentChannelClaim: v}
 			}
 			iNdEx = postIndex
-		case 113:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftokenCancelOffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8229,21 +9098,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_NftokenCancelOffer); ok {
-				if err := oneof.NftokenCancelOffer.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_CheckCreate); ok {
+				if err := oneof.CheckCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &NFTokenCancelOffer{}
+				v := &CheckCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_NftokenCancelOffer{NftokenCancelOffer: v}
+				m.TxDetails = &Transaction_CheckCreate{CheckCreate: v}
 			}
 			iNdEx = postIndex
-		case 114:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftokenAcceptOffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckCash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8270,21 +9139,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_NftokenAcceptOffer); ok {
-				if err := oneof.NftokenAcceptOffer.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_CheckCash); ok {
+				if err := oneof.CheckCash.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &NFTokenAcceptOffer{}
+				v := &CheckCash{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_NftokenAcceptOffer{NftokenAcceptOffer: v}
+				m.TxDetails = &Transaction_CheckCash{CheckCash: v}
 			}
 			iNdEx = postIndex
-		case 115:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftokenModify", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckCancel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8311,21 +9180,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_NftokenModify); ok {
-				if err := oneof.NftokenModify.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_CheckCancel); ok {
+				if err := oneof.CheckCancel.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &NFTokenModify{}
+				v := &CheckCancel{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_NftokenModify{NftokenModify: v}
+				m.TxDetails = &Transaction_CheckCancel{CheckCancel: v}
 			}
 			iNdEx = postIndex
-		case 120:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPreauth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8352,21 +9221,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_Clawback); ok {
-				if err := oneof.Clawback.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_DepositPreauth); ok {
+				if err := oneof.DepositPreauth.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &Clawback{}
+				v := &DepositPreauth{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_Clawback{Clawback: v}
+				m.TxDetails = &Transaction_DepositPreauth{DepositPreauth: v}
 			}
 			iNdEx = postIndex
-		case 130:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8393,21 +9262,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmCreate); ok {
-				if err := oneof.AmmCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_TicketCreate); ok {
+				if err := oneof.TicketCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMCreate{}
+				v := &TicketCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmCreate{AmmCreate: v}
+				m.TxDetails = &Transaction_TicketCreate{TicketCreate: v}
 			}
 			iNdEx = postIndex
-		case 131:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenMint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8434,21 +9303,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmDeposit); ok {
-				if err := oneof.AmmDeposit.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_NftokenMint); ok {
+				if err := oneof.NftokenMint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMDeposit{}
+				v := &NFTokenMint{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmDeposit{AmmDeposit: v}
+				m.TxDetails = &Transaction_NftokenMint{NftokenMint: v}
 			}
 			iNdEx = postIndex
-		case 132:
+		case 111:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmWithdraw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenBurn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8475,21 +9344,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmWithdraw); ok {
-				if err := oneof.AmmWithdraw.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_NftokenBurn); ok {
+				if err := oneof.NftokenBurn.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMWithdraw{}
+				v := &NFTokenBurn{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmWithdraw{AmmWithdraw: v}
+				m.TxDetails = &Transaction_NftokenBurn{NftokenBurn: v}
 			}
 			iNdEx = postIndex
-		case 133:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenCreateOffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8516,21 +9385,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmVote); ok {
-				if err := oneof.AmmVote.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_NftokenCreateOffer); ok {
+				if err := oneof.NftokenCreateOffer.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMVote{}
+				v := &NFTokenCreateOffer{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmVote{AmmVote: v}
+				m.TxDetails = &Transaction_NftokenCreateOffer{NftokenCreateOffer: v}
 			}
 			iNdEx = postIndex
-		case 134:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmBid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenCancelOffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8557,21 +9426,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmBid); ok {
-				if err := oneof.AmmBid.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_NftokenCancelOffer); ok {
+				if err := oneof.NftokenCancelOffer.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMBid{}
+				v := &NFTokenCancelOffer{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmBid{AmmBid: v}
+				m.TxDetails = &Transaction_NftokenCancelOffer{NftokenCancelOffer: v}
 			}
 			iNdEx = postIndex
-		case 135:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenAcceptOffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8598,21 +9467,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmDelete); ok {
-				if err := oneof.AmmDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_NftokenAcceptOffer); ok {
+				if err := oneof.NftokenAcceptOffer.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMDelete{}
+				v := &NFTokenAcceptOffer{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmDelete{AmmDelete: v}
+				m.TxDetails = &Transaction_NftokenAcceptOffer{NftokenAcceptOffer: v}
 			}
 			iNdEx = postIndex
-		case 136:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmClawback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenModify", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8639,21 +9508,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_AmmClawback); ok {
-				if err := oneof.AmmClawback.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_NftokenModify); ok {
+				if err := oneof.NftokenModify.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &AMMClawback{}
+				v := &NFTokenModify{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_AmmClawback{AmmClawback: v}
+				m.TxDetails = &Transaction_NftokenModify{NftokenModify: v}
 			}
 			iNdEx = postIndex
-		case 140:
+		case 120:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8680,21 +9549,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_DidSet); ok {
-				if err := oneof.DidSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_Clawback); ok {
+				if err := oneof.Clawback.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &DIDSet{}
+				v := &Clawback{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_DidSet{DidSet: v}
+				m.TxDetails = &Transaction_Clawback{Clawback: v}
 			}
 			iNdEx = postIndex
-		case 141:
+		case 130:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8721,21 +9590,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_DidDelete); ok {
-				if err := oneof.DidDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmCreate); ok {
+				if err := oneof.AmmCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &DIDDelete{}
+				v := &AMMCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_DidDelete{DidDelete: v}
+				m.TxDetails = &Transaction_AmmCreate{AmmCreate: v}
 			}
 			iNdEx = postIndex
-		case 150:
+		case 131:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8762,21 +9631,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_OracleSet); ok {
-				if err := oneof.OracleSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmDeposit); ok {
+				if err := oneof.AmmDeposit.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &OracleSet{}
+				v := &AMMDeposit{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_OracleSet{OracleSet: v}
+				m.TxDetails = &Transaction_AmmDeposit{AmmDeposit: v}
 			}
 			iNdEx = postIndex
-		case 151:
+		case 132:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmWithdraw", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8803,21 +9672,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_OracleDelete); ok {
-				if err := oneof.OracleDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmWithdraw); ok {
+				if err := oneof.AmmWithdraw.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &OracleDelete{}
+				v := &AMMWithdraw{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_OracleDelete{OracleDelete: v}
+				m.TxDetails = &Transaction_AmmWithdraw{AmmWithdraw: v}
 			}
 			iNdEx = postIndex
-		case 160:
+		case 133:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MptokenIssuanceCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmVote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8844,21 +9713,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_MptokenIssuanceCreate); ok {
-				if err := oneof.MptokenIssuanceCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmVote); ok {
+				if err := oneof.AmmVote.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &MPTokenIssuanceCreate{}
+				v := &AMMVote{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_MptokenIssuanceCreate{MptokenIssuanceCreate: v}
+				m.TxDetails = &Transaction_AmmVote{AmmVote: v}
 			}
 			iNdEx = postIndex
-		case 161:
+		case 134:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MptokenIssuanceDestroy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmBid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8885,21 +9754,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_MptokenIssuanceDestroy); ok {
-				if err := oneof.MptokenIssuanceDestroy.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmBid); ok {
+				if err := oneof.AmmBid.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &MPTokenIssuanceDestroy{}
+				v := &AMMBid{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_MptokenIssuanceDestroy{MptokenIssuanceDestroy: v}
+				m.TxDetails = &Transaction_AmmBid{AmmBid: v}
 			}
 			iNdEx = postIndex
-		case 162:
+		case 135:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MptokenIssuanceSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmDelete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8926,21 +9795,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_MptokenIssuanceSet); ok {
-				if err := oneof.MptokenIssuanceSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmDelete); ok {
+				if err := oneof.AmmDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &MPTokenIssuanceSet{}
+				v := &AMMDelete{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_MptokenIssuanceSet{MptokenIssuanceSet: v}
+				m.TxDetails = &Transaction_AmmDelete{AmmDelete: v}
 			}
 			iNdEx = postIndex
-		case 163:
+		case 136:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MptokenAuthorize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmClawback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8967,21 +9836,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_MptokenAuthorize); ok {
-				if err := oneof.MptokenAuthorize.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_AmmClawback); ok {
+				if err := oneof.AmmClawback.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &MPTokenAuthorize{}
+				v := &AMMClawback{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_MptokenAuthorize{MptokenAuthorize: v}
+				m.TxDetails = &Transaction_AmmClawback{AmmClawback: v}
 			}
 			iNdEx = postIndex
-		case 170:
+		case 140:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9008,21 +9877,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_CredentialCreate); ok {
-				if err := oneof.CredentialCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_DidSet); ok {
+				if err := oneof.DidSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &CredentialCreate{}
+				v := &DIDSet{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_CredentialCreate{CredentialCreate: v}
+				m.TxDetails = &Transaction_DidSet{DidSet: v}
 			}
 			iNdEx = postIndex
-		case 171:
+		case 141:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDelete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9049,21 +9918,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_CredentialAccept); ok {
-				if err := oneof.CredentialAccept.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_DidDelete); ok {
+				if err := oneof.DidDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &CredentialAccept{}
+				v := &DIDDelete{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_CredentialAccept{CredentialAccept: v}
+				m.TxDetails = &Transaction_DidDelete{DidDelete: v}
 			}
 			iNdEx = postIndex
-		case 172:
+		case 150:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9090,21 +9959,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_CredentialDelete); ok {
-				if err := oneof.CredentialDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_OracleSet); ok {
+				if err := oneof.OracleSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &CredentialDelete{}
+				v := &OracleSet{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_CredentialDelete{CredentialDelete: v}
+				m.TxDetails = &Transaction_OracleSet{OracleSet: v}
 			}
 			iNdEx = postIndex
-		case 180:
+		case 151:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedDomainSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDelete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9131,21 +10000,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_PermissionedDomainSet); ok {
-				if err := oneof.PermissionedDomainSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_OracleDelete); ok {
+				if err := oneof.OracleDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &PermissionedDomainSet{}
+				v := &OracleDelete{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_PermissionedDomainSet{PermissionedDomainSet: v}
+				m.TxDetails = &Transaction_OracleDelete{OracleDelete: v}
 			}
 			iNdEx = postIndex
-		case 181:
+		case 160:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedDomainDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MptokenIssuanceCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9172,21 +10041,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_PermissionedDomainDelete); ok {
-				if err := oneof.PermissionedDomainDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_MptokenIssuanceCreate); ok {
+				if err := oneof.MptokenIssuanceCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &PermissionedDomainDelete{}
+				v := &MPTokenIssuanceCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_PermissionedDomainDelete{PermissionedDomainDelete: v}
+				m.TxDetails = &Transaction_MptokenIssuanceCreate{MptokenIssuanceCreate: v}
 			}
 			iNdEx = postIndex
-		case 190:
+		case 161:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MptokenIssuanceDestroy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9213,21 +10082,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_DelegateSet); ok {
-				if err := oneof.DelegateSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_MptokenIssuanceDestroy); ok {
+				if err := oneof.MptokenIssuanceDestroy.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &DelegateSet{}
+				v := &MPTokenIssuanceDestroy{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_DelegateSet{DelegateSet: v}
+				m.TxDetails = &Transaction_MptokenIssuanceDestroy{MptokenIssuanceDestroy: v}
 			}
 			iNdEx = postIndex
-		case 200:
+		case 162:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MptokenIssuanceSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9254,21 +10123,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_Batch); ok {
-				if err := oneof.Batch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_MptokenIssuanceSet); ok {
+				if err := oneof.MptokenIssuanceSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &Batch{}
+				v := &MPTokenIssuanceSet{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_Batch{Batch: v}
+				m.TxDetails = &Transaction_MptokenIssuanceSet{MptokenIssuanceSet: v}
 			}
 			iNdEx = postIndex
-		case 900:
+		case 163:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableAmendment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MptokenAuthorize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9295,21 +10164,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_EnableAmendment); ok {
-				if err := oneof.EnableAmendment.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_MptokenAuthorize); ok {
+				if err := oneof.MptokenAuthorize.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &EnableAmendment{}
+				v := &MPTokenAuthorize{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_EnableAmendment{EnableAmendment: v}
+				m.TxDetails = &Transaction_MptokenAuthorize{MptokenAuthorize: v}
 			}
 			iNdEx = postIndex
-		case 901:
+		case 170:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9336,21 +10205,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_SetFee); ok {
-				if err := oneof.SetFee.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_CredentialCreate); ok {
+				if err := oneof.CredentialCreate.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &SetFee{}
+				v := &CredentialCreate{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_SetFee{SetFee: v}
+				m.TxDetails = &Transaction_CredentialCreate{CredentialCreate: v}
 			}
 			iNdEx = postIndex
-		case 902:
+		case 171:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlModify", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialAccept", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9377,21 +10246,21 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_UnlModify); ok {
-				if err := oneof.UnlModify.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_CredentialAccept); ok {
+				if err := oneof.CredentialAccept.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &UNLModify{}
+				v := &CredentialAccept{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_UnlModify{UnlModify: v}
+				m.TxDetails = &Transaction_CredentialAccept{CredentialAccept: v}
 			}
 			iNdEx = postIndex
-		case 903:
+		case 172:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LedgerStateFix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialDelete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9418,74 +10287,23 @@ func (m *Transaction) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_LedgerStateFix); ok {
-				if err := oneof.LedgerStateFix.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if oneof, ok := m.TxDetails.(*Transaction_CredentialDelete); ok {
+				if err := oneof.CredentialDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &LedgerStateFix{}
+				v := &CredentialDelete{}
 				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
-				m.TxDetails = &Transaction_LedgerStateFix{LedgerStateFix: v}
+				m.TxDetails = &Transaction_CredentialDelete{CredentialDelete: v}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return protohelpers.ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Memo) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return protohelpers.ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Memo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Memo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 180:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedDomainSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9495,29 +10313,38 @@ func (m *Memo) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemoData = string(dAtA[iNdEx:postIndex])
+			if oneof, ok := m.TxDetails.(*Transaction_PermissionedDomainSet); ok {
+				if err := oneof.PermissionedDomainSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &PermissionedDomainSet{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_PermissionedDomainSet{PermissionedDomainSet: v}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 181:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedDomainDelete", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9527,29 +10354,38 @@ func (m *Memo) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemoFormat = string(dAtA[iNdEx:postIndex])
+			if oneof, ok := m.TxDetails.(*Transaction_PermissionedDomainDelete); ok {
+				if err := oneof.PermissionedDomainDelete.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &PermissionedDomainDelete{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_PermissionedDomainDelete{PermissionedDomainDelete: v}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 190:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9559,80 +10395,38 @@ func (m *Memo) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemoType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return protohelpers.ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return protohelpers.ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if oneof, ok := m.TxDetails.(*Transaction_DelegateSet); ok {
+				if err := oneof.DelegateSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &DelegateSet{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_DelegateSet{DelegateSet: v}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9642,16 +10436,38 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
 			}
-			var byteLen int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.TxDetails.(*Transaction_Batch); ok {
+				if err := oneof.Batch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Batch{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_Batch{Batch: v}
+			}
+			iNdEx = postIndex
+		case 900:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAmendment", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9661,26 +10477,36 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = dAtA[iNdEx:postIndex]
+			if oneof, ok := m.TxDetails.(*Transaction_EnableAmendment); ok {
+				if err := oneof.EnableAmendment.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &EnableAmendment{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_EnableAmendment{EnableAmendment: v}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 901:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9707,18 +10533,23 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if oneof, ok := m.TxDetails.(*Transaction_SetFee); ok {
+				if err := oneof.SetFee.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &SetFee{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_SetFee{SetFee: v}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 902:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlModify", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9728,14 +10559,36 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.TxDetails.(*Transaction_UnlModify); ok {
+				if err := oneof.UnlModify.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UNLModify{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_UnlModify{UnlModify: v}
+			}
+			iNdEx = postIndex
+		case 903:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerStateFix", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9762,14 +10615,21 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transactions = append(m.Transactions, &Transaction{})
-			if err := m.Transactions[len(m.Transactions)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if oneof, ok := m.TxDetails.(*Transaction_LedgerStateFix); ok {
+				if err := oneof.LedgerStateFix.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &LedgerStateFix{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_LedgerStateFix{LedgerStateFix: v}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 1000:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnknownDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9796,11 +10656,16 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CloseTime == nil {
-				m.CloseTime = &timestamppb.Timestamp{}
-			}
-			if err := (*timestamppb1.Timestamp)(m.CloseTime).UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if oneof, ok := m.TxDetails.(*Transaction_UnknownDetails); ok {
+				if err := (*structpb1.Struct)(oneof.UnknownDetails).UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &structpb.Struct{}
+				if err := (*structpb1.Struct)(v).UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_UnknownDetails{UnknownDetails: v}
 			}
 			iNdEx = postIndex
 		default:
@@ -9825,7 +10690,7 @@ func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
+func (m *Memo) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9848,17 +10713,17 @@ func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Memo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Memo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoData", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9868,47 +10733,29 @@ func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentHash = dAtA[iNdEx:postIndex]
+			m.MemoData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDrops", wireType)
-			}
-			m.TotalDrops = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protohelpers.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalDrops |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoFormat", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9918,28 +10765,29 @@ func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountHash = dAtA[iNdEx:postIndex]
+			m.MemoFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9949,28 +10797,29 @@ func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionHash = dAtA[iNdEx:postIndex]
+			m.MemoType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseTimeResolution", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoText", wireType)
 			}
-			m.CloseTimeResolution = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -9980,30 +10829,24 @@ func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CloseTimeResolution |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseFlags", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
 			}
-			m.CloseFlags = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protohelpers.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CloseFlags |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoText = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -10026,7 +10869,7 @@ func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
+func (m *Block) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10049,13 +10892,32 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
@@ -10086,11 +10948,11 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			}
 			m.Hash = dAtA[iNdEx:postIndex]
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10100,33 +10962,33 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var stringValue string
-			if intStringLen > 0 {
-				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Result = stringValue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Index = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10136,14 +10998,135 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBlob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = &timestamppb.Timestamp{}
+			}
+			if err := (*timestamppb1.Timestamp)(m.CloseTime).UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10170,11 +11153,61 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBlob = dAtA[iNdEx:postIndex]
+			m.ParentHash = dAtA[iNdEx:postIndex]
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDrops", wireType)
+			}
+			m.TotalDrops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDrops |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaBlob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountHash = dAtA[iNdEx:postIndex]
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10201,11 +11234,744 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaBlob = dAtA[iNdEx:postIndex]
-			iNdEx = postIndex
-		case 6:
+			m.TransactionHash = dAtA[iNdEx:postIndex]
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTimeResolution", wireType)
+			}
+			m.CloseTimeResolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseTimeResolution |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFlags", wireType)
+			}
+			m.CloseFlags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseFlags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			m.BaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBase", wireType)
+			}
+			m.ReserveBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveBase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveIncrement", wireType)
+			}
+			m.ReserveIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveIncrement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCount", wireType)
+			}
+			m.TransactionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filtered = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedTransactionCount", wireType)
+			}
+			m.SkippedTransactionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedTransactionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = dAtA[iNdEx:postIndex]
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = stringValue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBlob", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBlob = dAtA[iNdEx:postIndex]
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaBlob", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaBlob = dAtA[iNdEx:postIndex]
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.TxType = stringValue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Account = stringValue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTxnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.AccountTxnId = stringValue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Delegate = stringValue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLedgerSequence", wireType)
+			}
+			m.LastLedgerSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastLedgerSequence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memos = append(m.Memos, &Memo{})
+			if err := m.Memos[len(m.Memos)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkId", wireType)
+			}
+			m.NetworkId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &Signer{})
+			if err := m.Signers[len(m.Signers)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTag", wireType)
+			}
+			m.SourceTag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceTag |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.SigningPubKey = stringValue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketSequence", wireType)
+			}
+			m.TicketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TicketSequence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10237,13 +12003,13 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
 			}
-			m.TxType = stringValue
+			m.TxnSignature = stringValue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMultisigned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10253,33 +12019,17 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return protohelpers.ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return protohelpers.ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var stringValue string
-			if intStringLen > 0 {
-				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
-			}
-			m.Account = stringValue
-			iNdEx = postIndex
-		case 8:
+			m.IsMultisigned = bool(v != 0)
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgersUntilExpiry", wireType)
 			}
-			m.Fee = 0
+			m.LedgersUntilExpiry = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10289,16 +12039,16 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Fee |= uint64(b&0x7F) << shift
+				m.LedgersUntilExpiry |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodecOutdated", wireType)
 			}
-			m.Sequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10308,16 +12058,17 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			m.CodecOutdated = bool(v != 0)
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTxnId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10327,31 +12078,31 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var stringValue string
-			if intStringLen > 0 {
-				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			if m.DecodedMeta == nil {
+				m.DecodedMeta = &structpb.Struct{}
+			}
+			if err := (*structpb1.Struct)(m.DecodedMeta).UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AccountTxnId = stringValue
 			iNdEx = postIndex
-		case 11:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetFlags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10383,13 +12134,13 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
 			}
-			m.Delegate = stringValue
+			m.SetFlags = append(m.SetFlags, stringValue)
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
 			}
-			m.Flags = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10399,16 +12150,31 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flags |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &StateChange{})
+			if err := m.StateChanges[len(m.StateChanges)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastLedgerSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionType", wireType)
 			}
-			m.LastLedgerSequence = 0
+			m.TransactionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10418,14 +12184,14 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastLedgerSequence |= uint32(b&0x7F) << shift
+				m.TransactionType |= TransactionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InnerTransactions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10452,16 +12218,16 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memos = append(m.Memos, &Memo{})
-			if err := m.Memos[len(m.Memos)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+			m.InnerTransactions = append(m.InnerTransactions, &Transaction{})
+			if err := m.InnerTransactions[len(m.InnerTransactions)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
 			}
-			m.NetworkId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10471,14 +12237,15 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NetworkId |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			m.Applied = bool(v != 0)
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10505,16 +12272,23 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, &Signer{})
-			if err := m.Signers[len(m.Signers)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if oneof, ok := m.TxDetails.(*Transaction_Payment); ok {
+				if err := oneof.Payment.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Payment{}
+				if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_Payment{Payment: v}
 			}
 			iNdEx = postIndex
-		case 17:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerIndex", wireType)
 			}
-			m.SourceTag = 0
+			m.LedgerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10524,16 +12298,16 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceTag |= uint32(b&0x7F) << shift
+				m.LedgerIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10543,52 +12317,33 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var stringValue string
-			if intStringLen > 0 {
-				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
-			}
-			m.SigningPubKey = stringValue
-			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TicketSequence", wireType)
+			if m.CloseTime == nil {
+				m.CloseTime = &timestamppb.Timestamp{}
 			}
-			m.TicketSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protohelpers.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TicketSequence |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := (*timestamppb1.Timestamp)(m.CloseTime).UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 20:
+			iNdEx = postIndex
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenTransfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -10598,31 +12353,29 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var stringValue string
-			if intStringLen > 0 {
-				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			m.NftokenTransfers = append(m.NftokenTransfers, &NFTokenTransfer{})
+			if err := m.NftokenTransfers[len(m.NftokenTransfers)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TxnSignature = stringValue
 			iNdEx = postIndex
-		case 30:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftokenOfferChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10649,16 +12402,9 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.TxDetails.(*Transaction_Payment); ok {
-				if err := oneof.Payment.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &Payment{}
-				if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.TxDetails = &Transaction_Payment{Payment: v}
+			m.NftokenOfferChanges = append(m.NftokenOfferChanges, &NFTokenOfferChange{})
+			if err := m.NftokenOfferChanges[len(m.NftokenOfferChanges)-1].UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 40:
@@ -12752,6 +14498,47 @@ func (m *Transaction) UnmarshalVTUnsafe(dAtA []byte) error {
 				m.TxDetails = &Transaction_LedgerStateFix{LedgerStateFix: v}
 			}
 			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnknownDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.TxDetails.(*Transaction_UnknownDetails); ok {
+				if err := (*structpb1.Struct)(oneof.UnknownDetails).UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &structpb.Struct{}
+				if err := (*structpb1.Struct)(v).UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.TxDetails = &Transaction_UnknownDetails{UnknownDetails: v}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -12911,6 +14698,42 @@ func (m *Memo) UnmarshalVTUnsafe(dAtA []byte) error {
 			}
 			m.MemoType = stringValue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoText", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.MemoText = stringValue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
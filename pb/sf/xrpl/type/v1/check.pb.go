@@ -113,9 +113,11 @@ type CheckCash struct {
 	// (Optional) Exact amount to receive
 	Amount *Amount `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// (Optional) Minimum amount willing to receive
-	DeliverMin    *Amount `protobuf:"bytes,3,opt,name=deliver_min,json=deliverMin,proto3" json:"deliver_min,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	DeliverMin *Amount `protobuf:"bytes,3,opt,name=deliver_min,json=deliverMin,proto3" json:"deliver_min,omitempty"`
+	// Actual amount delivered (may differ from amount or deliver_min)
+	DeliveredAmount *Amount `protobuf:"bytes,20,opt,name=delivered_amount,json=deliveredAmount,proto3" json:"delivered_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CheckCash) Reset() {
@@ -169,6 +171,13 @@ func (x *CheckCash) GetDeliverMin() *Amount {
 	return nil
 }
 
+func (x *CheckCash) GetDeliveredAmount() *Amount {
+	if x != nil {
+		return x.DeliveredAmount
+	}
+	return nil
+}
+
 // CheckCancel - Cancels a Check object
 // Reference: https://xrpl.org/checkcancel.html
 type CheckCancel struct {
@@ -229,12 +238,13 @@ const file_sf_xrpl_type_v1_check_proto_rawDesc = "" +
 	"expiration\x12'\n" +
 	"\x0fdestination_tag\x18\x04 \x01(\rR\x0edestinationTag\x12\x1d\n" +
 	"\n" +
-	"invoice_id\x18\x05 \x01(\tR\tinvoiceId\"\x91\x01\n" +
+	"invoice_id\x18\x05 \x01(\tR\tinvoiceId\"\xd5\x01\n" +
 	"\tCheckCash\x12\x19\n" +
 	"\bcheck_id\x18\x01 \x01(\tR\acheckId\x12/\n" +
 	"\x06amount\x18\x02 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x128\n" +
 	"\vdeliver_min\x18\x03 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\n" +
-	"deliverMin\"(\n" +
+	"deliverMin\x12B\n" +
+	"\x10delivered_amount\x18\x14 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x0fdeliveredAmount\"(\n" +
 	"\vCheckCancel\x12\x19\n" +
 	"\bcheck_id\x18\x01 \x01(\tR\acheckIdBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
@@ -261,11 +271,12 @@ var file_sf_xrpl_type_v1_check_proto_depIdxs = []int32{
 	3, // 0: sf.xrpl.type.v1.CheckCreate.send_max:type_name -> sf.xrpl.type.v1.Amount
 	3, // 1: sf.xrpl.type.v1.CheckCash.amount:type_name -> sf.xrpl.type.v1.Amount
 	3, // 2: sf.xrpl.type.v1.CheckCash.deliver_min:type_name -> sf.xrpl.type.v1.Amount
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 3: sf.xrpl.type.v1.CheckCash.delivered_amount:type_name -> sf.xrpl.type.v1.Amount
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_check_proto_init() }
@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DepositAuthorizationDirection int32
+
+const (
+	DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNSPECIFIED DepositAuthorizationDirection = 0
+	DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE   DepositAuthorizationDirection = 1
+	DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE DepositAuthorizationDirection = 2
+)
+
+// Enum value maps for DepositAuthorizationDirection.
+var (
+	DepositAuthorizationDirection_name = map[int32]string{
+		0: "DEPOSIT_AUTHORIZATION_DIRECTION_UNSPECIFIED",
+		1: "DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE",
+		2: "DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE",
+	}
+	DepositAuthorizationDirection_value = map[string]int32{
+		"DEPOSIT_AUTHORIZATION_DIRECTION_UNSPECIFIED": 0,
+		"DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE":   1,
+		"DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE": 2,
+	}
+)
+
+func (x DepositAuthorizationDirection) Enum() *DepositAuthorizationDirection {
+	p := new(DepositAuthorizationDirection)
+	*p = x
+	return p
+}
+
+func (x DepositAuthorizationDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositAuthorizationDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_deposit_preauth_proto_enumTypes[0].Descriptor()
+}
+
+func (DepositAuthorizationDirection) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_deposit_preauth_proto_enumTypes[0]
+}
+
+func (x DepositAuthorizationDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositAuthorizationDirection.Descriptor instead.
+func (DepositAuthorizationDirection) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_deposit_preauth_proto_rawDescGZIP(), []int{0}
+}
+
 // DepositPreauth - Pre-authorizes an account to deliver payments
 // Reference: https://xrpl.org/depositpreauth.html
 type DepositPreauth struct {
@@ -33,8 +82,11 @@ type DepositPreauth struct {
 	AuthorizeCredentials []*AuthorizeCredential `protobuf:"bytes,3,rep,name=authorize_credentials,json=authorizeCredentials,proto3" json:"authorize_credentials,omitempty"`
 	// (Optional) Credentials to unauthorize
 	UnauthorizeCredentials []*AuthorizeCredential `protobuf:"bytes,4,rep,name=unauthorize_credentials,json=unauthorizeCredentials,proto3" json:"unauthorize_credentials,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Derived: the single authorization change described by the four fields
+	// above, unset when they don't hold exactly one value
+	Authorization *DepositAuthorization `protobuf:"bytes,5,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DepositPreauth) Reset() {
@@ -95,6 +147,13 @@ func (x *DepositPreauth) GetUnauthorizeCredentials() []*AuthorizeCredential {
 	return nil
 }
 
+func (x *DepositPreauth) GetAuthorization() *DepositAuthorization {
+	if x != nil {
+		return x.Authorization
+	}
+	return nil
+}
+
 type AuthorizeCredential struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Issuer         string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
@@ -147,19 +206,172 @@ func (x *AuthorizeCredential) GetCredentialType() string {
 	return ""
 }
 
+// DepositAuthorization - Normalized DepositPreauth change
+type DepositAuthorization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the target is being authorized or unauthorized
+	Direction DepositAuthorizationDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=sf.xrpl.type.v1.DepositAuthorizationDirection" json:"direction,omitempty"`
+	// What is being (un)authorized
+	//
+	// Types that are valid to be assigned to Target:
+	//
+	//	*DepositAuthorization_Account
+	//	*DepositAuthorization_Credentials
+	Target        isDepositAuthorization_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositAuthorization) Reset() {
+	*x = DepositAuthorization{}
+	mi := &file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAuthorization) ProtoMessage() {}
+
+func (x *DepositAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAuthorization.ProtoReflect.Descriptor instead.
+func (*DepositAuthorization) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_deposit_preauth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DepositAuthorization) GetDirection() DepositAuthorizationDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return DepositAuthorizationDirection_DEPOSIT_AUTHORIZATION_DIRECTION_UNSPECIFIED
+}
+
+func (x *DepositAuthorization) GetTarget() isDepositAuthorization_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *DepositAuthorization) GetAccount() string {
+	if x != nil {
+		if x, ok := x.Target.(*DepositAuthorization_Account); ok {
+			return x.Account
+		}
+	}
+	return ""
+}
+
+func (x *DepositAuthorization) GetCredentials() *CredentialSet {
+	if x != nil {
+		if x, ok := x.Target.(*DepositAuthorization_Credentials); ok {
+			return x.Credentials
+		}
+	}
+	return nil
+}
+
+type isDepositAuthorization_Target interface {
+	isDepositAuthorization_Target()
+}
+
+type DepositAuthorization_Account struct {
+	// Account from Authorize / Unauthorize
+	Account string `protobuf:"bytes,2,opt,name=account,proto3,oneof"`
+}
+
+type DepositAuthorization_Credentials struct {
+	// Credentials from AuthorizeCredentials / UnauthorizeCredentials
+	Credentials *CredentialSet `protobuf:"bytes,3,opt,name=credentials,proto3,oneof"`
+}
+
+func (*DepositAuthorization_Account) isDepositAuthorization_Target() {}
+
+func (*DepositAuthorization_Credentials) isDepositAuthorization_Target() {}
+
+// CredentialSet - A set of credentials that must all be held
+type CredentialSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credentials   []*AuthorizeCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialSet) Reset() {
+	*x = CredentialSet{}
+	mi := &file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialSet) ProtoMessage() {}
+
+func (x *CredentialSet) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialSet.ProtoReflect.Descriptor instead.
+func (*CredentialSet) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_deposit_preauth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CredentialSet) GetCredentials() []*AuthorizeCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
 var File_sf_xrpl_type_v1_deposit_preauth_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_deposit_preauth_proto_rawDesc = "" +
 	"\n" +
-	"%sf/xrpl/type/v1/deposit_preauth.proto\x12\x0fsf.xrpl.type.v1\"\x8a\x02\n" +
+	"%sf/xrpl/type/v1/deposit_preauth.proto\x12\x0fsf.xrpl.type.v1\"\xd7\x02\n" +
 	"\x0eDepositPreauth\x12\x1c\n" +
 	"\tauthorize\x18\x01 \x01(\tR\tauthorize\x12 \n" +
 	"\vunauthorize\x18\x02 \x01(\tR\vunauthorize\x12Y\n" +
 	"\x15authorize_credentials\x18\x03 \x03(\v2$.sf.xrpl.type.v1.AuthorizeCredentialR\x14authorizeCredentials\x12]\n" +
-	"\x17unauthorize_credentials\x18\x04 \x03(\v2$.sf.xrpl.type.v1.AuthorizeCredentialR\x16unauthorizeCredentials\"V\n" +
+	"\x17unauthorize_credentials\x18\x04 \x03(\v2$.sf.xrpl.type.v1.AuthorizeCredentialR\x16unauthorizeCredentials\x12K\n" +
+	"\rauthorization\x18\x05 \x01(\v2%.sf.xrpl.type.v1.DepositAuthorizationR\rauthorization\"V\n" +
 	"\x13AuthorizeCredential\x12\x16\n" +
 	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12'\n" +
-	"\x0fcredential_type\x18\x02 \x01(\tR\x0ecredentialTypeBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\x0fcredential_type\x18\x02 \x01(\tR\x0ecredentialType\"\xce\x01\n" +
+	"\x14DepositAuthorization\x12L\n" +
+	"\tdirection\x18\x01 \x01(\x0e2..sf.xrpl.type.v1.DepositAuthorizationDirectionR\tdirection\x12\x1a\n" +
+	"\aaccount\x18\x02 \x01(\tH\x00R\aaccount\x12B\n" +
+	"\vcredentials\x18\x03 \x01(\v2\x1e.sf.xrpl.type.v1.CredentialSetH\x00R\vcredentialsB\b\n" +
+	"\x06target\"W\n" +
+	"\rCredentialSet\x12F\n" +
+	"\vcredentials\x18\x01 \x03(\v2$.sf.xrpl.type.v1.AuthorizeCredentialR\vcredentials*\xb0\x01\n" +
+	"\x1dDepositAuthorizationDirection\x12/\n" +
+	"+DEPOSIT_AUTHORIZATION_DIRECTION_UNSPECIFIED\x10\x00\x12-\n" +
+	")DEPOSIT_AUTHORIZATION_DIRECTION_AUTHORIZE\x10\x01\x12/\n" +
+	"+DEPOSIT_AUTHORIZATION_DIRECTION_UNAUTHORIZE\x10\x02BAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_deposit_preauth_proto_rawDescOnce sync.Once
@@ -173,19 +385,27 @@ func file_sf_xrpl_type_v1_deposit_preauth_proto_rawDescGZIP() []byte {
 	return file_sf_xrpl_type_v1_deposit_preauth_proto_rawDescData
 }
 
-var file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_xrpl_type_v1_deposit_preauth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_sf_xrpl_type_v1_deposit_preauth_proto_goTypes = []any{
-	(*DepositPreauth)(nil),      // 0: sf.xrpl.type.v1.DepositPreauth
-	(*AuthorizeCredential)(nil), // 1: sf.xrpl.type.v1.AuthorizeCredential
+	(DepositAuthorizationDirection)(0), // 0: sf.xrpl.type.v1.DepositAuthorizationDirection
+	(*DepositPreauth)(nil),             // 1: sf.xrpl.type.v1.DepositPreauth
+	(*AuthorizeCredential)(nil),        // 2: sf.xrpl.type.v1.AuthorizeCredential
+	(*DepositAuthorization)(nil),       // 3: sf.xrpl.type.v1.DepositAuthorization
+	(*CredentialSet)(nil),              // 4: sf.xrpl.type.v1.CredentialSet
 }
 var file_sf_xrpl_type_v1_deposit_preauth_proto_depIdxs = []int32{
-	1, // 0: sf.xrpl.type.v1.DepositPreauth.authorize_credentials:type_name -> sf.xrpl.type.v1.AuthorizeCredential
-	1, // 1: sf.xrpl.type.v1.DepositPreauth.unauthorize_credentials:type_name -> sf.xrpl.type.v1.AuthorizeCredential
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: sf.xrpl.type.v1.DepositPreauth.authorize_credentials:type_name -> sf.xrpl.type.v1.AuthorizeCredential
+	2, // 1: sf.xrpl.type.v1.DepositPreauth.unauthorize_credentials:type_name -> sf.xrpl.type.v1.AuthorizeCredential
+	3, // 2: sf.xrpl.type.v1.DepositPreauth.authorization:type_name -> sf.xrpl.type.v1.DepositAuthorization
+	0, // 3: sf.xrpl.type.v1.DepositAuthorization.direction:type_name -> sf.xrpl.type.v1.DepositAuthorizationDirection
+	4, // 4: sf.xrpl.type.v1.DepositAuthorization.credentials:type_name -> sf.xrpl.type.v1.CredentialSet
+	2, // 5: sf.xrpl.type.v1.CredentialSet.credentials:type_name -> sf.xrpl.type.v1.AuthorizeCredential
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_deposit_preauth_proto_init() }
@@ -193,18 +413,23 @@ func file_sf_xrpl_type_v1_deposit_preauth_proto_init() {
 	if File_sf_xrpl_type_v1_deposit_preauth_proto != nil {
 		return
 	}
+	file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes[2].OneofWrappers = []any{
+		(*DepositAuthorization_Account)(nil),
+		(*DepositAuthorization_Credentials)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_deposit_preauth_proto_rawDesc), len(file_sf_xrpl_type_v1_deposit_preauth_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sf_xrpl_type_v1_deposit_preauth_proto_goTypes,
 		DependencyIndexes: file_sf_xrpl_type_v1_deposit_preauth_proto_depIdxs,
+		EnumInfos:         file_sf_xrpl_type_v1_deposit_preauth_proto_enumTypes,
 		MessageInfos:      file_sf_xrpl_type_v1_deposit_preauth_proto_msgTypes,
 	}.Build()
 	File_sf_xrpl_type_v1_deposit_preauth_proto = out.File
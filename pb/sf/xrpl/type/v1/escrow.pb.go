@@ -37,8 +37,12 @@ type EscrowCreate struct {
 	Condition string `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
 	// (Optional) Destination tag
 	DestinationTag uint32 `protobuf:"varint,6,opt,name=destination_tag,json=destinationTag,proto3" json:"destination_tag,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// (Optional) Source tag, copied from the base transaction
+	SourceTag uint32 `protobuf:"varint,7,opt,name=source_tag,json=sourceTag,proto3" json:"source_tag,omitempty"`
+	// True when the transaction carries a SourceTag (0 is a valid tag)
+	HasSourceTag  bool `protobuf:"varint,8,opt,name=has_source_tag,json=hasSourceTag,proto3" json:"has_source_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EscrowCreate) Reset() {
@@ -113,6 +117,20 @@ func (x *EscrowCreate) GetDestinationTag() uint32 {
 	return 0
 }
 
+func (x *EscrowCreate) GetSourceTag() uint32 {
+	if x != nil {
+		return x.SourceTag
+	}
+	return 0
+}
+
+func (x *EscrowCreate) GetHasSourceTag() bool {
+	if x != nil {
+		return x.HasSourceTag
+	}
+	return false
+}
+
 // EscrowFinish - Completes a held payment
 // Reference: https://xrpl.org/escrowfinish.html
 type EscrowFinish struct {
@@ -256,14 +274,17 @@ var File_sf_xrpl_type_v1_escrow_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_escrow_proto_rawDesc = "" +
 	"\n" +
-	"\x1csf/xrpl/type/v1/escrow.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xee\x01\n" +
+	"\x1csf/xrpl/type/v1/escrow.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xb3\x02\n" +
 	"\fEscrowCreate\x12/\n" +
 	"\x06amount\x18\x01 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x12 \n" +
 	"\vdestination\x18\x02 \x01(\tR\vdestination\x12!\n" +
 	"\fcancel_after\x18\x03 \x01(\rR\vcancelAfter\x12!\n" +
 	"\ffinish_after\x18\x04 \x01(\rR\vfinishAfter\x12\x1c\n" +
 	"\tcondition\x18\x05 \x01(\tR\tcondition\x12'\n" +
-	"\x0fdestination_tag\x18\x06 \x01(\rR\x0edestinationTag\"\xb2\x01\n" +
+	"\x0fdestination_tag\x18\x06 \x01(\rR\x0edestinationTag\x12\x1d\n" +
+	"\n" +
+	"source_tag\x18\a \x01(\rR\tsourceTag\x12$\n" +
+	"\x0ehas_source_tag\x18\b \x01(\bR\fhasSourceTag\"\xb2\x01\n" +
 	"\fEscrowFinish\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12%\n" +
 	"\x0eoffer_sequence\x18\x02 \x01(\rR\rofferSequence\x12\x1c\n" +
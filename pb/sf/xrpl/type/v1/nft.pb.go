@@ -45,7 +45,11 @@ type NFTokenMint struct {
 	// tfTrustLine = 4 (0x00000004) - DEPRECATED: Auto-create trust lines for fees
 	// tfTransferable = 8 (0x00000008) - NFToken can be transferred to others
 	// tfMutable = 16 (0x00000010) - URI can be updated via NFTokenModify
-	Flags         uint32 `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
+	Flags uint32 `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
+	// ID of the minted NFToken (64 hex chars), derived from the NFTokenPage changes
+	NftokenId string `protobuf:"bytes,20,opt,name=nftoken_id,json=nftokenId,proto3" json:"nftoken_id,omitempty"`
+	// ID of the sell offer a mint with an amount created (64 hex chars), from the created NFTokenOffer
+	OfferId       string `protobuf:"bytes,21,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -136,6 +140,20 @@ func (x *NFTokenMint) GetFlags() uint32 {
 	return 0
 }
 
+func (x *NFTokenMint) GetNftokenId() string {
+	if x != nil {
+		return x.NftokenId
+	}
+	return ""
+}
+
+func (x *NFTokenMint) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
 // NFTokenBurn - Burns an existing NFT
 // Reference: https://xrpl.org/nftokenburn.html
 type NFTokenBurn struct {
@@ -208,7 +226,10 @@ type NFTokenCreateOffer struct {
 	Expiration uint32 `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
 	// (Optional) Transaction flags
 	// tfSellNFToken = 1 (0x00000001) - If set indicate this is a sell offer.
-	Flags         uint32 `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	Flags uint32 `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Derived: true when tfSellNFToken is set, amount is then the asking price,
+	// otherwise this is a buy offer and amount is the bid
+	IsSellOffer   bool `protobuf:"varint,7,opt,name=is_sell_offer,json=isSellOffer,proto3" json:"is_sell_offer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +306,13 @@ func (x *NFTokenCreateOffer) GetFlags() uint32 {
 	return 0
 }
 
+func (x *NFTokenCreateOffer) GetIsSellOffer() bool {
+	if x != nil {
+		return x.IsSellOffer
+	}
+	return false
+}
+
 // NFTokenCancelOffer - Cancels NFT offers
 // Reference: https://xrpl.org/nftokencanceloffer.html
 type NFTokenCancelOffer struct {
@@ -342,8 +370,13 @@ type NFTokenAcceptOffer struct {
 	NftokenBuyOffer string `protobuf:"bytes,2,opt,name=nftoken_buy_offer,json=nftokenBuyOffer,proto3" json:"nftoken_buy_offer,omitempty"`
 	// (Optional) Broker fee for brokered trades
 	NftokenBrokerFee *Amount `protobuf:"bytes,3,opt,name=nftoken_broker_fee,json=nftokenBrokerFee,proto3" json:"nftoken_broker_fee,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Offers the accept consumed, from the deleted NFTokenOffer entries
+	// A brokered accept consumes both the sell and the buy offer
+	ConsumedOffers []*ConsumedNFTokenOffer `protobuf:"bytes,20,rep,name=consumed_offers,json=consumedOffers,proto3" json:"consumed_offers,omitempty"`
+	// ID of the traded NFToken (64 hex chars), from the consumed offers
+	NftokenId     string `protobuf:"bytes,21,opt,name=nftoken_id,json=nftokenId,proto3" json:"nftoken_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NFTokenAcceptOffer) Reset() {
@@ -397,6 +430,93 @@ func (x *NFTokenAcceptOffer) GetNftokenBrokerFee() *Amount {
 	return nil
 }
 
+func (x *NFTokenAcceptOffer) GetConsumedOffers() []*ConsumedNFTokenOffer {
+	if x != nil {
+		return x.ConsumedOffers
+	}
+	return nil
+}
+
+func (x *NFTokenAcceptOffer) GetNftokenId() string {
+	if x != nil {
+		return x.NftokenId
+	}
+	return ""
+}
+
+// ConsumedNFTokenOffer is an NFTokenOffer removed from the ledger by an NFTokenAcceptOffer
+type ConsumedNFTokenOffer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ledger entry ID of the offer (64 hex chars)
+	OfferId string `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// Account that created the offer: the seller of a sell offer, the buyer of a buy offer
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// True for a sell offer (lsfSellNFToken), false for a buy offer
+	IsSellOffer bool `protobuf:"varint,3,opt,name=is_sell_offer,json=isSellOffer,proto3" json:"is_sell_offer,omitempty"`
+	// Asking price of a sell offer, bid of a buy offer
+	Amount        *Amount `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumedNFTokenOffer) Reset() {
+	*x = ConsumedNFTokenOffer{}
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumedNFTokenOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumedNFTokenOffer) ProtoMessage() {}
+
+func (x *ConsumedNFTokenOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumedNFTokenOffer.ProtoReflect.Descriptor instead.
+func (*ConsumedNFTokenOffer) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_nft_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConsumedNFTokenOffer) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+func (x *ConsumedNFTokenOffer) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ConsumedNFTokenOffer) GetIsSellOffer() bool {
+	if x != nil {
+		return x.IsSellOffer
+	}
+	return false
+}
+
+func (x *ConsumedNFTokenOffer) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 // NFTokenModify - Modifies the URI of a dynamic NFT
 // Reference: https://xrpl.org/nftokenmodify.html
 type NFTokenModify struct {
@@ -414,7 +534,7 @@ type NFTokenModify struct {
 
 func (x *NFTokenModify) Reset() {
 	*x = NFTokenModify{}
-	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[5]
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -426,7 +546,7 @@ func (x *NFTokenModify) String() string {
 func (*NFTokenModify) ProtoMessage() {}
 
 func (x *NFTokenModify) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[5]
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +559,7 @@ func (x *NFTokenModify) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NFTokenModify.ProtoReflect.Descriptor instead.
 func (*NFTokenModify) Descriptor() ([]byte, []int) {
-	return file_sf_xrpl_type_v1_nft_proto_rawDescGZIP(), []int{5}
+	return file_sf_xrpl_type_v1_nft_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NFTokenModify) GetNftokenId() string {
@@ -463,11 +583,176 @@ func (x *NFTokenModify) GetUri() string {
 	return ""
 }
 
+// NFTokenTransfer - Change of owner of an NFToken, derived from the NFTokenPage entries of
+// a transaction's metadata: the token left the pages of from and entered the pages of to
+type NFTokenTransfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the NFT (64 hex chars)
+	NftokenId string `protobuf:"bytes,1,opt,name=nftoken_id,json=nftokenId,proto3" json:"nftoken_id,omitempty"`
+	// Previous owner, empty when the token was minted
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// New owner, empty when the token was burned
+	To            string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFTokenTransfer) Reset() {
+	*x = NFTokenTransfer{}
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFTokenTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTokenTransfer) ProtoMessage() {}
+
+func (x *NFTokenTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTokenTransfer.ProtoReflect.Descriptor instead.
+func (*NFTokenTransfer) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_nft_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NFTokenTransfer) GetNftokenId() string {
+	if x != nil {
+		return x.NftokenId
+	}
+	return ""
+}
+
+func (x *NFTokenTransfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *NFTokenTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// NFTokenOfferChange - NFTokenOffer entry created or deleted by a transaction
+type NFTokenOfferChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MOD_TYPE_CREATED or MOD_TYPE_DELETED
+	ModType ModType `protobuf:"varint,1,opt,name=mod_type,json=modType,proto3,enum=sf.xrpl.type.v1.ModType" json:"mod_type,omitempty"`
+	// Ledger entry ID of the offer (64 hex chars)
+	OfferId string `protobuf:"bytes,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// The NFT the offer is for
+	NftokenId string `protobuf:"bytes,3,opt,name=nftoken_id,json=nftokenId,proto3" json:"nftoken_id,omitempty"`
+	// Account that created the offer: the seller of a sell offer, the buyer of a buy offer
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// True for a sell offer (lsfSellNFToken), false for a buy offer
+	IsSellOffer bool `protobuf:"varint,5,opt,name=is_sell_offer,json=isSellOffer,proto3" json:"is_sell_offer,omitempty"`
+	// Asking price of a sell offer, bid of a buy offer
+	Amount *Amount `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// (Optional) Only account allowed to accept the offer
+	Destination   string `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFTokenOfferChange) Reset() {
+	*x = NFTokenOfferChange{}
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFTokenOfferChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTokenOfferChange) ProtoMessage() {}
+
+func (x *NFTokenOfferChange) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_nft_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTokenOfferChange.ProtoReflect.Descriptor instead.
+func (*NFTokenOfferChange) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_nft_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NFTokenOfferChange) GetModType() ModType {
+	if x != nil {
+		return x.ModType
+	}
+	return ModType_MOD_TYPE_UNSPECIFIED
+}
+
+func (x *NFTokenOfferChange) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+func (x *NFTokenOfferChange) GetNftokenId() string {
+	if x != nil {
+		return x.NftokenId
+	}
+	return ""
+}
+
+func (x *NFTokenOfferChange) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *NFTokenOfferChange) GetIsSellOffer() bool {
+	if x != nil {
+		return x.IsSellOffer
+	}
+	return false
+}
+
+func (x *NFTokenOfferChange) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *NFTokenOfferChange) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
 var File_sf_xrpl_type_v1_nft_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_nft_proto_rawDesc = "" +
 	"\n" +
-	"\x19sf/xrpl/type/v1/nft.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\x88\x02\n" +
+	"\x19sf/xrpl/type/v1/nft.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\x1a\"sf/xrpl/type/v1/state_change.proto\"\xc2\x02\n" +
 	"\vNFTokenMint\x12#\n" +
 	"\rnftoken_taxon\x18\x01 \x01(\rR\fnftokenTaxon\x12\x16\n" +
 	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12!\n" +
@@ -478,11 +763,14 @@ const file_sf_xrpl_type_v1_nft_proto_rawDesc = "" +
 	"expiration\x18\x06 \x01(\rR\n" +
 	"expiration\x12 \n" +
 	"\vdestination\x18\a \x01(\tR\vdestination\x12\x14\n" +
-	"\x05flags\x18\b \x01(\rR\x05flags\"B\n" +
+	"\x05flags\x18\b \x01(\rR\x05flags\x12\x1d\n" +
+	"\n" +
+	"nftoken_id\x18\x14 \x01(\tR\tnftokenId\x12\x19\n" +
+	"\boffer_id\x18\x15 \x01(\tR\aofferId\"B\n" +
 	"\vNFTokenBurn\x12\x1d\n" +
 	"\n" +
 	"nftoken_id\x18\x01 \x01(\tR\tnftokenId\x12\x14\n" +
-	"\x05owner\x18\x02 \x01(\tR\x05owner\"\xd2\x01\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\"\xf6\x01\n" +
 	"\x12NFTokenCreateOffer\x12\x1d\n" +
 	"\n" +
 	"nftoken_id\x18\x01 \x01(\tR\tnftokenId\x12/\n" +
@@ -492,18 +780,41 @@ const file_sf_xrpl_type_v1_nft_proto_rawDesc = "" +
 	"\n" +
 	"expiration\x18\x05 \x01(\rR\n" +
 	"expiration\x12\x14\n" +
-	"\x05flags\x18\x06 \x01(\rR\x05flags\";\n" +
+	"\x05flags\x18\x06 \x01(\rR\x05flags\x12\"\n" +
+	"\ris_sell_offer\x18\a \x01(\bR\visSellOffer\";\n" +
 	"\x12NFTokenCancelOffer\x12%\n" +
-	"\x0enftoken_offers\x18\x01 \x03(\tR\rnftokenOffers\"\xb5\x01\n" +
+	"\x0enftoken_offers\x18\x01 \x03(\tR\rnftokenOffers\"\xa4\x02\n" +
 	"\x12NFTokenAcceptOffer\x12,\n" +
 	"\x12nftoken_sell_offer\x18\x01 \x01(\tR\x10nftokenSellOffer\x12*\n" +
 	"\x11nftoken_buy_offer\x18\x02 \x01(\tR\x0fnftokenBuyOffer\x12E\n" +
-	"\x12nftoken_broker_fee\x18\x03 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x10nftokenBrokerFee\"V\n" +
+	"\x12nftoken_broker_fee\x18\x03 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x10nftokenBrokerFee\x12N\n" +
+	"\x0fconsumed_offers\x18\x14 \x03(\v2%.sf.xrpl.type.v1.ConsumedNFTokenOfferR\x0econsumedOffers\x12\x1d\n" +
+	"\n" +
+	"nftoken_id\x18\x15 \x01(\tR\tnftokenId\"\x9c\x01\n" +
+	"\x14ConsumedNFTokenOffer\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\"\n" +
+	"\ris_sell_offer\x18\x03 \x01(\bR\visSellOffer\x12/\n" +
+	"\x06amount\x18\x04 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\"V\n" +
 	"\rNFTokenModify\x12\x1d\n" +
 	"\n" +
 	"nftoken_id\x18\x01 \x01(\tR\tnftokenId\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x10\n" +
-	"\x03uri\x18\x03 \x01(\tR\x03uriBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\x03uri\x18\x03 \x01(\tR\x03uri\"T\n" +
+	"\x0fNFTokenTransfer\x12\x1d\n" +
+	"\n" +
+	"nftoken_id\x18\x01 \x01(\tR\tnftokenId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x90\x02\n" +
+	"\x12NFTokenOfferChange\x123\n" +
+	"\bmod_type\x18\x01 \x01(\x0e2\x18.sf.xrpl.type.v1.ModTypeR\amodType\x12\x19\n" +
+	"\boffer_id\x18\x02 \x01(\tR\aofferId\x12\x1d\n" +
+	"\n" +
+	"nftoken_id\x18\x03 \x01(\tR\tnftokenId\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\"\n" +
+	"\ris_sell_offer\x18\x05 \x01(\bR\visSellOffer\x12/\n" +
+	"\x06amount\x18\x06 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x12 \n" +
+	"\vdestination\x18\a \x01(\tR\vdestinationBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_nft_proto_rawDescOnce sync.Once
@@ -517,25 +828,33 @@ func file_sf_xrpl_type_v1_nft_proto_rawDescGZIP() []byte {
 	return file_sf_xrpl_type_v1_nft_proto_rawDescData
 }
 
-var file_sf_xrpl_type_v1_nft_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sf_xrpl_type_v1_nft_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_sf_xrpl_type_v1_nft_proto_goTypes = []any{
-	(*NFTokenMint)(nil),        // 0: sf.xrpl.type.v1.NFTokenMint
-	(*NFTokenBurn)(nil),        // 1: sf.xrpl.type.v1.NFTokenBurn
-	(*NFTokenCreateOffer)(nil), // 2: sf.xrpl.type.v1.NFTokenCreateOffer
-	(*NFTokenCancelOffer)(nil), // 3: sf.xrpl.type.v1.NFTokenCancelOffer
-	(*NFTokenAcceptOffer)(nil), // 4: sf.xrpl.type.v1.NFTokenAcceptOffer
-	(*NFTokenModify)(nil),      // 5: sf.xrpl.type.v1.NFTokenModify
-	(*Amount)(nil),             // 6: sf.xrpl.type.v1.Amount
+	(*NFTokenMint)(nil),          // 0: sf.xrpl.type.v1.NFTokenMint
+	(*NFTokenBurn)(nil),          // 1: sf.xrpl.type.v1.NFTokenBurn
+	(*NFTokenCreateOffer)(nil),   // 2: sf.xrpl.type.v1.NFTokenCreateOffer
+	(*NFTokenCancelOffer)(nil),   // 3: sf.xrpl.type.v1.NFTokenCancelOffer
+	(*NFTokenAcceptOffer)(nil),   // 4: sf.xrpl.type.v1.NFTokenAcceptOffer
+	(*ConsumedNFTokenOffer)(nil), // 5: sf.xrpl.type.v1.ConsumedNFTokenOffer
+	(*NFTokenModify)(nil),        // 6: sf.xrpl.type.v1.NFTokenModify
+	(*NFTokenTransfer)(nil),      // 7: sf.xrpl.type.v1.NFTokenTransfer
+	(*NFTokenOfferChange)(nil),   // 8: sf.xrpl.type.v1.NFTokenOfferChange
+	(*Amount)(nil),               // 9: sf.xrpl.type.v1.Amount
+	(ModType)(0),                 // 10: sf.xrpl.type.v1.ModType
 }
 var file_sf_xrpl_type_v1_nft_proto_depIdxs = []int32{
-	6, // 0: sf.xrpl.type.v1.NFTokenMint.amount:type_name -> sf.xrpl.type.v1.Amount
-	6, // 1: sf.xrpl.type.v1.NFTokenCreateOffer.amount:type_name -> sf.xrpl.type.v1.Amount
-	6, // 2: sf.xrpl.type.v1.NFTokenAcceptOffer.nftoken_broker_fee:type_name -> sf.xrpl.type.v1.Amount
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9,  // 0: sf.xrpl.type.v1.NFTokenMint.amount:type_name -> sf.xrpl.type.v1.Amount
+	9,  // 1: sf.xrpl.type.v1.NFTokenCreateOffer.amount:type_name -> sf.xrpl.type.v1.Amount
+	9,  // 2: sf.xrpl.type.v1.NFTokenAcceptOffer.nftoken_broker_fee:type_name -> sf.xrpl.type.v1.Amount
+	5,  // 3: sf.xrpl.type.v1.NFTokenAcceptOffer.consumed_offers:type_name -> sf.xrpl.type.v1.ConsumedNFTokenOffer
+	9,  // 4: sf.xrpl.type.v1.ConsumedNFTokenOffer.amount:type_name -> sf.xrpl.type.v1.Amount
+	10, // 5: sf.xrpl.type.v1.NFTokenOfferChange.mod_type:type_name -> sf.xrpl.type.v1.ModType
+	9,  // 6: sf.xrpl.type.v1.NFTokenOfferChange.amount:type_name -> sf.xrpl.type.v1.Amount
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_sf_xrpl_type_v1_nft_proto_init() }
@@ -544,13 +863,14 @@ func file_sf_xrpl_type_v1_nft_proto_init() {
 		return
 	}
 	file_sf_xrpl_type_v1_amount_proto_init()
+	file_sf_xrpl_type_v1_state_change_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_nft_proto_rawDesc), len(file_sf_xrpl_type_v1_nft_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -42,7 +42,17 @@ type OfferCreate struct {
 	// tfFillOrKill = 262144 (0x00040000) - Fill or Kill order
 	// tfSell = 524288 (0x00080000) - Exchange entire TakerGets amount
 	// tfHybrid = 1048576 (0x00100000) - Use both permissioned and open DEX
-	Flags         uint32 `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	Flags uint32 `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Derived: true when tfPassive is set, the offer does not consume offers that exactly match it
+	IsPassive bool `protobuf:"varint,7,opt,name=is_passive,json=isPassive,proto3" json:"is_passive,omitempty"`
+	// Derived: true when tfImmediateOrCancel is set, any unfilled part is not placed on the books
+	IsImmediateOrCancel bool `protobuf:"varint,8,opt,name=is_immediate_or_cancel,json=isImmediateOrCancel,proto3" json:"is_immediate_or_cancel,omitempty"`
+	// Derived: true when tfFillOrKill is set, the offer fails unless fully filled
+	IsFillOrKill bool `protobuf:"varint,9,opt,name=is_fill_or_kill,json=isFillOrKill,proto3" json:"is_fill_or_kill,omitempty"`
+	// Derived: true when tfSell is set, the whole taker_gets is exchanged even for more than taker_pays
+	IsSell bool `protobuf:"varint,10,opt,name=is_sell,json=isSell,proto3" json:"is_sell,omitempty"`
+	// Derived: true when tfHybrid is set, the offer is in both the permissioned and the open DEX
+	IsHybrid      bool `protobuf:"varint,11,opt,name=is_hybrid,json=isHybrid,proto3" json:"is_hybrid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,6 +129,41 @@ func (x *OfferCreate) GetFlags() uint32 {
 	return 0
 }
 
+func (x *OfferCreate) GetIsPassive() bool {
+	if x != nil {
+		return x.IsPassive
+	}
+	return false
+}
+
+func (x *OfferCreate) GetIsImmediateOrCancel() bool {
+	if x != nil {
+		return x.IsImmediateOrCancel
+	}
+	return false
+}
+
+func (x *OfferCreate) GetIsFillOrKill() bool {
+	if x != nil {
+		return x.IsFillOrKill
+	}
+	return false
+}
+
+func (x *OfferCreate) GetIsSell() bool {
+	if x != nil {
+		return x.IsSell
+	}
+	return false
+}
+
+func (x *OfferCreate) GetIsHybrid() bool {
+	if x != nil {
+		return x.IsHybrid
+	}
+	return false
+}
+
 // OfferCancel - Cancels an existing offer
 // Reference: https://xrpl.org/offercancel.html
 type OfferCancel struct {
@@ -170,7 +215,7 @@ var File_sf_xrpl_type_v1_offer_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_offer_proto_rawDesc = "" +
 	"\n" +
-	"\x1bsf/xrpl/type/v1/offer.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xf7\x01\n" +
+	"\x1bsf/xrpl/type/v1/offer.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xa8\x03\n" +
 	"\vOfferCreate\x126\n" +
 	"\n" +
 	"taker_gets\x18\x01 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\ttakerGets\x126\n" +
@@ -181,7 +226,14 @@ const file_sf_xrpl_type_v1_offer_proto_rawDesc = "" +
 	"expiration\x12%\n" +
 	"\x0eoffer_sequence\x18\x04 \x01(\rR\rofferSequence\x12\x1b\n" +
 	"\tdomain_id\x18\x05 \x01(\tR\bdomainId\x12\x14\n" +
-	"\x05flags\x18\x06 \x01(\rR\x05flags\"4\n" +
+	"\x05flags\x18\x06 \x01(\rR\x05flags\x12\x1d\n" +
+	"\n" +
+	"is_passive\x18\a \x01(\bR\tisPassive\x123\n" +
+	"\x16is_immediate_or_cancel\x18\b \x01(\bR\x13isImmediateOrCancel\x12%\n" +
+	"\x0fis_fill_or_kill\x18\t \x01(\bR\fisFillOrKill\x12\x17\n" +
+	"\ais_sell\x18\n" +
+	" \x01(\bR\x06isSell\x12\x1b\n" +
+	"\tis_hybrid\x18\v \x01(\bR\bisHybrid\"4\n" +
 	"\vOfferCancel\x12%\n" +
 	"\x0eoffer_sequence\x18\x01 \x01(\rR\rofferSequenceBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
@@ -122,7 +122,12 @@ type PriceData struct {
 	// (Optional) Asset price
 	AssetPrice uint64 `protobuf:"varint,3,opt,name=asset_price,json=assetPrice,proto3" json:"asset_price,omitempty"`
 	// (Optional) Scale factor
-	Scale         uint32 `protobuf:"varint,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	Scale uint32 `protobuf:"varint,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Derived: effective price asset_price * 10^-scale as a plain decimal string
+	// (e.g., "1.2345" for asset_price 12345 and scale 4), empty for a removal
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// Derived: true when asset_price is absent, removing the pair from the oracle
+	IsRemoval     bool `protobuf:"varint,6,opt,name=is_removal,json=isRemoval,proto3" json:"is_removal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -185,6 +190,20 @@ func (x *PriceData) GetScale() uint32 {
 	return 0
 }
 
+func (x *PriceData) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PriceData) GetIsRemoval() bool {
+	if x != nil {
+		return x.IsRemoval
+	}
+	return false
+}
+
 // OracleDelete - Deletes a price oracle
 // Reference: https://xrpl.org/oracledelete.html
 type OracleDelete struct {
@@ -244,7 +263,7 @@ const file_sf_xrpl_type_v1_oracle_proto_rawDesc = "" +
 	"\vasset_class\x18\x04 \x01(\tR\n" +
 	"assetClass\x12(\n" +
 	"\x10last_update_time\x18\x05 \x01(\rR\x0elastUpdateTime\x12F\n" +
-	"\x11price_data_series\x18\x06 \x03(\v2\x1a.sf.xrpl.type.v1.PriceDataR\x0fpriceDataSeries\"\x82\x01\n" +
+	"\x11price_data_series\x18\x06 \x03(\v2\x1a.sf.xrpl.type.v1.PriceDataR\x0fpriceDataSeries\"\xb7\x01\n" +
 	"\tPriceData\x12\x1d\n" +
 	"\n" +
 	"base_asset\x18\x01 \x01(\tR\tbaseAsset\x12\x1f\n" +
@@ -252,7 +271,10 @@ const file_sf_xrpl_type_v1_oracle_proto_rawDesc = "" +
 	"quoteAsset\x12\x1f\n" +
 	"\vasset_price\x18\x03 \x01(\x04R\n" +
 	"assetPrice\x12\x14\n" +
-	"\x05scale\x18\x04 \x01(\rR\x05scale\"<\n" +
+	"\x05scale\x18\x04 \x01(\rR\x05scale\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x1d\n" +
+	"\n" +
+	"is_removal\x18\x06 \x01(\bR\tisRemoval\"<\n" +
 	"\fOracleDelete\x12,\n" +
 	"\x12oracle_document_id\x18\x01 \x01(\rR\x10oracleDocumentIdBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
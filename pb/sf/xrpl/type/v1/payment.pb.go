@@ -51,6 +51,12 @@ type Payment struct {
 	// tfPartialPayment = 131072 (0x00020000) - Allow partial payment
 	// tfLimitQuality = 262144 (0x00040000) - Only use paths with good quality
 	Flags uint32 `protobuf:"varint,11,opt,name=flags,proto3" json:"flags,omitempty"`
+	// True when the Paths field is present, even if empty
+	HasPaths bool `protobuf:"varint,12,opt,name=has_paths,json=hasPaths,proto3" json:"has_paths,omitempty"`
+	// Derived: true for cross-currency payments (send_max asset differs from
+	// amount asset) that let rippled try the default path, alongside any explicit
+	// paths: those without tfNoRippleDirect
+	UsesDefaultPath bool `protobuf:"varint,13,opt,name=uses_default_path,json=usesDefaultPath,proto3" json:"uses_default_path,omitempty"`
 	// --- From metadata ---
 	// Actual amount delivered (may differ from amount for partial payments)
 	DeliveredAmount *Amount `protobuf:"bytes,20,opt,name=delivered_amount,json=deliveredAmount,proto3" json:"delivered_amount,omitempty"`
@@ -165,6 +171,20 @@ func (x *Payment) GetFlags() uint32 {
 	return 0
 }
 
+func (x *Payment) GetHasPaths() bool {
+	if x != nil {
+		return x.HasPaths
+	}
+	return false
+}
+
+func (x *Payment) GetUsesDefaultPath() bool {
+	if x != nil {
+		return x.UsesDefaultPath
+	}
+	return false
+}
+
 func (x *Payment) GetDeliveredAmount() *Amount {
 	if x != nil {
 		return x.DeliveredAmount
@@ -176,7 +196,7 @@ var File_sf_xrpl_type_v1_payment_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_payment_proto_rawDesc = "" +
 	"\n" +
-	"\x1dsf/xrpl/type/v1/payment.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\x97\x04\n" +
+	"\x1dsf/xrpl/type/v1/payment.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xe0\x04\n" +
 	"\aPayment\x12 \n" +
 	"\vdestination\x18\x01 \x01(\tR\vdestination\x12/\n" +
 	"\x06amount\x18\x02 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x128\n" +
@@ -192,7 +212,9 @@ const file_sf_xrpl_type_v1_payment_proto_rawDesc = "" +
 	"\x0ecredential_ids\x18\t \x03(\tR\rcredentialIds\x12\x1b\n" +
 	"\tdomain_id\x18\n" +
 	" \x01(\tR\bdomainId\x12\x14\n" +
-	"\x05flags\x18\v \x01(\rR\x05flags\x12B\n" +
+	"\x05flags\x18\v \x01(\rR\x05flags\x12\x1b\n" +
+	"\thas_paths\x18\f \x01(\bR\bhasPaths\x12*\n" +
+	"\x11uses_default_path\x18\r \x01(\bR\x0fusesDefaultPath\x12B\n" +
 	"\x10delivered_amount\x18\x14 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x0fdeliveredAmountBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
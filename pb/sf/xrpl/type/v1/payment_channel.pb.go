@@ -38,8 +38,12 @@ type PaymentChannelCreate struct {
 	CancelAfter uint32 `protobuf:"varint,5,opt,name=cancel_after,json=cancelAfter,proto3" json:"cancel_after,omitempty"`
 	// (Optional) Destination tag
 	DestinationTag uint32 `protobuf:"varint,6,opt,name=destination_tag,json=destinationTag,proto3" json:"destination_tag,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// (Optional) Source tag, copied from the base transaction
+	SourceTag uint32 `protobuf:"varint,7,opt,name=source_tag,json=sourceTag,proto3" json:"source_tag,omitempty"`
+	// True when the transaction carries a SourceTag (0 is a valid tag)
+	HasSourceTag  bool `protobuf:"varint,8,opt,name=has_source_tag,json=hasSourceTag,proto3" json:"has_source_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaymentChannelCreate) Reset() {
@@ -114,6 +118,20 @@ func (x *PaymentChannelCreate) GetDestinationTag() uint32 {
 	return 0
 }
 
+func (x *PaymentChannelCreate) GetSourceTag() uint32 {
+	if x != nil {
+		return x.SourceTag
+	}
+	return 0
+}
+
+func (x *PaymentChannelCreate) GetHasSourceTag() bool {
+	if x != nil {
+		return x.HasSourceTag
+	}
+	return false
+}
+
 // PaymentChannelFund - Adds XRP to an existing payment channel
 // Reference:
 // https://xrpl.org/docs/references/protocol/transactions/types/paymentchannelfund
@@ -292,7 +310,7 @@ var File_sf_xrpl_type_v1_payment_channel_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_payment_channel_proto_rawDesc = "" +
 	"\n" +
-	"%sf/xrpl/type/v1/payment_channel.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xf7\x01\n" +
+	"%sf/xrpl/type/v1/payment_channel.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\xbc\x02\n" +
 	"\x14PaymentChannelCreate\x12 \n" +
 	"\vdestination\x18\x01 \x01(\tR\vdestination\x12/\n" +
 	"\x06amount\x18\x02 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x12!\n" +
@@ -300,7 +318,10 @@ const file_sf_xrpl_type_v1_payment_channel_proto_rawDesc = "" +
 	"\n" +
 	"public_key\x18\x04 \x01(\tR\tpublicKey\x12!\n" +
 	"\fcancel_after\x18\x05 \x01(\rR\vcancelAfter\x12'\n" +
-	"\x0fdestination_tag\x18\x06 \x01(\rR\x0edestinationTag\"\x7f\n" +
+	"\x0fdestination_tag\x18\x06 \x01(\rR\x0edestinationTag\x12\x1d\n" +
+	"\n" +
+	"source_tag\x18\a \x01(\rR\tsourceTag\x12$\n" +
+	"\x0ehas_source_tag\x18\b \x01(\bR\fhasSourceTag\"\x7f\n" +
 	"\x12PaymentChannelFund\x12\x18\n" +
 	"\achannel\x18\x01 \x01(\tR\achannel\x12/\n" +
 	"\x06amount\x18\x02 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\x06amount\x12\x1e\n" +
@@ -31,6 +31,8 @@ func (m *PaymentChannelCreate) CloneVT() *PaymentChannelCreate {
 	r.PublicKey = m.PublicKey
 	r.CancelAfter = m.CancelAfter
 	r.DestinationTag = m.DestinationTag
+	r.SourceTag = m.SourceTag
+	r.HasSourceTag = m.HasSourceTag
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -112,6 +114,12 @@ func (this *PaymentChannelCreate) EqualVT(that *PaymentChannelCreate) bool {
 	if this.DestinationTag != that.DestinationTag {
 		return false
 	}
+	if this.SourceTag != that.SourceTag {
+		return false
+	}
+	if this.HasSourceTag != that.HasSourceTag {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -220,6 +228,21 @@ func (m *PaymentChannelCreate) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.HasSourceTag {
+		i--
+		if m.HasSourceTag {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SourceTag != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SourceTag))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DestinationTag != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.DestinationTag))
 		i--
@@ -435,6 +458,21 @@ func (m *PaymentChannelCreate) MarshalToSizedBufferVTStrict(dAtA []byte) (int, e
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.HasSourceTag {
+		i--
+		if m.HasSourceTag {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SourceTag != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SourceTag))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DestinationTag != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.DestinationTag))
 		i--
@@ -647,6 +685,12 @@ func (m *PaymentChannelCreate) SizeVT() (n int) {
 	if m.DestinationTag != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.DestinationTag))
 	}
+	if m.SourceTag != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.SourceTag))
+	}
+	if m.HasSourceTag {
+		n += 2
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -897,6 +941,45 @@ func (m *PaymentChannelCreate) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTag", wireType)
+			}
+			m.SourceTag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceTag |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasSourceTag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasSourceTag = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -1521,6 +1604,45 @@ func (m *PaymentChannelCreate) UnmarshalVTUnsafe(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTag", wireType)
+			}
+			m.SourceTag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceTag |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasSourceTag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasSourceTag = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
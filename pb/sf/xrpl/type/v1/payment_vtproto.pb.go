@@ -34,6 +34,8 @@ func (m *Payment) CloneVT() *Payment {
 	r.DestinationTag = m.DestinationTag
 	r.DomainId = m.DomainId
 	r.Flags = m.Flags
+	r.HasPaths = m.HasPaths
+	r.UsesDefaultPath = m.UsesDefaultPath
 	r.DeliveredAmount = m.DeliveredAmount.CloneVT()
 	if rhs := m.Paths; rhs != nil {
 		tmpContainer := make([]*Path, len(rhs))
@@ -117,6 +119,12 @@ func (this *Payment) EqualVT(that *Payment) bool {
 	if this.Flags != that.Flags {
 		return false
 	}
+	if this.HasPaths != that.HasPaths {
+		return false
+	}
+	if this.UsesDefaultPath != that.UsesDefaultPath {
+		return false
+	}
 	if !this.DeliveredAmount.EqualVT(that.DeliveredAmount) {
 		return false
 	}
@@ -172,6 +180,26 @@ func (m *Payment) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.UsesDefaultPath {
+		i--
+		if m.UsesDefaultPath {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.HasPaths {
+		i--
+		if m.HasPaths {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Flags != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Flags))
 		i--
@@ -309,6 +337,26 @@ func (m *Payment) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.UsesDefaultPath {
+		i--
+		if m.UsesDefaultPath {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.HasPaths {
+		i--
+		if m.HasPaths {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Flags != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Flags))
 		i--
@@ -456,6 +504,12 @@ func (m *Payment) SizeVT() (n int) {
 	if m.Flags != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.Flags))
 	}
+	if m.HasPaths {
+		n += 2
+	}
+	if m.UsesDefaultPath {
+		n += 2
+	}
 	if m.DeliveredAmount != nil {
 		l = m.DeliveredAmount.SizeVT()
 		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
@@ -837,6 +891,46 @@ func (m *Payment) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPaths", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPaths = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsesDefaultPath", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UsesDefaultPath = bool(v != 0)
 		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAmount", wireType)
@@ -1284,6 +1378,46 @@ func (m *Payment) UnmarshalVTUnsafe(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPaths", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPaths = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsesDefaultPath", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UsesDefaultPath = bool(v != 0)
 		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAmount", wireType)
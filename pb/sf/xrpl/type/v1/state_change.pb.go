@@ -0,0 +1,513 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sf/xrpl/type/v1/state_change.proto
+
+package pbxrpl
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ModType - Kind of change applied to a ledger entry
+type ModType int32
+
+const (
+	ModType_MOD_TYPE_UNSPECIFIED ModType = 0
+	// CreatedNode
+	ModType_MOD_TYPE_CREATED ModType = 1
+	// ModifiedNode
+	ModType_MOD_TYPE_MODIFIED ModType = 2
+	// DeletedNode
+	ModType_MOD_TYPE_DELETED ModType = 3
+)
+
+// Enum value maps for ModType.
+var (
+	ModType_name = map[int32]string{
+		0: "MOD_TYPE_UNSPECIFIED",
+		1: "MOD_TYPE_CREATED",
+		2: "MOD_TYPE_MODIFIED",
+		3: "MOD_TYPE_DELETED",
+	}
+	ModType_value = map[string]int32{
+		"MOD_TYPE_UNSPECIFIED": 0,
+		"MOD_TYPE_CREATED":     1,
+		"MOD_TYPE_MODIFIED":    2,
+		"MOD_TYPE_DELETED":     3,
+	}
+)
+
+func (x ModType) Enum() *ModType {
+	p := new(ModType)
+	*p = x
+	return p
+}
+
+func (x ModType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_state_change_proto_enumTypes[0].Descriptor()
+}
+
+func (ModType) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_state_change_proto_enumTypes[0]
+}
+
+func (x ModType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModType.Descriptor instead.
+func (ModType) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_state_change_proto_rawDescGZIP(), []int{0}
+}
+
+// EntryType - Ledger entry types
+// Reference: https://xrpl.org/docs/references/protocol/ledger-data/ledger-entry-types
+type EntryType int32
+
+const (
+	// Entry type unknown to this schema, see ledger_entry_type
+	EntryType_ENTRY_TYPE_UNSPECIFIED                          EntryType = 0
+	EntryType_ENTRY_TYPE_ACCOUNT_ROOT                         EntryType = 1
+	EntryType_ENTRY_TYPE_AMENDMENTS                           EntryType = 2
+	EntryType_ENTRY_TYPE_AMM                                  EntryType = 3
+	EntryType_ENTRY_TYPE_BRIDGE                               EntryType = 4
+	EntryType_ENTRY_TYPE_CHECK                                EntryType = 5
+	EntryType_ENTRY_TYPE_CREDENTIAL                           EntryType = 6
+	EntryType_ENTRY_TYPE_DELEGATE                             EntryType = 7
+	EntryType_ENTRY_TYPE_DEPOSIT_PREAUTH                      EntryType = 8
+	EntryType_ENTRY_TYPE_DID                                  EntryType = 9
+	EntryType_ENTRY_TYPE_DIRECTORY_NODE                       EntryType = 10
+	EntryType_ENTRY_TYPE_ESCROW                               EntryType = 11
+	EntryType_ENTRY_TYPE_FEE_SETTINGS                         EntryType = 12
+	EntryType_ENTRY_TYPE_LEDGER_HASHES                        EntryType = 13
+	EntryType_ENTRY_TYPE_MPTOKEN                              EntryType = 14
+	EntryType_ENTRY_TYPE_MPTOKEN_ISSUANCE                     EntryType = 15
+	EntryType_ENTRY_TYPE_NEGATIVE_UNL                         EntryType = 16
+	EntryType_ENTRY_TYPE_NFTOKEN_OFFER                        EntryType = 17
+	EntryType_ENTRY_TYPE_NFTOKEN_PAGE                         EntryType = 18
+	EntryType_ENTRY_TYPE_OFFER                                EntryType = 19
+	EntryType_ENTRY_TYPE_ORACLE                               EntryType = 20
+	EntryType_ENTRY_TYPE_PAY_CHANNEL                          EntryType = 21
+	EntryType_ENTRY_TYPE_PERMISSIONED_DOMAIN                  EntryType = 22
+	EntryType_ENTRY_TYPE_RIPPLE_STATE                         EntryType = 23
+	EntryType_ENTRY_TYPE_SIGNER_LIST                          EntryType = 24
+	EntryType_ENTRY_TYPE_TICKET                               EntryType = 25
+	EntryType_ENTRY_TYPE_VAULT                                EntryType = 26
+	EntryType_ENTRY_TYPE_XCHAIN_OWNED_CLAIM_ID                EntryType = 27
+	EntryType_ENTRY_TYPE_XCHAIN_OWNED_CREATE_ACCOUNT_CLAIM_ID EntryType = 28
+)
+
+// Enum value maps for EntryType.
+var (
+	EntryType_name = map[int32]string{
+		0:  "ENTRY_TYPE_UNSPECIFIED",
+		1:  "ENTRY_TYPE_ACCOUNT_ROOT",
+		2:  "ENTRY_TYPE_AMENDMENTS",
+		3:  "ENTRY_TYPE_AMM",
+		4:  "ENTRY_TYPE_BRIDGE",
+		5:  "ENTRY_TYPE_CHECK",
+		6:  "ENTRY_TYPE_CREDENTIAL",
+		7:  "ENTRY_TYPE_DELEGATE",
+		8:  "ENTRY_TYPE_DEPOSIT_PREAUTH",
+		9:  "ENTRY_TYPE_DID",
+		10: "ENTRY_TYPE_DIRECTORY_NODE",
+		11: "ENTRY_TYPE_ESCROW",
+		12: "ENTRY_TYPE_FEE_SETTINGS",
+		13: "ENTRY_TYPE_LEDGER_HASHES",
+		14: "ENTRY_TYPE_MPTOKEN",
+		15: "ENTRY_TYPE_MPTOKEN_ISSUANCE",
+		16: "ENTRY_TYPE_NEGATIVE_UNL",
+		17: "ENTRY_TYPE_NFTOKEN_OFFER",
+		18: "ENTRY_TYPE_NFTOKEN_PAGE",
+		19: "ENTRY_TYPE_OFFER",
+		20: "ENTRY_TYPE_ORACLE",
+		21: "ENTRY_TYPE_PAY_CHANNEL",
+		22: "ENTRY_TYPE_PERMISSIONED_DOMAIN",
+		23: "ENTRY_TYPE_RIPPLE_STATE",
+		24: "ENTRY_TYPE_SIGNER_LIST",
+		25: "ENTRY_TYPE_TICKET",
+		26: "ENTRY_TYPE_VAULT",
+		27: "ENTRY_TYPE_XCHAIN_OWNED_CLAIM_ID",
+		28: "ENTRY_TYPE_XCHAIN_OWNED_CREATE_ACCOUNT_CLAIM_ID",
+	}
+	EntryType_value = map[string]int32{
+		"ENTRY_TYPE_UNSPECIFIED":                          0,
+		"ENTRY_TYPE_ACCOUNT_ROOT":                         1,
+		"ENTRY_TYPE_AMENDMENTS":                           2,
+		"ENTRY_TYPE_AMM":                                  3,
+		"ENTRY_TYPE_BRIDGE":                               4,
+		"ENTRY_TYPE_CHECK":                                5,
+		"ENTRY_TYPE_CREDENTIAL":                           6,
+		"ENTRY_TYPE_DELEGATE":                             7,
+		"ENTRY_TYPE_DEPOSIT_PREAUTH":                      8,
+		"ENTRY_TYPE_DID":                                  9,
+		"ENTRY_TYPE_DIRECTORY_NODE":                       10,
+		"ENTRY_TYPE_ESCROW":                               11,
+		"ENTRY_TYPE_FEE_SETTINGS":                         12,
+		"ENTRY_TYPE_LEDGER_HASHES":                        13,
+		"ENTRY_TYPE_MPTOKEN":                              14,
+		"ENTRY_TYPE_MPTOKEN_ISSUANCE":                     15,
+		"ENTRY_TYPE_NEGATIVE_UNL":                         16,
+		"ENTRY_TYPE_NFTOKEN_OFFER":                        17,
+		"ENTRY_TYPE_NFTOKEN_PAGE":                         18,
+		"ENTRY_TYPE_OFFER":                                19,
+		"ENTRY_TYPE_ORACLE":                               20,
+		"ENTRY_TYPE_PAY_CHANNEL":                          21,
+		"ENTRY_TYPE_PERMISSIONED_DOMAIN":                  22,
+		"ENTRY_TYPE_RIPPLE_STATE":                         23,
+		"ENTRY_TYPE_SIGNER_LIST":                          24,
+		"ENTRY_TYPE_TICKET":                               25,
+		"ENTRY_TYPE_VAULT":                                26,
+		"ENTRY_TYPE_XCHAIN_OWNED_CLAIM_ID":                27,
+		"ENTRY_TYPE_XCHAIN_OWNED_CREATE_ACCOUNT_CLAIM_ID": 28,
+	}
+)
+
+func (x EntryType) Enum() *EntryType {
+	p := new(EntryType)
+	*p = x
+	return p
+}
+
+func (x EntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_state_change_proto_enumTypes[1].Descriptor()
+}
+
+func (EntryType) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_state_change_proto_enumTypes[1]
+}
+
+func (x EntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntryType.Descriptor instead.
+func (EntryType) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_state_change_proto_rawDescGZIP(), []int{1}
+}
+
+// StateChange - A ledger entry created, modified or deleted by a transaction
+// Decoded from the AffectedNodes array of the transaction metadata
+type StateChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How the entry was changed (CreatedNode, ModifiedNode or DeletedNode)
+	ModType ModType `protobuf:"varint,1,opt,name=mod_type,json=modType,proto3,enum=sf.xrpl.type.v1.ModType" json:"mod_type,omitempty"`
+	// Ledger entry type, ENTRY_TYPE_UNSPECIFIED for types unknown to this schema
+	EntryType EntryType `protobuf:"varint,2,opt,name=entry_type,json=entryType,proto3,enum=sf.xrpl.type.v1.EntryType" json:"entry_type,omitempty"`
+	// Ledger entry type as it appears in the metadata (e.g., "RippleState")
+	// Future-proof: set even when entry_type is unspecified
+	LedgerEntryType string `protobuf:"bytes,3,opt,name=ledger_entry_type,json=ledgerEntryType,proto3" json:"ledger_entry_type,omitempty"`
+	// Ledger entry ID (LedgerIndex, 32 bytes)
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// (CreatedNode) Fields of the new entry
+	NewFields *structpb.Struct `protobuf:"bytes,5,opt,name=new_fields,json=newFields,proto3" json:"new_fields,omitempty"`
+	// (ModifiedNode, DeletedNode) Fields of the entry after the transaction
+	FinalFields *structpb.Struct `protobuf:"bytes,6,opt,name=final_fields,json=finalFields,proto3" json:"final_fields,omitempty"`
+	// (ModifiedNode, DeletedNode) Previous values of the fields the transaction changed
+	PreviousFields *structpb.Struct `protobuf:"bytes,7,opt,name=previous_fields,json=previousFields,proto3" json:"previous_fields,omitempty"`
+	// Derived: (MPToken, MPTokenIssuance) MPT holder balance or outstanding supply
+	MptBalance    *MPTBalance `protobuf:"bytes,8,opt,name=mpt_balance,json=mptBalance,proto3" json:"mpt_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateChange) Reset() {
+	*x = StateChange{}
+	mi := &file_sf_xrpl_type_v1_state_change_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChange) ProtoMessage() {}
+
+func (x *StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_state_change_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChange.ProtoReflect.Descriptor instead.
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_state_change_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateChange) GetModType() ModType {
+	if x != nil {
+		return x.ModType
+	}
+	return ModType_MOD_TYPE_UNSPECIFIED
+}
+
+func (x *StateChange) GetEntryType() EntryType {
+	if x != nil {
+		return x.EntryType
+	}
+	return EntryType_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *StateChange) GetLedgerEntryType() string {
+	if x != nil {
+		return x.LedgerEntryType
+	}
+	return ""
+}
+
+func (x *StateChange) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StateChange) GetNewFields() *structpb.Struct {
+	if x != nil {
+		return x.NewFields
+	}
+	return nil
+}
+
+func (x *StateChange) GetFinalFields() *structpb.Struct {
+	if x != nil {
+		return x.FinalFields
+	}
+	return nil
+}
+
+func (x *StateChange) GetPreviousFields() *structpb.Struct {
+	if x != nil {
+		return x.PreviousFields
+	}
+	return nil
+}
+
+func (x *StateChange) GetMptBalance() *MPTBalance {
+	if x != nil {
+		return x.MptBalance
+	}
+	return nil
+}
+
+// MPTBalance - MPT amount held by an MPToken entry, or outstanding for an MPTokenIssuance
+type MPTBalance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MPTokenIssuanceID of the token (48 hex chars)
+	MptIssuanceId string `protobuf:"bytes,1,opt,name=mpt_issuance_id,json=mptIssuanceId,proto3" json:"mpt_issuance_id,omitempty"`
+	// (MPToken) Holder account, empty for an MPTokenIssuance
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// Holder MPTAmount, or issuance OutstandingAmount, after the transaction
+	FinalAmount uint64 `protobuf:"varint,3,opt,name=final_amount,json=finalAmount,proto3" json:"final_amount,omitempty"`
+	// Amount before the transaction, 0 for a created entry and final_amount when unchanged
+	PreviousAmount uint64 `protobuf:"varint,4,opt,name=previous_amount,json=previousAmount,proto3" json:"previous_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MPTBalance) Reset() {
+	*x = MPTBalance{}
+	mi := &file_sf_xrpl_type_v1_state_change_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MPTBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MPTBalance) ProtoMessage() {}
+
+func (x *MPTBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_xrpl_type_v1_state_change_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MPTBalance.ProtoReflect.Descriptor instead.
+func (*MPTBalance) Descriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_state_change_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MPTBalance) GetMptIssuanceId() string {
+	if x != nil {
+		return x.MptIssuanceId
+	}
+	return ""
+}
+
+func (x *MPTBalance) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *MPTBalance) GetFinalAmount() uint64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+func (x *MPTBalance) GetPreviousAmount() uint64 {
+	if x != nil {
+		return x.PreviousAmount
+	}
+	return 0
+}
+
+var File_sf_xrpl_type_v1_state_change_proto protoreflect.FileDescriptor
+
+const file_sf_xrpl_type_v1_state_change_proto_rawDesc = "" +
+	"\n" +
+	"\"sf/xrpl/type/v1/state_change.proto\x12\x0fsf.xrpl.type.v1\x1a\x1cgoogle/protobuf/struct.proto\"\xaf\x03\n" +
+	"\vStateChange\x123\n" +
+	"\bmod_type\x18\x01 \x01(\x0e2\x18.sf.xrpl.type.v1.ModTypeR\amodType\x129\n" +
+	"\n" +
+	"entry_type\x18\x02 \x01(\x0e2\x1a.sf.xrpl.type.v1.EntryTypeR\tentryType\x12*\n" +
+	"\x11ledger_entry_type\x18\x03 \x01(\tR\x0fledgerEntryType\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\fR\x03key\x126\n" +
+	"\n" +
+	"new_fields\x18\x05 \x01(\v2\x17.google.protobuf.StructR\tnewFields\x12:\n" +
+	"\ffinal_fields\x18\x06 \x01(\v2\x17.google.protobuf.StructR\vfinalFields\x12@\n" +
+	"\x0fprevious_fields\x18\a \x01(\v2\x17.google.protobuf.StructR\x0epreviousFields\x12<\n" +
+	"\vmpt_balance\x18\b \x01(\v2\x1b.sf.xrpl.type.v1.MPTBalanceR\n" +
+	"mptBalance\"\x98\x01\n" +
+	"\n" +
+	"MPTBalance\x12&\n" +
+	"\x0fmpt_issuance_id\x18\x01 \x01(\tR\rmptIssuanceId\x12\x16\n" +
+	"\x06holder\x18\x02 \x01(\tR\x06holder\x12!\n" +
+	"\ffinal_amount\x18\x03 \x01(\x04R\vfinalAmount\x12'\n" +
+	"\x0fprevious_amount\x18\x04 \x01(\x04R\x0epreviousAmount*f\n" +
+	"\aModType\x12\x18\n" +
+	"\x14MOD_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10MOD_TYPE_CREATED\x10\x01\x12\x15\n" +
+	"\x11MOD_TYPE_MODIFIED\x10\x02\x12\x14\n" +
+	"\x10MOD_TYPE_DELETED\x10\x03*\xb8\x06\n" +
+	"\tEntryType\x12\x1a\n" +
+	"\x16ENTRY_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ENTRY_TYPE_ACCOUNT_ROOT\x10\x01\x12\x19\n" +
+	"\x15ENTRY_TYPE_AMENDMENTS\x10\x02\x12\x12\n" +
+	"\x0eENTRY_TYPE_AMM\x10\x03\x12\x15\n" +
+	"\x11ENTRY_TYPE_BRIDGE\x10\x04\x12\x14\n" +
+	"\x10ENTRY_TYPE_CHECK\x10\x05\x12\x19\n" +
+	"\x15ENTRY_TYPE_CREDENTIAL\x10\x06\x12\x17\n" +
+	"\x13ENTRY_TYPE_DELEGATE\x10\a\x12\x1e\n" +
+	"\x1aENTRY_TYPE_DEPOSIT_PREAUTH\x10\b\x12\x12\n" +
+	"\x0eENTRY_TYPE_DID\x10\t\x12\x1d\n" +
+	"\x19ENTRY_TYPE_DIRECTORY_NODE\x10\n" +
+	"\x12\x15\n" +
+	"\x11ENTRY_TYPE_ESCROW\x10\v\x12\x1b\n" +
+	"\x17ENTRY_TYPE_FEE_SETTINGS\x10\f\x12\x1c\n" +
+	"\x18ENTRY_TYPE_LEDGER_HASHES\x10\r\x12\x16\n" +
+	"\x12ENTRY_TYPE_MPTOKEN\x10\x0e\x12\x1f\n" +
+	"\x1bENTRY_TYPE_MPTOKEN_ISSUANCE\x10\x0f\x12\x1b\n" +
+	"\x17ENTRY_TYPE_NEGATIVE_UNL\x10\x10\x12\x1c\n" +
+	"\x18ENTRY_TYPE_NFTOKEN_OFFER\x10\x11\x12\x1b\n" +
+	"\x17ENTRY_TYPE_NFTOKEN_PAGE\x10\x12\x12\x14\n" +
+	"\x10ENTRY_TYPE_OFFER\x10\x13\x12\x15\n" +
+	"\x11ENTRY_TYPE_ORACLE\x10\x14\x12\x1a\n" +
+	"\x16ENTRY_TYPE_PAY_CHANNEL\x10\x15\x12\"\n" +
+	"\x1eENTRY_TYPE_PERMISSIONED_DOMAIN\x10\x16\x12\x1b\n" +
+	"\x17ENTRY_TYPE_RIPPLE_STATE\x10\x17\x12\x1a\n" +
+	"\x16ENTRY_TYPE_SIGNER_LIST\x10\x18\x12\x15\n" +
+	"\x11ENTRY_TYPE_TICKET\x10\x19\x12\x14\n" +
+	"\x10ENTRY_TYPE_VAULT\x10\x1a\x12$\n" +
+	" ENTRY_TYPE_XCHAIN_OWNED_CLAIM_ID\x10\x1b\x123\n" +
+	"/ENTRY_TYPE_XCHAIN_OWNED_CREATE_ACCOUNT_CLAIM_ID\x10\x1cBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+
+var (
+	file_sf_xrpl_type_v1_state_change_proto_rawDescOnce sync.Once
+	file_sf_xrpl_type_v1_state_change_proto_rawDescData []byte
+)
+
+func file_sf_xrpl_type_v1_state_change_proto_rawDescGZIP() []byte {
+	file_sf_xrpl_type_v1_state_change_proto_rawDescOnce.Do(func() {
+		file_sf_xrpl_type_v1_state_change_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_state_change_proto_rawDesc), len(file_sf_xrpl_type_v1_state_change_proto_rawDesc)))
+	})
+	return file_sf_xrpl_type_v1_state_change_proto_rawDescData
+}
+
+var file_sf_xrpl_type_v1_state_change_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_sf_xrpl_type_v1_state_change_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_xrpl_type_v1_state_change_proto_goTypes = []any{
+	(ModType)(0),            // 0: sf.xrpl.type.v1.ModType
+	(EntryType)(0),          // 1: sf.xrpl.type.v1.EntryType
+	(*StateChange)(nil),     // 2: sf.xrpl.type.v1.StateChange
+	(*MPTBalance)(nil),      // 3: sf.xrpl.type.v1.MPTBalance
+	(*structpb.Struct)(nil), // 4: google.protobuf.Struct
+}
+var file_sf_xrpl_type_v1_state_change_proto_depIdxs = []int32{
+	0, // 0: sf.xrpl.type.v1.StateChange.mod_type:type_name -> sf.xrpl.type.v1.ModType
+	1, // 1: sf.xrpl.type.v1.StateChange.entry_type:type_name -> sf.xrpl.type.v1.EntryType
+	4, // 2: sf.xrpl.type.v1.StateChange.new_fields:type_name -> google.protobuf.Struct
+	4, // 3: sf.xrpl.type.v1.StateChange.final_fields:type_name -> google.protobuf.Struct
+	4, // 4: sf.xrpl.type.v1.StateChange.previous_fields:type_name -> google.protobuf.Struct
+	3, // 5: sf.xrpl.type.v1.StateChange.mpt_balance:type_name -> sf.xrpl.type.v1.MPTBalance
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_sf_xrpl_type_v1_state_change_proto_init() }
+func file_sf_xrpl_type_v1_state_change_proto_init() {
+	if File_sf_xrpl_type_v1_state_change_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_state_change_proto_rawDesc), len(file_sf_xrpl_type_v1_state_change_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_xrpl_type_v1_state_change_proto_goTypes,
+		DependencyIndexes: file_sf_xrpl_type_v1_state_change_proto_depIdxs,
+		EnumInfos:         file_sf_xrpl_type_v1_state_change_proto_enumTypes,
+		MessageInfos:      file_sf_xrpl_type_v1_state_change_proto_msgTypes,
+	}.Build()
+	File_sf_xrpl_type_v1_state_change_proto = out.File
+	file_sf_xrpl_type_v1_state_change_proto_goTypes = nil
+	file_sf_xrpl_type_v1_state_change_proto_depIdxs = nil
+}
@@ -28,7 +28,9 @@ type EnableAmendment struct {
 	// Ledger sequence when this applies
 	LedgerSequence uint32 `protobuf:"varint,1,opt,name=ledger_sequence,json=ledgerSequence,proto3" json:"ledger_sequence,omitempty"`
 	// Amendment hash (64 hex chars)
-	Amendment     string `protobuf:"bytes,2,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	Amendment string `protobuf:"bytes,2,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	// Derived: human-readable amendment name (e.g., "AMM"), the hex ID when unknown
+	AmendmentName string `protobuf:"bytes,3,opt,name=amendment_name,json=amendmentName,proto3" json:"amendment_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -77,6 +79,13 @@ func (x *EnableAmendment) GetAmendment() string {
 	return ""
 }
 
+func (x *EnableAmendment) GetAmendmentName() string {
+	if x != nil {
+		return x.AmendmentName
+	}
+	return ""
+}
+
 // SetFee - System transaction to update network fees
 // Reference: https://xrpl.org/setfee.html
 type SetFee struct {
@@ -332,10 +341,11 @@ var File_sf_xrpl_type_v1_system_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_system_proto_rawDesc = "" +
 	"\n" +
-	"\x1csf/xrpl/type/v1/system.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"X\n" +
+	"\x1csf/xrpl/type/v1/system.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\x7f\n" +
 	"\x0fEnableAmendment\x12'\n" +
 	"\x0fledger_sequence\x18\x01 \x01(\rR\x0eledgerSequence\x12\x1c\n" +
-	"\tamendment\x18\x02 \x01(\tR\tamendment\"\xaa\x04\n" +
+	"\tamendment\x18\x02 \x01(\tR\tamendment\x12%\n" +
+	"\x0eamendment_name\x18\x03 \x01(\tR\ramendmentName\"\xaa\x04\n" +
 	"\x06SetFee\x12'\n" +
 	"\x0fledger_sequence\x18\x01 \x01(\rR\x0eledgerSequence\x12\x19\n" +
 	"\bbase_fee\x18\x02 \x01(\x04R\abaseFee\x12.\n" +
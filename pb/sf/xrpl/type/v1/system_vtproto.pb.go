@@ -27,6 +27,7 @@ func (m *EnableAmendment) CloneVT() *EnableAmendment {
 	r := new(EnableAmendment)
 	r.LedgerSequence = m.LedgerSequence
 	r.Amendment = m.Amendment
+	r.AmendmentName = m.AmendmentName
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -114,6 +115,9 @@ func (this *EnableAmendment) EqualVT(that *EnableAmendment) bool {
 	if this.Amendment != that.Amendment {
 		return false
 	}
+	if this.AmendmentName != that.AmendmentName {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -250,6 +254,13 @@ func (m *EnableAmendment) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.AmendmentName) > 0 {
+		i -= len(m.AmendmentName)
+		copy(dAtA[i:], m.AmendmentName)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.AmendmentName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amendment) > 0 {
 		i -= len(m.Amendment)
 		copy(dAtA[i:], m.Amendment)
@@ -498,6 +509,13 @@ func (m *EnableAmendment) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.AmendmentName) > 0 {
+		i -= len(m.AmendmentName)
+		copy(dAtA[i:], m.AmendmentName)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.AmendmentName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amendment) > 0 {
 		i -= len(m.Amendment)
 		copy(dAtA[i:], m.Amendment)
@@ -729,6 +747,10 @@ func (m *EnableAmendment) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	l = len(m.AmendmentName)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -896,6 +918,38 @@ func (m *EnableAmendment) UnmarshalVT(dAtA []byte) error {
 			}
 			m.Amendment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendmentName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmendmentName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -1537,6 +1591,42 @@ func (m *EnableAmendment) UnmarshalVTUnsafe(dAtA []byte) error {
 			}
 			m.Amendment = stringValue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendmentName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.AmendmentName = stringValue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
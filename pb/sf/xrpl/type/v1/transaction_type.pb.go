@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sf/xrpl/type/v1/transaction_type.proto
+
+package pbxrpl
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransactionType - Transaction types, the typed counterpart of Transaction.tx_type
+// Reference: https://xrpl.org/docs/references/protocol/transactions/types
+type TransactionType int32
+
+const (
+	// Transaction type unknown to this schema, see tx_type
+	TransactionType_TRANSACTION_TYPE_UNKNOWN                               TransactionType = 0
+	TransactionType_TRANSACTION_TYPE_PAYMENT                               TransactionType = 1
+	TransactionType_TRANSACTION_TYPE_OFFER_CREATE                          TransactionType = 2
+	TransactionType_TRANSACTION_TYPE_OFFER_CANCEL                          TransactionType = 3
+	TransactionType_TRANSACTION_TYPE_TRUST_SET                             TransactionType = 4
+	TransactionType_TRANSACTION_TYPE_ACCOUNT_SET                           TransactionType = 5
+	TransactionType_TRANSACTION_TYPE_ACCOUNT_DELETE                        TransactionType = 6
+	TransactionType_TRANSACTION_TYPE_SET_REGULAR_KEY                       TransactionType = 7
+	TransactionType_TRANSACTION_TYPE_SIGNER_LIST_SET                       TransactionType = 8
+	TransactionType_TRANSACTION_TYPE_ESCROW_CREATE                         TransactionType = 9
+	TransactionType_TRANSACTION_TYPE_ESCROW_FINISH                         TransactionType = 10
+	TransactionType_TRANSACTION_TYPE_ESCROW_CANCEL                         TransactionType = 11
+	TransactionType_TRANSACTION_TYPE_PAYMENT_CHANNEL_CREATE                TransactionType = 12
+	TransactionType_TRANSACTION_TYPE_PAYMENT_CHANNEL_FUND                  TransactionType = 13
+	TransactionType_TRANSACTION_TYPE_PAYMENT_CHANNEL_CLAIM                 TransactionType = 14
+	TransactionType_TRANSACTION_TYPE_CHECK_CREATE                          TransactionType = 15
+	TransactionType_TRANSACTION_TYPE_CHECK_CASH                            TransactionType = 16
+	TransactionType_TRANSACTION_TYPE_CHECK_CANCEL                          TransactionType = 17
+	TransactionType_TRANSACTION_TYPE_DEPOSIT_PREAUTH                       TransactionType = 18
+	TransactionType_TRANSACTION_TYPE_TICKET_CREATE                         TransactionType = 19
+	TransactionType_TRANSACTION_TYPE_NFTOKEN_MINT                          TransactionType = 20
+	TransactionType_TRANSACTION_TYPE_NFTOKEN_BURN                          TransactionType = 21
+	TransactionType_TRANSACTION_TYPE_NFTOKEN_CREATE_OFFER                  TransactionType = 22
+	TransactionType_TRANSACTION_TYPE_NFTOKEN_CANCEL_OFFER                  TransactionType = 23
+	TransactionType_TRANSACTION_TYPE_NFTOKEN_ACCEPT_OFFER                  TransactionType = 24
+	TransactionType_TRANSACTION_TYPE_CLAWBACK                              TransactionType = 25
+	TransactionType_TRANSACTION_TYPE_AMM_CREATE                            TransactionType = 26
+	TransactionType_TRANSACTION_TYPE_AMM_DEPOSIT                           TransactionType = 27
+	TransactionType_TRANSACTION_TYPE_AMM_WITHDRAW                          TransactionType = 28
+	TransactionType_TRANSACTION_TYPE_AMM_VOTE                              TransactionType = 29
+	TransactionType_TRANSACTION_TYPE_AMM_BID                               TransactionType = 30
+	TransactionType_TRANSACTION_TYPE_AMM_DELETE                            TransactionType = 31
+	TransactionType_TRANSACTION_TYPE_AMM_CLAWBACK                          TransactionType = 32
+	TransactionType_TRANSACTION_TYPE_DID_SET                               TransactionType = 33
+	TransactionType_TRANSACTION_TYPE_DID_DELETE                            TransactionType = 34
+	TransactionType_TRANSACTION_TYPE_ORACLE_SET                            TransactionType = 35
+	TransactionType_TRANSACTION_TYPE_ORACLE_DELETE                         TransactionType = 36
+	TransactionType_TRANSACTION_TYPE_MPTOKEN_ISSUANCE_CREATE               TransactionType = 37
+	TransactionType_TRANSACTION_TYPE_MPTOKEN_ISSUANCE_DESTROY              TransactionType = 38
+	TransactionType_TRANSACTION_TYPE_MPTOKEN_ISSUANCE_SET                  TransactionType = 39
+	TransactionType_TRANSACTION_TYPE_MPTOKEN_AUTHORIZE                     TransactionType = 40
+	TransactionType_TRANSACTION_TYPE_CREDENTIAL_CREATE                     TransactionType = 41
+	TransactionType_TRANSACTION_TYPE_CREDENTIAL_ACCEPT                     TransactionType = 42
+	TransactionType_TRANSACTION_TYPE_CREDENTIAL_DELETE                     TransactionType = 43
+	TransactionType_TRANSACTION_TYPE_PERMISSIONED_DOMAIN_SET               TransactionType = 44
+	TransactionType_TRANSACTION_TYPE_PERMISSIONED_DOMAIN_DELETE            TransactionType = 45
+	TransactionType_TRANSACTION_TYPE_DELEGATE_SET                          TransactionType = 46
+	TransactionType_TRANSACTION_TYPE_BATCH                                 TransactionType = 47
+	TransactionType_TRANSACTION_TYPE_ENABLE_AMENDMENT                      TransactionType = 48
+	TransactionType_TRANSACTION_TYPE_SET_FEE                               TransactionType = 49
+	TransactionType_TRANSACTION_TYPE_UNL_MODIFY                            TransactionType = 50
+	TransactionType_TRANSACTION_TYPE_NFTOKEN_MODIFY                        TransactionType = 51
+	TransactionType_TRANSACTION_TYPE_LEDGER_STATE_FIX                      TransactionType = 52
+	TransactionType_TRANSACTION_TYPE_XCHAIN_CREATE_BRIDGE                  TransactionType = 53
+	TransactionType_TRANSACTION_TYPE_XCHAIN_MODIFY_BRIDGE                  TransactionType = 54
+	TransactionType_TRANSACTION_TYPE_XCHAIN_CREATE_CLAIM_ID                TransactionType = 55
+	TransactionType_TRANSACTION_TYPE_XCHAIN_COMMIT                         TransactionType = 56
+	TransactionType_TRANSACTION_TYPE_XCHAIN_CLAIM                          TransactionType = 57
+	TransactionType_TRANSACTION_TYPE_XCHAIN_ACCOUNT_CREATE_COMMIT          TransactionType = 58
+	TransactionType_TRANSACTION_TYPE_XCHAIN_ADD_CLAIM_ATTESTATION          TransactionType = 59
+	TransactionType_TRANSACTION_TYPE_XCHAIN_ADD_ACCOUNT_CREATE_ATTESTATION TransactionType = 60
+	TransactionType_TRANSACTION_TYPE_VAULT_CREATE                          TransactionType = 61
+	TransactionType_TRANSACTION_TYPE_VAULT_SET                             TransactionType = 62
+	TransactionType_TRANSACTION_TYPE_VAULT_DELETE                          TransactionType = 63
+	TransactionType_TRANSACTION_TYPE_VAULT_DEPOSIT                         TransactionType = 64
+	TransactionType_TRANSACTION_TYPE_VAULT_WITHDRAW                        TransactionType = 65
+	TransactionType_TRANSACTION_TYPE_VAULT_CLAWBACK                        TransactionType = 66
+)
+
+// Enum value maps for TransactionType.
+var (
+	TransactionType_name = map[int32]string{
+		0:  "TRANSACTION_TYPE_UNKNOWN",
+		1:  "TRANSACTION_TYPE_PAYMENT",
+		2:  "TRANSACTION_TYPE_OFFER_CREATE",
+		3:  "TRANSACTION_TYPE_OFFER_CANCEL",
+		4:  "TRANSACTION_TYPE_TRUST_SET",
+		5:  "TRANSACTION_TYPE_ACCOUNT_SET",
+		6:  "TRANSACTION_TYPE_ACCOUNT_DELETE",
+		7:  "TRANSACTION_TYPE_SET_REGULAR_KEY",
+		8:  "TRANSACTION_TYPE_SIGNER_LIST_SET",
+		9:  "TRANSACTION_TYPE_ESCROW_CREATE",
+		10: "TRANSACTION_TYPE_ESCROW_FINISH",
+		11: "TRANSACTION_TYPE_ESCROW_CANCEL",
+		12: "TRANSACTION_TYPE_PAYMENT_CHANNEL_CREATE",
+		13: "TRANSACTION_TYPE_PAYMENT_CHANNEL_FUND",
+		14: "TRANSACTION_TYPE_PAYMENT_CHANNEL_CLAIM",
+		15: "TRANSACTION_TYPE_CHECK_CREATE",
+		16: "TRANSACTION_TYPE_CHECK_CASH",
+		17: "TRANSACTION_TYPE_CHECK_CANCEL",
+		18: "TRANSACTION_TYPE_DEPOSIT_PREAUTH",
+		19: "TRANSACTION_TYPE_TICKET_CREATE",
+		20: "TRANSACTION_TYPE_NFTOKEN_MINT",
+		21: "TRANSACTION_TYPE_NFTOKEN_BURN",
+		22: "TRANSACTION_TYPE_NFTOKEN_CREATE_OFFER",
+		23: "TRANSACTION_TYPE_NFTOKEN_CANCEL_OFFER",
+		24: "TRANSACTION_TYPE_NFTOKEN_ACCEPT_OFFER",
+		25: "TRANSACTION_TYPE_CLAWBACK",
+		26: "TRANSACTION_TYPE_AMM_CREATE",
+		27: "TRANSACTION_TYPE_AMM_DEPOSIT",
+		28: "TRANSACTION_TYPE_AMM_WITHDRAW",
+		29: "TRANSACTION_TYPE_AMM_VOTE",
+		30: "TRANSACTION_TYPE_AMM_BID",
+		31: "TRANSACTION_TYPE_AMM_DELETE",
+		32: "TRANSACTION_TYPE_AMM_CLAWBACK",
+		33: "TRANSACTION_TYPE_DID_SET",
+		34: "TRANSACTION_TYPE_DID_DELETE",
+		35: "TRANSACTION_TYPE_ORACLE_SET",
+		36: "TRANSACTION_TYPE_ORACLE_DELETE",
+		37: "TRANSACTION_TYPE_MPTOKEN_ISSUANCE_CREATE",
+		38: "TRANSACTION_TYPE_MPTOKEN_ISSUANCE_DESTROY",
+		39: "TRANSACTION_TYPE_MPTOKEN_ISSUANCE_SET",
+		40: "TRANSACTION_TYPE_MPTOKEN_AUTHORIZE",
+		41: "TRANSACTION_TYPE_CREDENTIAL_CREATE",
+		42: "TRANSACTION_TYPE_CREDENTIAL_ACCEPT",
+		43: "TRANSACTION_TYPE_CREDENTIAL_DELETE",
+		44: "TRANSACTION_TYPE_PERMISSIONED_DOMAIN_SET",
+		45: "TRANSACTION_TYPE_PERMISSIONED_DOMAIN_DELETE",
+		46: "TRANSACTION_TYPE_DELEGATE_SET",
+		47: "TRANSACTION_TYPE_BATCH",
+		48: "TRANSACTION_TYPE_ENABLE_AMENDMENT",
+		49: "TRANSACTION_TYPE_SET_FEE",
+		50: "TRANSACTION_TYPE_UNL_MODIFY",
+		51: "TRANSACTION_TYPE_NFTOKEN_MODIFY",
+		52: "TRANSACTION_TYPE_LEDGER_STATE_FIX",
+		53: "TRANSACTION_TYPE_XCHAIN_CREATE_BRIDGE",
+		54: "TRANSACTION_TYPE_XCHAIN_MODIFY_BRIDGE",
+		55: "TRANSACTION_TYPE_XCHAIN_CREATE_CLAIM_ID",
+		56: "TRANSACTION_TYPE_XCHAIN_COMMIT",
+		57: "TRANSACTION_TYPE_XCHAIN_CLAIM",
+		58: "TRANSACTION_TYPE_XCHAIN_ACCOUNT_CREATE_COMMIT",
+		59: "TRANSACTION_TYPE_XCHAIN_ADD_CLAIM_ATTESTATION",
+		60: "TRANSACTION_TYPE_XCHAIN_ADD_ACCOUNT_CREATE_ATTESTATION",
+		61: "TRANSACTION_TYPE_VAULT_CREATE",
+		62: "TRANSACTION_TYPE_VAULT_SET",
+		63: "TRANSACTION_TYPE_VAULT_DELETE",
+		64: "TRANSACTION_TYPE_VAULT_DEPOSIT",
+		65: "TRANSACTION_TYPE_VAULT_WITHDRAW",
+		66: "TRANSACTION_TYPE_VAULT_CLAWBACK",
+	}
+	TransactionType_value = map[string]int32{
+		"TRANSACTION_TYPE_UNKNOWN":                               0,
+		"TRANSACTION_TYPE_PAYMENT":                               1,
+		"TRANSACTION_TYPE_OFFER_CREATE":                          2,
+		"TRANSACTION_TYPE_OFFER_CANCEL":                          3,
+		"TRANSACTION_TYPE_TRUST_SET":                             4,
+		"TRANSACTION_TYPE_ACCOUNT_SET":                           5,
+		"TRANSACTION_TYPE_ACCOUNT_DELETE":                        6,
+		"TRANSACTION_TYPE_SET_REGULAR_KEY":                       7,
+		"TRANSACTION_TYPE_SIGNER_LIST_SET":                       8,
+		"TRANSACTION_TYPE_ESCROW_CREATE":                         9,
+		"TRANSACTION_TYPE_ESCROW_FINISH":                         10,
+		"TRANSACTION_TYPE_ESCROW_CANCEL":                         11,
+		"TRANSACTION_TYPE_PAYMENT_CHANNEL_CREATE":                12,
+		"TRANSACTION_TYPE_PAYMENT_CHANNEL_FUND":                  13,
+		"TRANSACTION_TYPE_PAYMENT_CHANNEL_CLAIM":                 14,
+		"TRANSACTION_TYPE_CHECK_CREATE":                          15,
+		"TRANSACTION_TYPE_CHECK_CASH":                            16,
+		"TRANSACTION_TYPE_CHECK_CANCEL":                          17,
+		"TRANSACTION_TYPE_DEPOSIT_PREAUTH":                       18,
+		"TRANSACTION_TYPE_TICKET_CREATE":                         19,
+		"TRANSACTION_TYPE_NFTOKEN_MINT":                          20,
+		"TRANSACTION_TYPE_NFTOKEN_BURN":                          21,
+		"TRANSACTION_TYPE_NFTOKEN_CREATE_OFFER":                  22,
+		"TRANSACTION_TYPE_NFTOKEN_CANCEL_OFFER":                  23,
+		"TRANSACTION_TYPE_NFTOKEN_ACCEPT_OFFER":                  24,
+		"TRANSACTION_TYPE_CLAWBACK":                              25,
+		"TRANSACTION_TYPE_AMM_CREATE":                            26,
+		"TRANSACTION_TYPE_AMM_DEPOSIT":                           27,
+		"TRANSACTION_TYPE_AMM_WITHDRAW":                          28,
+		"TRANSACTION_TYPE_AMM_VOTE":                              29,
+		"TRANSACTION_TYPE_AMM_BID":                               30,
+		"TRANSACTION_TYPE_AMM_DELETE":                            31,
+		"TRANSACTION_TYPE_AMM_CLAWBACK":                          32,
+		"TRANSACTION_TYPE_DID_SET":                               33,
+		"TRANSACTION_TYPE_DID_DELETE":                            34,
+		"TRANSACTION_TYPE_ORACLE_SET":                            35,
+		"TRANSACTION_TYPE_ORACLE_DELETE":                         36,
+		"TRANSACTION_TYPE_MPTOKEN_ISSUANCE_CREATE":               37,
+		"TRANSACTION_TYPE_MPTOKEN_ISSUANCE_DESTROY":              38,
+		"TRANSACTION_TYPE_MPTOKEN_ISSUANCE_SET":                  39,
+		"TRANSACTION_TYPE_MPTOKEN_AUTHORIZE":                     40,
+		"TRANSACTION_TYPE_CREDENTIAL_CREATE":                     41,
+		"TRANSACTION_TYPE_CREDENTIAL_ACCEPT":                     42,
+		"TRANSACTION_TYPE_CREDENTIAL_DELETE":                     43,
+		"TRANSACTION_TYPE_PERMISSIONED_DOMAIN_SET":               44,
+		"TRANSACTION_TYPE_PERMISSIONED_DOMAIN_DELETE":            45,
+		"TRANSACTION_TYPE_DELEGATE_SET":                          46,
+		"TRANSACTION_TYPE_BATCH":                                 47,
+		"TRANSACTION_TYPE_ENABLE_AMENDMENT":                      48,
+		"TRANSACTION_TYPE_SET_FEE":                               49,
+		"TRANSACTION_TYPE_UNL_MODIFY":                            50,
+		"TRANSACTION_TYPE_NFTOKEN_MODIFY":                        51,
+		"TRANSACTION_TYPE_LEDGER_STATE_FIX":                      52,
+		"TRANSACTION_TYPE_XCHAIN_CREATE_BRIDGE":                  53,
+		"TRANSACTION_TYPE_XCHAIN_MODIFY_BRIDGE":                  54,
+		"TRANSACTION_TYPE_XCHAIN_CREATE_CLAIM_ID":                55,
+		"TRANSACTION_TYPE_XCHAIN_COMMIT":                         56,
+		"TRANSACTION_TYPE_XCHAIN_CLAIM":                          57,
+		"TRANSACTION_TYPE_XCHAIN_ACCOUNT_CREATE_COMMIT":          58,
+		"TRANSACTION_TYPE_XCHAIN_ADD_CLAIM_ATTESTATION":          59,
+		"TRANSACTION_TYPE_XCHAIN_ADD_ACCOUNT_CREATE_ATTESTATION": 60,
+		"TRANSACTION_TYPE_VAULT_CREATE":                          61,
+		"TRANSACTION_TYPE_VAULT_SET":                             62,
+		"TRANSACTION_TYPE_VAULT_DELETE":                          63,
+		"TRANSACTION_TYPE_VAULT_DEPOSIT":                         64,
+		"TRANSACTION_TYPE_VAULT_WITHDRAW":                        65,
+		"TRANSACTION_TYPE_VAULT_CLAWBACK":                        66,
+	}
+)
+
+func (x TransactionType) Enum() *TransactionType {
+	p := new(TransactionType)
+	*p = x
+	return p
+}
+
+func (x TransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_xrpl_type_v1_transaction_type_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionType) Type() protoreflect.EnumType {
+	return &file_sf_xrpl_type_v1_transaction_type_proto_enumTypes[0]
+}
+
+func (x TransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionType.Descriptor instead.
+func (TransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_sf_xrpl_type_v1_transaction_type_proto_rawDescGZIP(), []int{0}
+}
+
+var File_sf_xrpl_type_v1_transaction_type_proto protoreflect.FileDescriptor
+
+const file_sf_xrpl_type_v1_transaction_type_proto_rawDesc = "" +
+	"\n" +
+	"&sf/xrpl/type/v1/transaction_type.proto\x12\x0fsf.xrpl.type.v1*\xf0\x13\n" +
+	"\x0fTransactionType\x12\x1c\n" +
+	"\x18TRANSACTION_TYPE_UNKNOWN\x10\x00\x12\x1c\n" +
+	"\x18TRANSACTION_TYPE_PAYMENT\x10\x01\x12!\n" +
+	"\x1dTRANSACTION_TYPE_OFFER_CREATE\x10\x02\x12!\n" +
+	"\x1dTRANSACTION_TYPE_OFFER_CANCEL\x10\x03\x12\x1e\n" +
+	"\x1aTRANSACTION_TYPE_TRUST_SET\x10\x04\x12 \n" +
+	"\x1cTRANSACTION_TYPE_ACCOUNT_SET\x10\x05\x12#\n" +
+	"\x1fTRANSACTION_TYPE_ACCOUNT_DELETE\x10\x06\x12$\n" +
+	" TRANSACTION_TYPE_SET_REGULAR_KEY\x10\a\x12$\n" +
+	" TRANSACTION_TYPE_SIGNER_LIST_SET\x10\b\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_ESCROW_CREATE\x10\t\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_ESCROW_FINISH\x10\n" +
+	"\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_ESCROW_CANCEL\x10\v\x12+\n" +
+	"'TRANSACTION_TYPE_PAYMENT_CHANNEL_CREATE\x10\f\x12)\n" +
+	"%TRANSACTION_TYPE_PAYMENT_CHANNEL_FUND\x10\r\x12*\n" +
+	"&TRANSACTION_TYPE_PAYMENT_CHANNEL_CLAIM\x10\x0e\x12!\n" +
+	"\x1dTRANSACTION_TYPE_CHECK_CREATE\x10\x0f\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_CHECK_CASH\x10\x10\x12!\n" +
+	"\x1dTRANSACTION_TYPE_CHECK_CANCEL\x10\x11\x12$\n" +
+	" TRANSACTION_TYPE_DEPOSIT_PREAUTH\x10\x12\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_TICKET_CREATE\x10\x13\x12!\n" +
+	"\x1dTRANSACTION_TYPE_NFTOKEN_MINT\x10\x14\x12!\n" +
+	"\x1dTRANSACTION_TYPE_NFTOKEN_BURN\x10\x15\x12)\n" +
+	"%TRANSACTION_TYPE_NFTOKEN_CREATE_OFFER\x10\x16\x12)\n" +
+	"%TRANSACTION_TYPE_NFTOKEN_CANCEL_OFFER\x10\x17\x12)\n" +
+	"%TRANSACTION_TYPE_NFTOKEN_ACCEPT_OFFER\x10\x18\x12\x1d\n" +
+	"\x19TRANSACTION_TYPE_CLAWBACK\x10\x19\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_AMM_CREATE\x10\x1a\x12 \n" +
+	"\x1cTRANSACTION_TYPE_AMM_DEPOSIT\x10\x1b\x12!\n" +
+	"\x1dTRANSACTION_TYPE_AMM_WITHDRAW\x10\x1c\x12\x1d\n" +
+	"\x19TRANSACTION_TYPE_AMM_VOTE\x10\x1d\x12\x1c\n" +
+	"\x18TRANSACTION_TYPE_AMM_BID\x10\x1e\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_AMM_DELETE\x10\x1f\x12!\n" +
+	"\x1dTRANSACTION_TYPE_AMM_CLAWBACK\x10 \x12\x1c\n" +
+	"\x18TRANSACTION_TYPE_DID_SET\x10!\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_DID_DELETE\x10\"\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_ORACLE_SET\x10#\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_ORACLE_DELETE\x10$\x12,\n" +
+	"(TRANSACTION_TYPE_MPTOKEN_ISSUANCE_CREATE\x10%\x12-\n" +
+	")TRANSACTION_TYPE_MPTOKEN_ISSUANCE_DESTROY\x10&\x12)\n" +
+	"%TRANSACTION_TYPE_MPTOKEN_ISSUANCE_SET\x10'\x12&\n" +
+	"\"TRANSACTION_TYPE_MPTOKEN_AUTHORIZE\x10(\x12&\n" +
+	"\"TRANSACTION_TYPE_CREDENTIAL_CREATE\x10)\x12&\n" +
+	"\"TRANSACTION_TYPE_CREDENTIAL_ACCEPT\x10*\x12&\n" +
+	"\"TRANSACTION_TYPE_CREDENTIAL_DELETE\x10+\x12,\n" +
+	"(TRANSACTION_TYPE_PERMISSIONED_DOMAIN_SET\x10,\x12/\n" +
+	"+TRANSACTION_TYPE_PERMISSIONED_DOMAIN_DELETE\x10-\x12!\n" +
+	"\x1dTRANSACTION_TYPE_DELEGATE_SET\x10.\x12\x1a\n" +
+	"\x16TRANSACTION_TYPE_BATCH\x10/\x12%\n" +
+	"!TRANSACTION_TYPE_ENABLE_AMENDMENT\x100\x12\x1c\n" +
+	"\x18TRANSACTION_TYPE_SET_FEE\x101\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_UNL_MODIFY\x102\x12#\n" +
+	"\x1fTRANSACTION_TYPE_NFTOKEN_MODIFY\x103\x12%\n" +
+	"!TRANSACTION_TYPE_LEDGER_STATE_FIX\x104\x12)\n" +
+	"%TRANSACTION_TYPE_XCHAIN_CREATE_BRIDGE\x105\x12)\n" +
+	"%TRANSACTION_TYPE_XCHAIN_MODIFY_BRIDGE\x106\x12+\n" +
+	"'TRANSACTION_TYPE_XCHAIN_CREATE_CLAIM_ID\x107\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_XCHAIN_COMMIT\x108\x12!\n" +
+	"\x1dTRANSACTION_TYPE_XCHAIN_CLAIM\x109\x121\n" +
+	"-TRANSACTION_TYPE_XCHAIN_ACCOUNT_CREATE_COMMIT\x10:\x121\n" +
+	"-TRANSACTION_TYPE_XCHAIN_ADD_CLAIM_ATTESTATION\x10;\x12:\n" +
+	"6TRANSACTION_TYPE_XCHAIN_ADD_ACCOUNT_CREATE_ATTESTATION\x10<\x12!\n" +
+	"\x1dTRANSACTION_TYPE_VAULT_CREATE\x10=\x12\x1e\n" +
+	"\x1aTRANSACTION_TYPE_VAULT_SET\x10>\x12!\n" +
+	"\x1dTRANSACTION_TYPE_VAULT_DELETE\x10?\x12\"\n" +
+	"\x1eTRANSACTION_TYPE_VAULT_DEPOSIT\x10@\x12#\n" +
+	"\x1fTRANSACTION_TYPE_VAULT_WITHDRAW\x10A\x12#\n" +
+	"\x1fTRANSACTION_TYPE_VAULT_CLAWBACK\x10BBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+
+var (
+	file_sf_xrpl_type_v1_transaction_type_proto_rawDescOnce sync.Once
+	file_sf_xrpl_type_v1_transaction_type_proto_rawDescData []byte
+)
+
+func file_sf_xrpl_type_v1_transaction_type_proto_rawDescGZIP() []byte {
+	file_sf_xrpl_type_v1_transaction_type_proto_rawDescOnce.Do(func() {
+		file_sf_xrpl_type_v1_transaction_type_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_transaction_type_proto_rawDesc), len(file_sf_xrpl_type_v1_transaction_type_proto_rawDesc)))
+	})
+	return file_sf_xrpl_type_v1_transaction_type_proto_rawDescData
+}
+
+var file_sf_xrpl_type_v1_transaction_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_xrpl_type_v1_transaction_type_proto_goTypes = []any{
+	(TransactionType)(0), // 0: sf.xrpl.type.v1.TransactionType
+}
+var file_sf_xrpl_type_v1_transaction_type_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sf_xrpl_type_v1_transaction_type_proto_init() }
+func file_sf_xrpl_type_v1_transaction_type_proto_init() {
+	if File_sf_xrpl_type_v1_transaction_type_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_xrpl_type_v1_transaction_type_proto_rawDesc), len(file_sf_xrpl_type_v1_transaction_type_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_xrpl_type_v1_transaction_type_proto_goTypes,
+		DependencyIndexes: file_sf_xrpl_type_v1_transaction_type_proto_depIdxs,
+		EnumInfos:         file_sf_xrpl_type_v1_transaction_type_proto_enumTypes,
+	}.Build()
+	File_sf_xrpl_type_v1_transaction_type_proto = out.File
+	file_sf_xrpl_type_v1_transaction_type_proto_goTypes = nil
+	file_sf_xrpl_type_v1_transaction_type_proto_depIdxs = nil
+}
@@ -44,7 +44,14 @@ type TrustSet struct {
 	// tfClearFreeze = 2097152 (0x00200000) - Unfreeze the trust line
 	// tfSetDeepFreeze = 4194304 (0x00400000) - Deep Freeze the trust line
 	// tfClearDeepFreeze = 8388608 (0x00800000) - Clear Deep Freeze on trust line
-	Flags         uint32 `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Flags uint32 `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Whether the trust line's RippleState entry was found in the metadata
+	// False when the line was deleted or the transaction failed
+	HasTrustLineState bool `protobuf:"varint,20,opt,name=has_trust_line_state,json=hasTrustLineState,proto3" json:"has_trust_line_state,omitempty"`
+	// Resulting NoRipple state on the submitting account's side of the trust line
+	AccountNoRipple bool `protobuf:"varint,21,opt,name=account_no_ripple,json=accountNoRipple,proto3" json:"account_no_ripple,omitempty"`
+	// Resulting NoRipple state on the peer's (limit_amount issuer's) side of the trust line
+	PeerNoRipple  bool `protobuf:"varint,22,opt,name=peer_no_ripple,json=peerNoRipple,proto3" json:"peer_no_ripple,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,18 +114,42 @@ func (x *TrustSet) GetFlags() uint32 {
 	return 0
 }
 
+func (x *TrustSet) GetHasTrustLineState() bool {
+	if x != nil {
+		return x.HasTrustLineState
+	}
+	return false
+}
+
+func (x *TrustSet) GetAccountNoRipple() bool {
+	if x != nil {
+		return x.AccountNoRipple
+	}
+	return false
+}
+
+func (x *TrustSet) GetPeerNoRipple() bool {
+	if x != nil {
+		return x.PeerNoRipple
+	}
+	return false
+}
+
 var File_sf_xrpl_type_v1_trustline_proto protoreflect.FileDescriptor
 
 const file_sf_xrpl_type_v1_trustline_proto_rawDesc = "" +
 	"\n" +
-	"\x1fsf/xrpl/type/v1/trustline.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\x9c\x01\n" +
+	"\x1fsf/xrpl/type/v1/trustline.proto\x12\x0fsf.xrpl.type.v1\x1a\x1csf/xrpl/type/v1/amount.proto\"\x9f\x02\n" +
 	"\bTrustSet\x12:\n" +
 	"\flimit_amount\x18\x01 \x01(\v2\x17.sf.xrpl.type.v1.AmountR\vlimitAmount\x12\x1d\n" +
 	"\n" +
 	"quality_in\x18\x02 \x01(\rR\tqualityIn\x12\x1f\n" +
 	"\vquality_out\x18\x03 \x01(\rR\n" +
 	"qualityOut\x12\x14\n" +
-	"\x05flags\x18\x04 \x01(\rR\x05flagsBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
+	"\x05flags\x18\x04 \x01(\rR\x05flags\x12/\n" +
+	"\x14has_trust_line_state\x18\x14 \x01(\bR\x11hasTrustLineState\x12*\n" +
+	"\x11account_no_ripple\x18\x15 \x01(\bR\x0faccountNoRipple\x12$\n" +
+	"\x0epeer_no_ripple\x18\x16 \x01(\bR\fpeerNoRippleBAZ?github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1;pbxrplb\x06proto3"
 
 var (
 	file_sf_xrpl_type_v1_trustline_proto_rawDescOnce sync.Once
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// BlockIDEncoding selects how ledger hashes are encoded into bstream block IDs
+type BlockIDEncoding string
+
+const (
+	// BlockIDHexLower encodes block IDs as lowercase hex (default)
+	BlockIDHexLower BlockIDEncoding = "hex-lower"
+	// BlockIDHexUpper encodes block IDs as uppercase hex, as rippled displays hashes
+	BlockIDHexUpper BlockIDEncoding = "hex-upper"
+	// BlockIDBase64 encodes block IDs as standard base64
+	BlockIDBase64 BlockIDEncoding = "base64"
+)
+
+// ParseBlockIDEncoding validates a block ID encoding name
+func ParseBlockIDEncoding(name string) (BlockIDEncoding, error) {
+	switch encoding := BlockIDEncoding(name); encoding {
+	case BlockIDHexLower, BlockIDHexUpper, BlockIDBase64:
+		return encoding, nil
+	}
+
+	return "", fmt.Errorf("unknown block ID encoding %q, valid encodings are: %s, %s, %s", name, BlockIDHexLower, BlockIDHexUpper, BlockIDBase64)
+}
+
+// encode converts a ledger hash to a block ID
+func (e BlockIDEncoding) encode(hash []byte) string {
+	switch e {
+	case BlockIDHexUpper:
+		return strings.ToUpper(hex.EncodeToString(hash))
+	case BlockIDBase64:
+		return base64.StdEncoding.EncodeToString(hash)
+	}
+
+	return hex.EncodeToString(hash)
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+)
+
+func TestParseBlockIDEncoding(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    BlockIDEncoding
+		wantErr bool
+	}{
+		{"hex-lower", BlockIDHexLower, false},
+		{"hex-upper", BlockIDHexUpper, false},
+		{"base64", BlockIDBase64, false},
+		{"hex", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBlockIDEncoding(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConvertBlockIDEncoding(t *testing.T) {
+	block := &pbxrpl.Block{
+		Number: 90000001,
+		Hash:   bytes.Repeat([]byte{0xcd}, 32),
+		Header: &pbxrpl.Header{ParentHash: bytes.Repeat([]byte{0xab}, 32)},
+	}
+
+	tests := []struct {
+		encoding     BlockIDEncoding
+		wantID       string
+		wantParentID string
+	}{
+		{BlockIDHexLower, "cdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd", "abababababababababababababababababababababababababababababababab"},
+		{BlockIDHexUpper, "CDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCD", "ABABABABABABABABABABABABABABABABABABABABABABABABABABABABABABABAB"},
+		{BlockIDBase64, "zc3Nzc3Nzc3Nzc3Nzc3Nzc3Nzc3Nzc3Nzc3Nzc3Nzc0=", "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s="},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.encoding), func(t *testing.T) {
+			converted, err := convertBlock(block, tt.encoding)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, converted.Id)
+			assert.Equal(t, tt.wantParentID, converted.ParentId)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+)
+
+// cadenceSmoothing is the weight of the newest interval in the close cadence moving average
+const cadenceSmoothing = 0.2
+
+// closeCadence estimates the interval between ledger closes from the wall-clock time each newly
+// validated ledger was seen at. Ledger close times are rounded to the close time resolution and come
+// from the validators' clocks, while the arrival time is what the next poll must be scheduled against
+type closeCadence struct {
+	mu sync.Mutex
+
+	lastIndex   uint64
+	lastArrival time.Time
+	interval    time.Duration
+}
+
+// observe records when a newly validated ledger was seen, updating the cadence from the previous one
+// A tip that advanced by several ledgers since contributes the average interval between them
+func (c *closeCadence) observe(ledgerIndex uint64, arrival time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ledgerIndex <= c.lastIndex {
+		return
+	}
+
+	if c.lastIndex != 0 {
+		if delta := arrival.Sub(c.lastArrival) / time.Duration(ledgerIndex-c.lastIndex); delta > 0 {
+			if c.interval == 0 {
+				c.interval = delta
+			} else {
+				c.interval = time.Duration(cadenceSmoothing*float64(delta) + (1-cadenceSmoothing)*float64(c.interval))
+			}
+		}
+	}
+
+	c.lastIndex = ledgerIndex
+	c.lastArrival = arrival
+}
+
+// nextPollDelay returns how long to wait until the next ledger is expected to be validated
+// It never returns less than fallback, which is also used while the cadence is unknown
+func (c *closeCadence) nextPollDelay(now time.Time, fallback time.Duration) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.interval == 0 {
+		return fallback
+	}
+
+	delay := c.lastArrival.Add(c.interval).Sub(now)
+	if delay < fallback {
+		return fallback
+	}
+
+	return delay
+}
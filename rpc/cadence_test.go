@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloseCadenceObserve(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type arrival struct {
+		index uint64
+		after time.Duration // Since start
+	}
+
+	tests := []struct {
+		name     string
+		arrivals []arrival
+		want     time.Duration
+	}{
+		{"single ledger", []arrival{{10, 0}}, 0},
+		{"consecutive ledgers", []arrival{{10, 0}, {11, 4 * time.Second}}, 4 * time.Second},
+		{"tip advanced by several ledgers", []arrival{{10, 0}, {13, 12 * time.Second}}, 4 * time.Second},
+		{"smoothed", []arrival{{10, 0}, {11, 4 * time.Second}, {12, 9 * time.Second}}, 4200 * time.Millisecond},
+		{"older ledger ignored", []arrival{{10, 0}, {11, 4 * time.Second}, {9, 5 * time.Second}}, 4 * time.Second},
+		{"same ledger seen again ignored", []arrival{{10, 0}, {10, 3 * time.Second}, {11, 4 * time.Second}}, 4 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cadence closeCadence
+			for _, a := range tt.arrivals {
+				cadence.observe(a.index, start.Add(a.after))
+			}
+			assert.Equal(t, tt.want, cadence.interval)
+		})
+	}
+}
+
+func TestCloseCadenceNextPollDelay(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fallback := 500 * time.Millisecond
+
+	var unknown closeCadence
+	assert.Equal(t, fallback, unknown.nextPollDelay(start, fallback), "unknown cadence")
+
+	var cadence closeCadence
+	cadence.observe(10, start)
+	cadence.observe(11, start.Add(4*time.Second))
+
+	assert.Equal(t, 3*time.Second, cadence.nextPollDelay(start.Add(5*time.Second), fallback))
+	assert.Equal(t, fallback, cadence.nextPollDelay(start.Add(8*time.Second), fallback), "next ledger overdue")
+}
@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+// ErrParentHashMismatch is returned by Fetch when a ledger's parent hash differs from the hash of the
+// previously fetched ledger, failing the fetch so the poller retries it on the next endpoint
+var ErrParentHashMismatch = errors.New("parent hash mismatch")
+
+// ErrUnexpectedLedger is returned by Fetch when an endpoint answers a ledger request with another
+// ledger than the one requested, or with one that is not closed and validated
+var ErrUnexpectedLedger = errors.New("unexpected ledger")
+
+// SetVerifyParentHash checks that each fetched ledger's parent hash matches the hash of the
+// previous ledger fetched by this Fetcher, when that ledger is still remembered
+func (f *Fetcher) SetVerifyParentHash(enabled bool) {
+	f.verifyParentHash = enabled
+}
+
+// checkParentHash verifies the block links to the previously fetched ledger, then remembers the block
+// Validated XRPL ledgers never reorganize, so a mismatch means an endpoint served inconsistent data:
+// the parent's hash is asked to the next endpoint to tell whether the block or the remembered parent
+// is wrong. A wrong parent is replaced and the block accepted, a wrong block fails the fetch so the
+// poller retries it on the next endpoint
+func (f *Fetcher) checkParentHash(ctx context.Context, client ClientInterface, block *pbbstream.Block) error {
+	parentID, fetched, _ := f.gaps.lookup(block.ParentNum)
+	if !fetched || parentID == block.ParentId {
+		f.gaps.recordFetched(block.Number, block.Id)
+		return nil
+	}
+
+	logger := f.logger.With(
+		zap.String("endpoint", client.Endpoint()),
+		zap.Uint64("block_num", block.Number),
+		zap.String("parent_id", block.ParentId),
+		zap.String("fetched_parent_id", parentID))
+	mismatch := fmt.Errorf("ledger %d from %s: parent %s, fetched ledger %d is %s: %w",
+		block.Number, client.Endpoint(), block.ParentId, block.ParentNum, parentID, ErrParentHashMismatch)
+
+	arbiter := f.nextEndpoint(client)
+	confirmedID, err := f.ledgerID(ctx, arbiter, block.ParentNum)
+	if err != nil {
+		logger.Error("ledger does not link to the previously fetched ledger and its parent could not be refetched",
+			zap.String("arbiter", arbiter.Endpoint()),
+			zap.Error(err))
+		return mismatch
+	}
+
+	switch confirmedID {
+	case block.ParentId:
+		logger.Error("previously fetched ledger was wrong, replacing it",
+			zap.String("arbiter", arbiter.Endpoint()))
+		f.gaps.recordFetched(block.ParentNum, confirmedID)
+		f.gaps.recordFetched(block.Number, block.Id)
+		return nil
+	case parentID:
+		logger.Error("ledger does not link to the previously fetched ledger, refetching from the next endpoint",
+			zap.String("arbiter", arbiter.Endpoint()))
+	default:
+		// Nobody agrees, forget the parent so the retry is not held to a hash that may be wrong too
+		logger.Error("ledger and previously fetched ledger both disagree with the next endpoint, forgetting the parent",
+			zap.String("arbiter", arbiter.Endpoint()),
+			zap.String("arbiter_parent_id", confirmedID))
+		f.gaps.forget(block.ParentNum)
+	}
+
+	return mismatch
+}
+
+// ledgerID fetches a ledger's header and returns its hash as a block ID
+func (f *Fetcher) ledgerID(ctx context.Context, client ClientInterface, num uint64) (string, error) {
+	header, err := client.GetLedgerHeader(ctx, num)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := decodeHex(header.LedgerHash)
+	if err != nil {
+		return "", fmt.Errorf("decoding ledger %d hash: %w", num, err)
+	}
+	return f.blockIDEncoding.encode(hash), nil
+}
+
+// nextEndpoint returns the first healthy endpoint after client in rotation order, client itself
+// when it is the only one
+func (f *Fetcher) nextEndpoint(client ClientInterface) ClientInterface {
+	for i, endpoint := range f.endpoints {
+		if endpoint != client {
+			continue
+		}
+		for j := 1; j < len(f.endpoints); j++ {
+			if next := f.endpoints[(i+j)%len(f.endpoints)]; !next.Quarantined() {
+				return next
+			}
+		}
+	}
+	return client
+}
+
+// checkLedgerResult verifies the endpoint returned the requested ledger, closed and validated
+// Validated ledgers are final, but a load-balanced endpoint can still route a request to a node
+// that is out of sync or on a fork and answer with a different or not yet validated ledger
+func checkLedgerResult(client ClientInterface, requested uint64, result *types.LedgerResult) error {
+	switch {
+	case result.LedgerIndex != requested || result.Ledger.LedgerIndex != requested:
+		return fmt.Errorf("ledger %d from %s: got ledger %d: %w", requested, client.Endpoint(), result.LedgerIndex, ErrUnexpectedLedger)
+	case !result.Validated || !result.Ledger.Closed:
+		return fmt.Errorf("ledger %d from %s: closed %v, validated %v: %w",
+			requested, client.Endpoint(), result.Ledger.Closed, result.Validated, ErrUnexpectedLedger)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+// ledgerHash builds a distinct 32-byte hash from a short label
+func ledgerHash(label string) string {
+	return strings.Repeat(label, 64/len(label))
+}
+
+func TestCheckParentHash(t *testing.T) {
+	const parentNum = 99
+	block := &pbbstream.Block{Number: parentNum + 1, Id: ledgerHash("bb"), ParentNum: parentNum, ParentId: ledgerHash("aa")}
+
+	tests := []struct {
+		name       string
+		remembered string // ID remembered for the parent, empty for none
+		arbiter    string // Parent hash according to the next endpoint, empty when it fails
+		wantErr    bool
+		wantParent string // ID remembered for the parent afterwards, empty for none
+	}{
+		{"parent not remembered", "", "", false, ""},
+		{"linked", ledgerHash("aa"), "", false, ledgerHash("aa")},
+		{"remembered parent was wrong", ledgerHash("cc"), ledgerHash("aa"), false, ledgerHash("aa")},
+		{"block is wrong", ledgerHash("cc"), ledgerHash("cc"), true, ledgerHash("cc")},
+		{"nobody agrees", ledgerHash("cc"), ledgerHash("dd"), true, ""},
+		{"next endpoint fails", ledgerHash("cc"), "", true, ledgerHash("cc")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{endpoint: "first"}
+			next := &fakeClient{endpoint: "next", hashes: map[uint64]string{}}
+			if tt.arbiter != "" {
+				next.hashes[parentNum] = strings.ToUpper(tt.arbiter)
+			}
+
+			fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+			fetcher.SetEndpoints([]ClientInterface{client, next})
+			if tt.remembered != "" {
+				fetcher.gaps.recordFetched(parentNum, tt.remembered)
+			}
+
+			err := fetcher.checkParentHash(context.Background(), client, block)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrParentHashMismatch)
+			} else {
+				require.NoError(t, err)
+			}
+
+			parentID, fetched, _ := fetcher.gaps.lookup(parentNum)
+			assert.Equal(t, tt.wantParent != "", fetched)
+			assert.Equal(t, tt.wantParent, parentID)
+
+			_, blockRecorded, _ := fetcher.gaps.lookup(block.Number)
+			assert.Equal(t, !tt.wantErr, blockRecorded)
+		})
+	}
+}
+
+// A chain broken by one endpoint serving a wrong ledger is detected at the next ledger
+func TestCheckParentHashBrokenChain(t *testing.T) {
+	honest := &fakeClient{endpoint: "honest", hashes: map[uint64]string{1: ledgerHash("01"), 2: ledgerHash("02")}}
+	forked := &fakeClient{endpoint: "forked"}
+
+	fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+	fetcher.SetEndpoints([]ClientInterface{forked, honest})
+
+	chain := []struct {
+		client  ClientInterface
+		block   *pbbstream.Block
+		wantErr bool
+	}{
+		{honest, &pbbstream.Block{Number: 2, Id: ledgerHash("02"), ParentNum: 1, ParentId: ledgerHash("01")}, false},
+		{forked, &pbbstream.Block{Number: 3, Id: ledgerHash("f3"), ParentNum: 2, ParentId: ledgerHash("f2")}, true},
+		{honest, &pbbstream.Block{Number: 3, Id: ledgerHash("03"), ParentNum: 2, ParentId: ledgerHash("02")}, false},
+		{honest, &pbbstream.Block{Number: 4, Id: ledgerHash("04"), ParentNum: 3, ParentId: ledgerHash("03")}, false},
+	}
+
+	for _, link := range chain {
+		err := fetcher.checkParentHash(context.Background(), link.client, link.block)
+		if link.wantErr {
+			assert.ErrorIs(t, err, ErrParentHashMismatch, "ledger %d from %s", link.block.Number, link.client.Endpoint())
+		} else {
+			assert.NoError(t, err, "ledger %d from %s", link.block.Number, link.client.Endpoint())
+		}
+	}
+}
+
+func TestNextEndpoint(t *testing.T) {
+	a, b, c := &fakeClient{endpoint: "a"}, &fakeClient{endpoint: "b", quarantined: true}, &fakeClient{endpoint: "c"}
+
+	tests := []struct {
+		name      string
+		endpoints []ClientInterface
+		client    ClientInterface
+		want      ClientInterface
+	}{
+		{"following endpoint", []ClientInterface{a, c}, a, c},
+		{"wraps around", []ClientInterface{a, c}, c, a},
+		{"skips quarantined", []ClientInterface{a, b, c}, a, c},
+		{"single endpoint", []ClientInterface{a}, a, a},
+		{"unregistered endpoint", []ClientInterface{a, c}, b, b},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+			fetcher.SetEndpoints(tt.endpoints)
+			assert.Equal(t, tt.want.Endpoint(), fetcher.nextEndpoint(tt.client).Endpoint())
+		})
+	}
+}
+
+func TestCheckLedgerResult(t *testing.T) {
+	client := &fakeClient{endpoint: "endpoint"}
+	result := func(index, headerIndex uint64, validated, closed bool) *types.LedgerResult {
+		return &types.LedgerResult{
+			LedgerIndex: index,
+			Validated:   validated,
+			Ledger:      types.Ledger{LedgerIndex: headerIndex, Closed: closed},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		result  *types.LedgerResult
+		wantErr bool
+	}{
+		{"requested ledger", result(10, 10, true, true), false},
+		{"other ledger", result(11, 11, true, true), true},
+		{"header of another ledger", result(10, 11, true, true), true},
+		{"not validated", result(10, 10, false, true), true},
+		{"not closed", result(10, 10, true, false), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkLedgerResult(client, 10, tt.result)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnexpectedLedger)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFetchRejectsUnexpectedLedger(t *testing.T) {
+	const requested = 90000001
+
+	otherLedger := benchmarkLedger(requested+1, 1)
+	otherHeader := benchmarkLedger(requested, 1)
+	otherHeader.Ledger.LedgerIndex = requested + 1
+	notValidated := benchmarkLedger(requested, 1)
+	notValidated.Validated = false
+
+	tests := []struct {
+		name   string
+		ledger *types.LedgerResult
+	}{
+		{"response index mismatch", otherLedger},
+		{"header index mismatch", otherHeader},
+		{"not validated", notValidated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{endpoint: "memory", ledgers: map[uint64]*types.LedgerResult{requested: tt.ledger}}
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			defer fetcher.Close()
+			fetcher.lastBlockInfo.advance(requested)
+
+			block, err := fetcher.FetchLedgerBlock(context.Background(), client, requested)
+			require.ErrorIs(t, err, ErrUnexpectedLedger)
+			assert.Nil(t, block)
+			assert.Contains(t, err.Error(), "memory")
+		})
+	}
+}
+
+func TestFetchRejectsLedgerHeaderMismatch(t *testing.T) {
+	// A node out of sync answers the request for 90000002 with the header of 90000001
+	fixture, err := os.ReadFile("testdata/replay/ledger_90000001.json")
+	require.NoError(t, err)
+	response := strings.Replace(string(fixture), `"ledger_index": 90000001`, `"ledger_index": 90000002`, 1)
+	require.NotEqual(t, string(fixture), response)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(0, 0))
+	require.NoError(t, err)
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	defer fetcher.Close()
+	fetcher.lastBlockInfo.advance(90000002)
+
+	_, err = fetcher.FetchLedgerBlock(context.Background(), client, 90000002)
+	require.ErrorIs(t, err, ErrUnexpectedLedger)
+}
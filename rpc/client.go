@@ -4,41 +4,107 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
-	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/xrpl-commons/firehose-xrpl/decoder"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
 	"github.com/xrpl-commons/firehose-xrpl/types"
 	"go.uber.org/zap"
 )
 
-// Client wraps the xrpl-go RPC client for Firehose operations
+// ErrLedgerNotFound is returned when the endpoint does not have the requested ledger (rippled lgrNotFound)
+var ErrLedgerNotFound = errors.New("ledger not found")
+
+// RPCLedgerError is a rippled error returned in the result of a ledger request
+type RPCLedgerError struct {
+	Code    int    // error_code, e.g. 21
+	Name    string // error, e.g. "lgrNotFound"
+	Message string // error_message, may be empty
+}
+
+func (e *RPCLedgerError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("RPC error %s (%d)", e.Name, e.Code)
+	}
+	return fmt.Sprintf("RPC error %s (%d): %s", e.Name, e.Code, e.Message)
+}
+
+// Is lets errors.Is(err, ErrLedgerNotFound) match an lgrNotFound error
+func (e *RPCLedgerError) Is(target error) bool {
+	return target == ErrLedgerNotFound && e.IsNotFound()
+}
+
+// IsNotFound reports whether the node does not have the ledger, which retrying the same node won't fix
+func (e *RPCLedgerError) IsNotFound() bool {
+	return e.Name == "lgrNotFound"
+}
+
+// IsRetryable reports whether the node is temporarily unable to answer (overloaded or not synced)
+func (e *RPCLedgerError) IsRetryable() bool {
+	switch e.Name {
+	case "tooBusy", "slowDown", "noNetwork", "noCurrent", "noClosed":
+		return true
+	}
+	return false
+}
+
+// IsBusy reports whether the node asks clients to send fewer requests
+func (e *RPCLedgerError) IsBusy() bool {
+	return isBusyResult(e.Name)
+}
+
+// rpcError builds the error for a rippled error result, slowing requests down when the node is busy
+func (c *Client) rpcError(name string, code int, message string) *RPCLedgerError {
+	err := &RPCLedgerError{Code: code, Name: name, Message: message}
+	if err.IsBusy() {
+		c.recordBusy(0)
+	}
+	return err
+}
+
+// Client talks JSON-RPC to a rippled endpoint for Firehose operations
 type Client struct {
 	rpcEndpoint string
-	client      *rpc.Client
+	wsEndpoint  string
 	httpClient  *http.Client
 	logger      *zap.Logger
+	maxRetries  int
+	retryBase   time.Duration
+
+	// Extra headers sent on every request (API keys, basic auth)
+	headers http.Header
+
+	// Recent request outcomes, for quarantine and endpoint sorting
+	health endpointHealth
+
+	// Request pacing, slowed down when the endpoint reports being busy
+	throttle throttle
+
+	// Ledger ranges the node retains, refreshed by GetServerInfo
+	rangesMu          sync.RWMutex
+	completeLedgers   types.LedgerRanges
+	completeLedgersAt time.Time
 }
 
 // NewClient creates a new XRPL RPC client with default HTTP settings
-func NewClient(rpcEndpoint string, logger *zap.Logger) (*Client, error) {
-	return NewClientWithHTTPConfig(rpcEndpoint, logger, 100, 10, 90*time.Second)
+func NewClient(rpcEndpoint string, logger *zap.Logger, opts ...ClientOption) (*Client, error) {
+	return NewClientWithHTTPConfig(rpcEndpoint, logger, 100, 10, 90*time.Second, opts...)
 }
 
 // NewClientWithHTTPConfig creates a new XRPL RPC client with custom HTTP connection pool settings
-func NewClientWithHTTPConfig(rpcEndpoint string, logger *zap.Logger, maxIdleConns, maxIdleConnsPerHost int, idleConnTimeout time.Duration) (*Client, error) {
-	cfg, err := rpc.NewClientConfig(rpcEndpoint,
-		rpc.WithTimeout(60*time.Second),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create client config: %w", err)
+func NewClientWithHTTPConfig(rpcEndpoint string, logger *zap.Logger, maxIdleConns, maxIdleConnsPerHost int, idleConnTimeout time.Duration, opts ...ClientOption) (*Client, error) {
+	if rpcEndpoint == "" {
+		return nil, errors.New("empty RPC endpoint URL")
 	}
 
-	client := rpc.NewClient(cfg)
-
 	// Configure HTTP transport with connection pooling
 	transport := &http.Transport{
 		MaxIdleConns:          maxIdleConns,
@@ -51,30 +117,52 @@ func NewClientWithHTTPConfig(rpcEndpoint string, logger *zap.Logger, maxIdleConn
 		ExpectContinueTimeout: 1 * time.Second,
 	}
 
-	return &Client{
+	c := &Client{
 		rpcEndpoint: rpcEndpoint,
-		client:      client,
 		httpClient: &http.Client{
 			Timeout:   60 * time.Second,
 			Transport: transport,
 		},
-		logger: logger,
-	}, nil
+		logger:     logger,
+		maxRetries: defaultMaxRetries,
+		retryBase:  defaultRetryBase,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// Endpoint returns the RPC endpoint URL this client is bound to
+func (c *Client) Endpoint() string {
+	return c.rpcEndpoint
 }
 
 // GetLatestLedger returns the latest validated ledger index
+// The request is bound to ctx, so a shutdown interrupts a poll in flight
 func (c *Client) GetLatestLedger(ctx context.Context) (*types.LedgerClosedResult, error) {
-	// Use GetClosedLedger to get the latest closed ledger
-	response, err := c.client.GetClosedLedger()
+	defer rpcLatency.ObserveSince(time.Now(), "ledger_closed")
+
+	reqBody, err := json.Marshal(types.LedgerClosedRequest{
+		Method: "ledger_closed",
+		Params: []any{map[string]any{}},
+	})
 	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var resp types.LedgerClosedResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
 		return nil, fmt.Errorf("ledger_closed request failed: %w", err)
 	}
 
-	return &types.LedgerClosedResult{
-		LedgerHash:  response.LedgerHash,
-		LedgerIndex: uint64(response.LedgerIndex),
-		Status:      "success",
-	}, nil
+	if resp.Result.Error != "" {
+		return nil, fmt.Errorf("ledger_closed: %w", c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	resp.Result.Status = "success"
+	return &resp.Result, nil
 }
 
 // rawLedgerResponse is the raw JSON response from rippled for binary mode
@@ -85,54 +173,167 @@ type rawLedgerResponse struct {
 			Closed       bool          `json:"closed"`
 			Transactions []interface{} `json:"transactions"`
 		} `json:"ledger"`
-		LedgerHash  string `json:"ledger_hash"`
-		LedgerIndex uint64 `json:"ledger_index"`
-		Validated   bool   `json:"validated"`
-		Status      string `json:"status"`
-		Error       string `json:"error,omitempty"`
+		LedgerHash         string `json:"ledger_hash"`
+		LedgerIndex        uint64 `json:"ledger_index"`
+		LedgerCurrentIndex uint64 `json:"ledger_current_index"` // Instead of ledger_index for the open ledger
+		Validated          bool   `json:"validated"`
+		Status             string `json:"status"`
+		Error              string `json:"error,omitempty"`
+		ErrorCode          int    `json:"error_code,omitempty"`
+		ErrorMessage       string `json:"error_message,omitempty"`
 	} `json:"result"`
 }
 
+// ResultError returns the rippled error of the result, empty on success
+func (r *rawLedgerResponse) ResultError() string {
+	return r.Result.Error
+}
+
+// postJSON sends a raw JSON-RPC request and stream-decodes the response into out,
+// retrying transient failures according to the client's retry policy
+// Each attempt decodes into a fresh value and out is only set on success, so a
+// failed attempt can't leave fields behind for the retry to merge with
+func (c *Client) postJSON(ctx context.Context, body []byte, out interface{}) error {
+	target := reflect.ValueOf(out).Elem()
+	return c.withRetry(ctx, func() error {
+		if err := c.throttleWait(ctx); err != nil {
+			return err
+		}
+
+		attempt := reflect.New(target.Type()).Interface()
+		start := time.Now()
+		err := c.postJSONOnce(ctx, body, attempt)
+		c.recordOutcome(ctx, start, err)
+		c.recordThrottleOutcome(err, attempt)
+		if err == nil {
+			target.Set(reflect.ValueOf(attempt).Elem())
+		}
+		return err
+	})
+}
+
+// postJSONOnce makes a single JSON-RPC attempt
+// The request is rebuilt from body on every call, and GetBody is set from the
+// in-memory reader, so a retried attempt never sends a drained (empty) body
+func (c *Client) postJSONOnce(ctx context.Context, body []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.rpcEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			c.logger.Debug("failed to close response body", zap.Error(err))
+		}
+	}(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &httpStatusError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+
+	// Stream JSON parsing - avoids buffering entire response in memory
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+// ledgerSentinels are the ledger_index shortcuts rippled accepts in place of a ledger number
+var ledgerSentinels = map[string]bool{
+	"validated": true,
+	"closed":    true,
+	"current":   true,
+}
+
 // GetLedger fetches a ledger with all transactions in binary format
 func (c *Client) GetLedger(ctx context.Context, ledgerIndex uint64) (*types.LedgerResult, error) {
 	startTime := time.Now()
 	defer func() {
+		rpcLatency.ObserveSince(startTime, "ledger")
 		c.logger.Debug("GetLedger completed",
 			zap.Uint64("ledger_index", ledgerIndex),
 			zap.Duration("duration", time.Since(startTime)))
 	}()
-	// Make raw HTTP request to get ledger_data blob which xrpl-go doesn't expose
-	reqBody := fmt.Sprintf(`{"method":"ledger","params":[{"ledger_index":%d,"transactions":true,"expand":true,"binary":true}]}`, ledgerIndex)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.rpcEndpoint, bytes.NewBufferString(reqBody))
+	result, err := c.getFullLedger(ctx, types.LedgerParams{LedgerIndex: ledgerIndex})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	if !result.Validated {
+		return nil, fmt.Errorf("ledger %d not yet validated", ledgerIndex)
+	}
+
+	return result, nil
+}
+
+// GetLedgerBySentinel fetches the "validated", "closed" or "current" ledger with all transactions in
+// binary format, without first resolving its number. Only "validated" returns a validated ledger,
+// the others may still change or never validate
+func (c *Client) GetLedgerBySentinel(ctx context.Context, sentinel string) (*types.LedgerResult, error) {
+	if !ledgerSentinels[sentinel] {
+		return nil, fmt.Errorf("invalid ledger sentinel %q, expected validated, closed or current", sentinel)
+	}
+
+	return c.getFullLedger(ctx, types.LedgerParams{LedgerIndex: sentinel})
+}
+
+// GetLedgerByHash fetches the ledger with the given hash with all transactions in binary format,
+// whether or not it is validated. It fails with ErrLedgerNotFound when the node does not have it
+func (c *Client) GetLedgerByHash(ctx context.Context, hash string) (*types.LedgerResult, error) {
+	result, err := c.getFullLedger(ctx, types.LedgerParams{LedgerHash: hash})
 	if err != nil {
-		return nil, fmt.Errorf("ledger request failed: %w", err)
+		return nil, err
+	}
+
+	if !strings.EqualFold(result.LedgerHash, hash) {
+		return nil, fmt.Errorf("ledger %s: node returned ledger %d with hash %s", hash, result.LedgerIndex, result.LedgerHash)
+	}
+
+	return result, nil
+}
+
+// getFullLedger fetches a ledger by number, sentinel or hash with all transactions in binary format
+func (c *Client) getFullLedger(ctx context.Context, params types.LedgerParams) (*types.LedgerResult, error) {
+	params.Transactions = true
+	params.Expand = true
+	params.Binary = true
+
+	var ledgerRef any = params.LedgerIndex
+	if params.LedgerHash != "" {
+		ledgerRef = params.LedgerHash
+	}
+
+	// Make raw HTTP request to get ledger_data blob which xrpl-go doesn't expose
+	reqBody, err := json.Marshal(types.LedgerRequest{
+		Method: "ledger",
+		Params: []types.LedgerParams{params},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
 	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			_ = fmt.Errorf("failed to close response body: %w", err)
-		}
-	}(resp.Body)
 
-	// Stream JSON parsing - avoids buffering entire response in memory
 	var rawResp rawLedgerResponse
-	if err := json.NewDecoder(resp.Body).Decode(&rawResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if err := c.postJSON(ctx, reqBody, &rawResp); err != nil {
+		return nil, fmt.Errorf("ledger request failed: %w", err)
 	}
 
 	if rawResp.Result.Error != "" {
-		return nil, fmt.Errorf("RPC error: %s", rawResp.Result.Error)
+		return nil, fmt.Errorf("ledger %v: %w", ledgerRef, c.rpcError(rawResp.Result.Error, rawResp.Result.ErrorCode, rawResp.Result.ErrorMessage))
 	}
 
-	if !rawResp.Result.Validated {
-		return nil, fmt.Errorf("ledger %d not yet validated", ledgerIndex)
+	if rawResp.Result.LedgerIndex == 0 {
+		rawResp.Result.LedgerIndex = rawResp.Result.LedgerCurrentIndex
 	}
 
 	// Decode ledger header from ledger_data blob
@@ -143,18 +344,8 @@ func (c *Client) GetLedger(ctx context.Context, ledgerIndex uint64) (*types.Ledg
 	}
 
 	if rawResp.Result.Ledger.LedgerData != "" {
-		headerData, err := binarycodec.DecodeLedgerData(rawResp.Result.Ledger.LedgerData)
-		if err != nil {
+		if err := decodeLedgerHeader(rawResp.Result.Ledger.LedgerData, &ledgerData); err != nil {
 			c.logger.Warn("failed to decode ledger_data", zap.Error(err))
-		} else {
-			ledgerData.ParentHash = headerData.ParentHash
-			ledgerData.CloseTime = uint64(headerData.CloseTime)
-			ledgerData.ParentCloseTime = uint64(headerData.ParentCloseTime)
-			ledgerData.AccountHash = headerData.AccountHash
-			ledgerData.TransactionHash = headerData.TransactionHash
-			ledgerData.TotalCoins = headerData.TotalCoins
-			ledgerData.CloseTimeResolution = uint32(headerData.CloseTimeResolution)
-			ledgerData.CloseFlags = uint32(headerData.CloseFlags)
 		}
 	}
 
@@ -193,22 +384,356 @@ func (c *Client) GetLedger(ctx context.Context, ledgerIndex uint64) (*types.Ledg
 	}, nil
 }
 
-// GetServerInfo returns server information including available ledger range
+// GetLedgerJSON fetches a validated ledger with its transactions expanded to JSON by rippled
+// (binary=false). It is much heavier than GetLedger and only meant for debugging, e.g. diffing
+// rippled's rendering against the binary decoding when the codec lags behind a new amendment
+func (c *Client) GetLedgerJSON(ctx context.Context, ledgerIndex uint64) (*types.LedgerJSONResult, error) {
+	defer rpcLatency.ObserveSince(time.Now(), "ledger_json")
+
+	reqBody, err := json.Marshal(types.LedgerRequest{
+		Method: "ledger",
+		Params: []types.LedgerParams{{
+			LedgerIndex:  ledgerIndex,
+			Transactions: true,
+			Expand:       true,
+			Binary:       false,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var resp types.LedgerJSONResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
+		return nil, fmt.Errorf("ledger request failed: %w", err)
+	}
+
+	if resp.Result.Error != "" {
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	if !resp.Result.Validated {
+		return nil, fmt.Errorf("ledger %d not yet validated", ledgerIndex)
+	}
+
+	return &resp.Result, nil
+}
+
+// GetLedgerHeader fetches only the header of a validated ledger, without its transactions
+func (c *Client) GetLedgerHeader(ctx context.Context, ledgerIndex uint64) (*types.Ledger, error) {
+	reqBody := fmt.Sprintf(`{"method":"ledger","params":[{"ledger_index":%d,"binary":true}]}`, ledgerIndex)
+
+	var rawResp rawLedgerResponse
+	if err := c.postJSON(ctx, []byte(reqBody), &rawResp); err != nil {
+		return nil, fmt.Errorf("ledger header request failed: %w", err)
+	}
+
+	if rawResp.Result.Error != "" {
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, c.rpcError(rawResp.Result.Error, rawResp.Result.ErrorCode, rawResp.Result.ErrorMessage))
+	}
+
+	if !rawResp.Result.Validated {
+		return nil, fmt.Errorf("ledger %d not yet validated", ledgerIndex)
+	}
+
+	ledgerData := &types.Ledger{
+		LedgerIndex: rawResp.Result.LedgerIndex,
+		LedgerHash:  rawResp.Result.LedgerHash,
+		Closed:      rawResp.Result.Ledger.Closed,
+	}
+
+	if rawResp.Result.Ledger.LedgerData == "" {
+		return nil, fmt.Errorf("ledger %d response has no ledger_data", ledgerIndex)
+	}
+	if err := decodeLedgerHeader(rawResp.Result.Ledger.LedgerData, ledgerData); err != nil {
+		return nil, fmt.Errorf("decoding ledger %d header: %w", ledgerIndex, err)
+	}
+
+	return ledgerData, nil
+}
+
+// GetAccountObjects fetches one page of the ledger objects owned by account in the latest validated ledger
+// objectType filters by type (e.g. "offer", "state", "escrow", "check"), empty for all types
+// marker is the Marker of the previous page, nil for the first page; the result's Marker is nil on the last page
+func (c *Client) GetAccountObjects(ctx context.Context, account string, objectType string, marker any) (*types.AccountObjectsResult, error) {
+	reqBody, err := json.Marshal(types.AccountObjectsRequest{
+		Method: "account_objects",
+		Params: []types.AccountObjectsParams{{
+			Account:     account,
+			LedgerIndex: "validated",
+			Type:        objectType,
+			Marker:      marker,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var resp types.AccountObjectsResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
+		return nil, fmt.Errorf("account_objects request failed: %w", err)
+	}
+
+	if resp.Result.Error != "" {
+		return nil, fmt.Errorf("account_objects %s: %w", account, c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	return &resp.Result, nil
+}
+
+// ErrLedgerNotServed is returned when an endpoint's complete_ledgers does not cover the requested ledger,
+// so the poller moves on to another endpoint
+var ErrLedgerNotServed = errors.New("ledger outside the endpoint's complete ledgers")
+
+// ErrEntryNotFound is returned when the requested ledger entry does not exist (rippled entryNotFound)
+var ErrEntryNotFound = errors.New("ledger entry not found")
+
+// ErrTransactionNotFound is returned when the endpoint does not know the requested transaction (rippled txnNotFound)
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// GetTransaction fetches a transaction by hash in binary form, from whichever ledger holds it
+func (c *Client) GetTransaction(ctx context.Context, hash string) (*types.TxResult, error) {
+	reqBody, err := json.Marshal(types.TxRequest{
+		Method: "tx",
+		Params: []types.TxParams{{
+			Transaction: hash,
+			Binary:      true,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var resp types.TxResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
+		return nil, fmt.Errorf("tx request failed: %w", err)
+	}
+
+	switch resp.Result.Error {
+	case "":
+	case "txnNotFound":
+		return nil, fmt.Errorf("transaction %s: %w", hash, ErrTransactionNotFound)
+	default:
+		return nil, fmt.Errorf("transaction %s: %w", hash, c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	if resp.Result.BinaryTx() == "" || resp.Result.BinaryMeta() == "" {
+		return nil, fmt.Errorf("transaction %s: response has no binary tx or meta", hash)
+	}
+
+	return &resp.Result, nil
+}
+
+// GetLedgerEntry fetches a single ledger object by its ID as it was in the given ledger
+func (c *Client) GetLedgerEntry(ctx context.Context, index string, ledgerIndex uint64) (map[string]any, error) {
+	reqBody, err := json.Marshal(types.LedgerEntryRequest{
+		Method: "ledger_entry",
+		Params: []types.LedgerEntryParams{{
+			Index:       index,
+			LedgerIndex: ledgerIndex,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var resp types.LedgerEntryResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
+		return nil, fmt.Errorf("ledger_entry request failed: %w", err)
+	}
+
+	switch resp.Result.Error {
+	case "":
+	case "entryNotFound":
+		return nil, fmt.Errorf("entry %s at ledger %d: %w", index, ledgerIndex, ErrEntryNotFound)
+	case "lgrNotFound":
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, ErrLedgerNotFound)
+	default:
+		return nil, fmt.Errorf("entry %s at ledger %d: %w", index, ledgerIndex, c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	return resp.Result.Node, nil
+}
+
+// GetLedgerData fetches one page of the state of a validated ledger with ledger_data in binary format,
+// decoding each ledger object. marker is the Marker of the previous page, nil for the first page; the
+// page's Marker is nil on the last page. Paging through a whole ledger is the basis of a state snapshot
+func (c *Client) GetLedgerData(ctx context.Context, ledgerIndex uint64, marker any) (*types.LedgerDataPage, error) {
+	defer rpcLatency.ObserveSince(time.Now(), "ledger_data")
+
+	reqBody, err := json.Marshal(types.LedgerDataRequest{
+		Method: "ledger_data",
+		Params: []types.LedgerDataParams{{
+			LedgerIndex: ledgerIndex,
+			Binary:      true,
+			Marker:      marker,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	// postJSON decodes the response as it streams in, pages can be several MB
+	var resp types.LedgerDataResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
+		return nil, fmt.Errorf("ledger_data request failed: %w", err)
+	}
+
+	switch resp.Result.Error {
+	case "":
+	case "lgrNotFound":
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, ErrLedgerNotFound)
+	default:
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	if !resp.Result.Validated {
+		return nil, fmt.Errorf("ledger %d not yet validated", ledgerIndex)
+	}
+
+	page := &types.LedgerDataPage{
+		LedgerHash:  resp.Result.LedgerHash,
+		LedgerIndex: ledgerIndex,
+		Objects:     make([]types.LedgerObject, 0, len(resp.Result.State)),
+		Marker:      resp.Result.Marker,
+	}
+
+	for _, item := range resp.Result.State {
+		fields, err := binarycodec.Decode(item.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding ledger object %s: %w", item.Index, err)
+		}
+		page.Objects = append(page.Objects, types.LedgerObject{Index: item.Index, Fields: fields})
+	}
+
+	return page, nil
+}
+
+// FindPaths asks rippled which paths it would use to deliver destAmount from src to dst in the
+// latest validated ledger, returning the computed paths of every alternative it found
+func (c *Client) FindPaths(ctx context.Context, src, dst string, destAmount *pbxrpl.Amount) ([]*pbxrpl.Path, error) {
+	if destAmount == nil {
+		return nil, fmt.Errorf("destination amount is required")
+	}
+
+	reqBody, err := json.Marshal(types.PathFindRequest{
+		Method: "ripple_path_find",
+		Params: []types.PathFindParams{{
+			SourceAccount:      src,
+			DestinationAccount: dst,
+			DestinationAmount:  amountToJSON(destAmount),
+			LedgerIndex:        "validated",
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var resp types.PathFindResponse
+	if err := c.postJSON(ctx, reqBody, &resp); err != nil {
+		return nil, fmt.Errorf("ripple_path_find request failed: %w", err)
+	}
+
+	if resp.Result.Error != "" {
+		return nil, fmt.Errorf("ripple_path_find: %w", c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	var paths []*pbxrpl.Path
+	for _, alt := range resp.Result.Alternatives {
+		paths = append(paths, decoder.MapPaths(alt.PathsComputed)...)
+	}
+
+	return paths, nil
+}
+
+// amountToJSON converts an amount to rippled's JSON form: a drops string for XRP, an object otherwise
+func amountToJSON(amt *pbxrpl.Amount) any {
+	if amt.MptIssuanceId != "" {
+		return map[string]string{"mpt_issuance_id": amt.MptIssuanceId, "value": amt.Value}
+	}
+	if amt.Currency == "" {
+		return amt.Value
+	}
+
+	return map[string]string{"currency": amt.Currency, "issuer": amt.Issuer, "value": amt.Value}
+}
+
+// decodeLedgerHeader decodes a binary ledger_data blob into the header fields of ledger
+func decodeLedgerHeader(ledgerDataHex string, ledger *types.Ledger) error {
+	headerData, err := binarycodec.DecodeLedgerData(ledgerDataHex)
+	if err != nil {
+		return err
+	}
+
+	// The header's own sequence, so a header that disagrees with the response's ledger_index shows
+	ledger.LedgerIndex = uint64(headerData.LedgerIndex)
+	ledger.ParentHash = headerData.ParentHash
+	ledger.CloseTime = uint64(headerData.CloseTime)
+	ledger.ParentCloseTime = uint64(headerData.ParentCloseTime)
+	ledger.AccountHash = headerData.AccountHash
+	ledger.TransactionHash = headerData.TransactionHash
+	ledger.TotalCoins = headerData.TotalCoins
+	ledger.CloseTimeResolution = uint32(headerData.CloseTimeResolution)
+	ledger.CloseFlags = uint32(headerData.CloseFlags)
+
+	return nil
+}
+
+// GetServerInfo returns server information including the available ledger range
 func (c *Client) GetServerInfo(ctx context.Context) (*types.ServerInfoResult, error) {
-	// Use Ping to test connection - server_info not directly available
-	// For now, we'll use GetLedgerIndex as a health check
-	ledgerIndex, err := c.client.GetLedgerIndex()
+	var resp types.ServerInfoResponse
+	if err := c.postJSON(ctx, []byte(`{"method":"server_info","params":[{}]}`), &resp); err != nil {
+		return nil, fmt.Errorf("server_info request failed: %w", err)
+	}
+
+	if resp.Result.Error != "" {
+		return nil, fmt.Errorf("server_info: %w", c.rpcError(resp.Result.Error, resp.Result.ErrorCode, resp.Result.ErrorMessage))
+	}
+
+	completeLedgers, err := types.ParseLedgerRanges(resp.Result.Info.CompleteLedgers)
 	if err != nil {
-		return nil, fmt.Errorf("server check failed: %w", err)
+		return nil, fmt.Errorf("parsing complete_ledgers: %w", err)
 	}
+	resp.Result.CompleteLedgers = completeLedgers
 
-	return &types.ServerInfoResult{
-		Status: "success",
-		Info: types.ServerInfo{
-			ServerState: "connected",
-			ValidatedLedger: types.ValidatedInfo{
-				Seq: uint64(ledgerIndex),
-			},
-		},
-	}, nil
+	c.rangesMu.Lock()
+	c.completeLedgers = completeLedgers
+	c.completeLedgersAt = time.Now()
+	c.rangesMu.Unlock()
+
+	return &resp.Result, nil
+}
+
+// CanServe reports whether the node retains ledgerIndex according to the complete_ledgers
+// of the last GetServerInfo call. It is optimistic: without server info, or for ledgers
+// newer than the last known range, it returns true
+func (c *Client) CanServe(ledgerIndex uint64) bool {
+	c.rangesMu.RLock()
+	defer c.rangesMu.RUnlock()
+
+	if len(c.completeLedgers) == 0 {
+		return true
+	}
+
+	var newest uint64
+	for _, lr := range c.completeLedgers {
+		newest = max(newest, lr.End)
+	}
+	if ledgerIndex > newest {
+		return true
+	}
+
+	return c.completeLedgers.Contains(ledgerIndex)
+}
+
+// CompleteLedgersAge returns how long ago the ledger ranges were refreshed, or false when never
+func (c *Client) CompleteLedgersAge() (time.Duration, bool) {
+	c.rangesMu.RLock()
+	defer c.rangesMu.RUnlock()
+
+	if c.completeLedgersAt.IsZero() {
+		return 0, false
+	}
+
+	return time.Since(c.completeLedgersAt), true
 }
@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/xrpl-commons/firehose-xrpl/types"
+)
+
+// ClientInterface is what the Fetcher needs from an XRPL endpoint. Client implements it, against a
+// live rippled or against recorded responses served by a ReplayHandler, so Fetch can run offline
+type ClientInterface interface {
+	// Endpoint returns the URL requests are sent to, for logs and errors
+	Endpoint() string
+
+	GetLatestLedger(ctx context.Context) (*types.LedgerClosedResult, error)
+	GetLedger(ctx context.Context, ledgerIndex uint64) (*types.LedgerResult, error)
+	GetLedgerHeader(ctx context.Context, ledgerIndex uint64) (*types.Ledger, error)
+	GetLedgerEntry(ctx context.Context, index string, ledgerIndex uint64) (map[string]any, error)
+	GetServerInfo(ctx context.Context) (*types.ServerInfoResult, error)
+
+	// CanServe and CompleteLedgersAge report the ledger range retained by the endpoint, as of
+	// the last GetServerInfo
+	CanServe(ledgerIndex uint64) bool
+	CompleteLedgersAge() (time.Duration, bool)
+
+	// Quarantined and HealthScore report the endpoint health from its recent requests
+	Quarantined() bool
+	HealthScore() uint64
+}
+
+var _ ClientInterface = (*Client)(nil)
@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xrpl-commons/firehose-xrpl/types"
+)
+
+// fakeClient is an in-memory ClientInterface serving ledgers and the hashes of ledger headers
+type fakeClient struct {
+	endpoint    string
+	ledger      *types.LedgerResult
+	ledgers     map[uint64]*types.LedgerResult // Served alongside ledger, for batches
+	hashes      map[uint64]string
+	quarantined bool
+}
+
+func (c *fakeClient) Endpoint() string { return c.endpoint }
+
+func (c *fakeClient) GetLatestLedger(context.Context) (*types.LedgerClosedResult, error) {
+	if c.ledger == nil {
+		return nil, fmt.Errorf("no ledger")
+	}
+	return &types.LedgerClosedResult{LedgerIndex: c.ledger.LedgerIndex, LedgerHash: c.ledger.LedgerHash}, nil
+}
+
+func (c *fakeClient) GetLedger(_ context.Context, ledgerIndex uint64) (*types.LedgerResult, error) {
+	if ledger, ok := c.ledgers[ledgerIndex]; ok {
+		return ledger, nil
+	}
+	if c.ledger == nil || c.ledger.LedgerIndex != ledgerIndex {
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, ErrLedgerNotFound)
+	}
+	return c.ledger, nil
+}
+
+func (c *fakeClient) GetLedgerHeader(_ context.Context, ledgerIndex uint64) (*types.Ledger, error) {
+	hash, ok := c.hashes[ledgerIndex]
+	if !ok {
+		return nil, fmt.Errorf("ledger %d: %w", ledgerIndex, ErrLedgerNotFound)
+	}
+	return &types.Ledger{LedgerIndex: ledgerIndex, LedgerHash: hash}, nil
+}
+
+func (c *fakeClient) GetLedgerEntry(context.Context, string, uint64) (map[string]any, error) {
+	return nil, ErrEntryNotFound
+}
+
+func (c *fakeClient) GetServerInfo(context.Context) (*types.ServerInfoResult, error) {
+	return &types.ServerInfoResult{}, nil
+}
+
+func (c *fakeClient) CanServe(uint64) bool                      { return true }
+func (c *fakeClient) CompleteLedgersAge() (time.Duration, bool) { return 0, false }
+func (c *fakeClient) Quarantined() bool                         { return c.quarantined }
+func (c *fakeClient) HealthScore() uint64                       { return 0 }
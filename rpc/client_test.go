@@ -0,0 +1,392 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPostJSONResendsBodyOnRetry(t *testing.T) {
+	const body = `{"method":"server_info","params":[{}]}`
+
+	tests := []struct {
+		name      string
+		failures  int
+		wantCalls int
+	}{
+		{"first attempt", 0, 1},
+		{"after a busy response", 1, 2},
+		{"after two busy responses", 2, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var received []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+
+				mu.Lock()
+				received = append(received, string(data))
+				attempt := len(received)
+				mu.Unlock()
+
+				if attempt <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = io.WriteString(w, `{"result":{"status":"success"}}`)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(3, 0))
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			var out map[string]any
+			require.NoError(t, client.postJSON(ctx, []byte(body), &out))
+
+			require.Len(t, received, tt.wantCalls)
+			for _, got := range received {
+				assert.Equal(t, body, got)
+			}
+		})
+	}
+}
+
+// attemptRecorder records every response decoded into it, failing on truncated ones
+// after it has already been written to
+type attemptRecorder struct {
+	Decoded []string
+}
+
+func (r *attemptRecorder) UnmarshalJSON(data []byte) error {
+	var response struct {
+		Name      string `json:"name"`
+		Truncated bool   `json:"truncated"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+
+	r.Decoded = append(r.Decoded, response.Name)
+	if response.Truncated {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func TestPostJSONDiscardsFailedAttempt(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		attempt := calls
+		mu.Unlock()
+
+		if attempt == 1 {
+			_, _ = io.WriteString(w, `{"name":"first","truncated":true}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"name":"second"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(1, 0))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var out attemptRecorder
+	require.NoError(t, client.postJSON(ctx, []byte(`{"method":"ledger","params":[{}]}`), &out))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"second"}, out.Decoded, "the failed attempt must not leave its fields behind")
+}
+
+func TestCanServe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"result":{"status":"success","info":{"complete_ledgers":"32570-88888,90000-90100"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop())
+	require.NoError(t, err)
+
+	// Optimistic until the ranges are known
+	assert.True(t, client.CanServe(100))
+	_, known := client.CompleteLedgersAge()
+	assert.False(t, known)
+
+	_, err = client.GetServerInfo(context.Background())
+	require.NoError(t, err)
+	_, known = client.CompleteLedgersAge()
+	assert.True(t, known)
+
+	tests := []struct {
+		name   string
+		ledger uint64
+		want   bool
+	}{
+		{"before the history", 100, false},
+		{"first retained", 32570, true},
+		{"in the hole", 89000, false},
+		{"last retained", 90100, true},
+		{"newer than the ranges", 90101, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, client.CanServe(tt.ledger))
+		})
+	}
+}
+
+// historyClient is a fakeClient retaining a fixed range of ledgers
+type historyClient struct {
+	*fakeClient
+	first, last uint64
+}
+
+func (c *historyClient) CanServe(ledgerIndex uint64) bool {
+	return ledgerIndex >= c.first && ledgerIndex <= c.last
+}
+
+func TestCheckCanServe(t *testing.T) {
+	client := &historyClient{fakeClient: &fakeClient{endpoint: "memory"}, first: 90000000, last: 90000100}
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+
+	assert.NoError(t, fetcher.checkCanServe(context.Background(), client, 90000050))
+
+	err := fetcher.checkCanServe(context.Background(), client, 32570)
+	assert.ErrorIs(t, err, ErrLedgerNotServed)
+	assert.NotErrorIs(t, err, ErrLedgerNotFound)
+}
+
+func TestGetLedgerByHash(t *testing.T) {
+	const (
+		hash      = "CDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCD"
+		misrouted = "EFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEFEF"
+	)
+
+	fixture, err := os.ReadFile("testdata/replay/ledger_90000001.json")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Params []struct {
+				LedgerHash string `json:"ledger_hash"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		switch strings.ToUpper(request.Params[0].LedgerHash) {
+		case hash, misrouted:
+			// A misrouted request is answered with another ledger
+			_, _ = w.Write(fixture)
+		default:
+			_, _ = io.WriteString(w, `{"result":{"error":"lgrNotFound","error_code":21,"error_message":"ledgerNotFound","status":"error"}}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(0, 0))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		hash         string
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{"found", hash, false, false},
+		{"lowercase hash", strings.ToLower(hash), false, false},
+		{"node answers another ledger", misrouted, false, true},
+		{"unknown hash", strings.Repeat("00", 32), true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.GetLedgerByHash(context.Background(), tt.hash)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantNotFound, errors.Is(err, ErrLedgerNotFound), "err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint64(90000001), result.LedgerIndex)
+			assert.Len(t, result.Ledger.Transactions, 1)
+		})
+	}
+}
+
+func TestGetLedgerJSON(t *testing.T) {
+	const (
+		apiV1 = `{"result":{"ledger":{"transactions":[{"hash":"D739","TransactionType":"Payment","Fee":"12","metaData":{"TransactionResult":"tesSUCCESS"}}]},"ledger_index":90000001,"validated":true}}`
+		apiV2 = `{"result":{"ledger":{"transactions":[{"hash":"D739","tx_json":{"TransactionType":"Payment","Fee":"12"},"meta":{"TransactionResult":"tesSUCCESS"}}]},"ledger_index":90000001,"validated":true}}`
+	)
+
+	tests := []struct {
+		name     string
+		response string
+		wantTx   map[string]any
+		wantErr  bool
+	}{
+		// API v1 inlines the hash with the transaction fields, as rippled renders them
+		{"API v1", apiV1, map[string]any{"hash": "D739", "TransactionType": "Payment", "Fee": "12"}, false},
+		{"API v2", apiV2, map[string]any{"TransactionType": "Payment", "Fee": "12"}, false},
+		{"not validated", `{"result":{"ledger":{},"ledger_index":90000001,"validated":false}}`, nil, true},
+		{"rpc error", `{"result":{"error":"lgrNotFound","error_code":21,"status":"error"}}`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var request struct {
+					Params []struct {
+						Expand bool `json:"expand"`
+						Binary bool `json:"binary"`
+					} `json:"params"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+				assert.True(t, request.Params[0].Expand)
+				assert.False(t, request.Params[0].Binary)
+
+				_, _ = io.WriteString(w, tt.response)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(0, 0))
+			require.NoError(t, err)
+
+			result, err := client.GetLedgerJSON(context.Background(), 90000001)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			txs := result.Transactions()
+			require.Len(t, txs, 1)
+			assert.Equal(t, "D739", txs[0].Hash)
+			assert.Equal(t, tt.wantTx, txs[0].Tx)
+			assert.Equal(t, map[string]any{"TransactionResult": "tesSUCCESS"}, txs[0].Meta)
+		})
+	}
+}
+
+func TestGetLedgerDataPages(t *testing.T) {
+	const marker = "B7E8F5D1C2A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D"
+
+	accountRoot := func(account string, balance string) string {
+		data, err := binarycodec.Encode(map[string]interface{}{
+			"LedgerEntryType":   "AccountRoot",
+			"Account":           account,
+			"Balance":           balance,
+			"Flags":             uint32(0),
+			"OwnerCount":        uint32(0),
+			"PreviousTxnID":     strings.Repeat("D7", 32),
+			"PreviousTxnLgrSeq": uint32(90000000),
+			"Sequence":          uint32(5),
+		})
+		require.NoError(t, err)
+		return data
+	}
+	page := func(index, data string, next string) string {
+		markerField := ""
+		if next != "" {
+			markerField = `,"marker":"` + next + `"`
+		}
+		return `{"result":{"ledger_hash":"` + strings.Repeat("CD", 32) + `","ledger_index":"90000001","state":[{"data":"` + data + `","index":"` + index + `"}]` + markerField + `,"validated":true,"status":"success"}}`
+	}
+	pages := map[string]string{
+		"":     page(strings.Repeat("11", 32), accountRoot("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "1000000"), marker),
+		marker: page(strings.Repeat("22", 32), accountRoot("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "2000000"), ""),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+			Params []struct {
+				LedgerIndex uint64 `json:"ledger_index"`
+				Binary      bool   `json:"binary"`
+				Marker      string `json:"marker"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "ledger_data", request.Method)
+		assert.Equal(t, uint64(90000001), request.Params[0].LedgerIndex)
+		assert.True(t, request.Params[0].Binary)
+
+		response, ok := pages[request.Params[0].Marker]
+		if !ok {
+			response = `{"result":{"error":"invalidParams","error_code":31,"error_message":"Invalid field 'marker'.","status":"error"}}`
+		}
+		_, _ = io.WriteString(w, response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(0, 0))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		marker      any
+		wantIndex   string
+		wantAccount string
+		wantMarker  any
+	}{
+		{"first page", nil, strings.Repeat("11", 32), "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", marker},
+		{"last page", marker, strings.Repeat("22", 32), "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := client.GetLedgerData(context.Background(), 90000001, tt.marker)
+			require.NoError(t, err)
+
+			assert.Equal(t, strings.Repeat("CD", 32), page.LedgerHash)
+			assert.Equal(t, uint64(90000001), page.LedgerIndex)
+			require.Len(t, page.Objects, 1)
+			assert.Equal(t, tt.wantIndex, page.Objects[0].Index)
+			assert.Equal(t, "AccountRoot", page.Objects[0].Fields["LedgerEntryType"])
+			assert.Equal(t, tt.wantAccount, page.Objects[0].Fields["Account"])
+			assert.Equal(t, tt.wantMarker, page.Marker)
+		})
+	}
+
+	// Walking the pages passes each marker back as received
+	var accounts []any
+	var next any
+	for {
+		page, err := client.GetLedgerData(context.Background(), 90000001, next)
+		require.NoError(t, err)
+		for _, object := range page.Objects {
+			accounts = append(accounts, object.Fields["Account"])
+		}
+		if next = page.Marker; next == nil {
+			break
+		}
+	}
+	assert.Equal(t, []any{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}, accounts)
+
+	_, err = client.GetLedgerData(context.Background(), 90000001, "unknown")
+	var rpcErr *RPCLedgerError
+	assert.ErrorAs(t, err, &rpcErr)
+}
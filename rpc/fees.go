@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+)
+
+// flagLedgerInterval is the spacing of flag ledgers, fees only change in the ledger following one
+const flagLedgerInterval = 256
+
+// maxCachedFeePeriods bounds how many flag ledger periods keep their fee settings cached
+const maxCachedFeePeriods = 4
+
+// feeSettings are the fee and reserve settings of a ledger, in drops
+type feeSettings struct {
+	baseFee          uint64
+	reserveBase      uint64
+	reserveIncrement uint64
+}
+
+// feePeriod returns the fee period of a ledger: validators vote on fees in flag ledger 256k and
+// rippled adds the resulting SetFee pseudo-transaction to ledger 256k+1, so ledgers 256k+1 through
+// 256(k+1) share period k and the flag ledger itself still has the fees of the period before
+func feePeriod(ledgerIndex uint64) uint64 {
+	if ledgerIndex == 0 {
+		return 0
+	}
+	return (ledgerIndex - 1) / flagLedgerInterval
+}
+
+// feeCache caches fee settings by fee period (see feePeriod), safe for concurrent use
+type feeCache struct {
+	mu       sync.Mutex
+	settings map[uint64]feeSettings
+}
+
+func newFeeCache() *feeCache {
+	return &feeCache{settings: make(map[uint64]feeSettings)}
+}
+
+func (c *feeCache) get(period uint64) (feeSettings, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	settings, ok := c.settings[period]
+	return settings, ok
+}
+
+// put caches settings, evicting the oldest period once more than maxCachedFeePeriods are held
+func (c *feeCache) put(period uint64, settings feeSettings) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.settings[period] = settings
+	if len(c.settings) <= maxCachedFeePeriods {
+		return
+	}
+
+	oldest := period
+	for cached := range c.settings {
+		oldest = min(oldest, cached)
+	}
+	delete(c.settings, oldest)
+}
+
+// setFeeSettings fills the header fee and reserve settings in effect in ledgerIndex, resolving the
+// FeeSettings entry once per fee period since SetFee only applies after flag ledgers
+func (f *Fetcher) setFeeSettings(ctx context.Context, client ClientInterface, header *pbxrpl.Header, ledgerIndex uint64) error {
+	period := feePeriod(ledgerIndex)
+
+	settings, ok := f.fees.get(period)
+	if !ok {
+		entry, err := client.GetLedgerEntry(ctx, utils.FeeSettingsIndex(), ledgerIndex)
+		switch {
+		case errors.Is(err, ErrEntryNotFound):
+			// Early ledgers predate the FeeSettings entry
+			f.logger.Debug("ledger has no fee settings", zap.Uint64("ledger_index", ledgerIndex))
+		case err != nil:
+			return fmt.Errorf("fetching fee settings of ledger %d: %w", ledgerIndex, err)
+		default:
+			settings, err = parseFeeSettings(entry)
+			if err != nil {
+				return fmt.Errorf("parsing fee settings of ledger %d: %w", ledgerIndex, err)
+			}
+		}
+		f.fees.put(period, settings)
+	}
+
+	header.BaseFee = settings.baseFee
+	header.ReserveBase = settings.reserveBase
+	header.ReserveIncrement = settings.reserveIncrement
+
+	return nil
+}
+
+// parseFeeSettings reads a FeeSettings entry, in drops since the XRPFees amendment
+// (BaseFeeDrops, ...) or in the legacy format (BaseFee as UInt64 hex, ReserveBase, ReserveIncrement)
+func parseFeeSettings(entry map[string]any) (feeSettings, error) {
+	if _, ok := entry["BaseFeeDrops"]; ok {
+		var settings feeSettings
+		for field, out := range map[string]*uint64{
+			"BaseFeeDrops":          &settings.baseFee,
+			"ReserveBaseDrops":      &settings.reserveBase,
+			"ReserveIncrementDrops": &settings.reserveIncrement,
+		} {
+			drops, _ := entry[field].(string)
+			value, err := strconv.ParseUint(drops, 10, 64)
+			if err != nil {
+				return feeSettings{}, fmt.Errorf("invalid %s %v: %w", field, entry[field], err)
+			}
+			*out = value
+		}
+		return settings, nil
+	}
+
+	baseFeeHex, _ := entry["BaseFee"].(string)
+	baseFee, err := strconv.ParseUint(baseFeeHex, 16, 64)
+	if err != nil {
+		return feeSettings{}, fmt.Errorf("invalid BaseFee %v: %w", entry["BaseFee"], err)
+	}
+
+	reserveBase, _ := numberFromJSON(entry["ReserveBase"])
+	reserveIncrement, _ := numberFromJSON(entry["ReserveIncrement"])
+
+	return feeSettings{
+		baseFee:          baseFee,
+		reserveBase:      reserveBase,
+		reserveIncrement: reserveIncrement,
+	}, nil
+}
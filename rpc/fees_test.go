@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+)
+
+func TestFeePeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		ledgerIndex uint64
+		want        uint64
+	}{
+		{"ledger 0", 0, 0},
+		{"first ledger", 1, 0},
+		{"first flag ledger", 256, 0},
+		{"ledger holding the first SetFee", 257, 1},
+		{"second flag ledger", 512, 1},
+		{"ledger holding the second SetFee", 513, 2},
+		{"mainnet flag ledger", 90000128, 351562},
+		{"mainnet ledger after the flag ledger", 90000129, 351563},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, feePeriod(tt.ledgerIndex))
+		})
+	}
+}
+
+func TestParseFeeSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   map[string]any
+		want    feeSettings
+		wantErr bool
+	}{
+		{
+			name:  "drops",
+			entry: map[string]any{"BaseFeeDrops": "10", "ReserveBaseDrops": "1000000", "ReserveIncrementDrops": "200000"},
+			want:  feeSettings{baseFee: 10, reserveBase: 1000000, reserveIncrement: 200000},
+		},
+		{
+			name:  "legacy",
+			entry: map[string]any{"BaseFee": "000000000000000A", "ReferenceFeeUnits": float64(10), "ReserveBase": float64(20000000), "ReserveIncrement": float64(5000000)},
+			want:  feeSettings{baseFee: 10, reserveBase: 20000000, reserveIncrement: 5000000},
+		},
+		{
+			name:    "invalid drops",
+			entry:   map[string]any{"BaseFeeDrops": "10", "ReserveBaseDrops": "ten", "ReserveIncrementDrops": "200000"},
+			wantErr: true,
+		},
+		{
+			name:    "missing drops field",
+			entry:   map[string]any{"BaseFeeDrops": "10", "ReserveBaseDrops": "1000000"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid legacy base fee",
+			entry:   map[string]any{"BaseFee": "not hex", "ReserveBase": float64(20000000), "ReserveIncrement": float64(5000000)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFeeSettings(tt.entry)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFeeCacheEviction(t *testing.T) {
+	cache := newFeeCache()
+	for period := uint64(1); period <= maxCachedFeePeriods+2; period++ {
+		cache.put(period, feeSettings{baseFee: period})
+	}
+
+	assert.Len(t, cache.settings, maxCachedFeePeriods)
+	for period := uint64(1); period <= 2; period++ {
+		_, ok := cache.get(period)
+		assert.False(t, ok, "period %d should be evicted", period)
+	}
+	settings, ok := cache.get(maxCachedFeePeriods + 2)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(maxCachedFeePeriods+2), settings.baseFee)
+}
+
+// feeSettingsClient serves a FeeSettings entry in drops, recording the ledgers it was requested at
+type feeSettingsClient struct {
+	fakeClient
+	requested []uint64
+}
+
+func (c *feeSettingsClient) GetLedgerEntry(_ context.Context, index string, ledgerIndex uint64) (map[string]any, error) {
+	if index != utils.FeeSettingsIndex() {
+		return nil, ErrEntryNotFound
+	}
+	c.requested = append(c.requested, ledgerIndex)
+	return map[string]any{
+		"BaseFeeDrops":          "10",
+		"ReserveBaseDrops":      "1000000",
+		"ReserveIncrementDrops": "200000",
+	}, nil
+}
+
+func TestSetFeeSettingsOnePerPeriod(t *testing.T) {
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	defer fetcher.Close()
+
+	client := &feeSettingsClient{fakeClient: fakeClient{endpoint: "memory"}}
+
+	// 90000001 through 90000128 share a period, the flag ledger 90000128 included, 90000129 starts the next
+	for _, ledgerIndex := range []uint64{90000001, 90000050, 90000128, 90000129, 90000200} {
+		var header pbxrpl.Header
+		require.NoError(t, fetcher.setFeeSettings(context.Background(), client, &header, ledgerIndex))
+		assert.Equal(t, uint64(10), header.BaseFee)
+		assert.Equal(t, uint64(1000000), header.ReserveBase)
+		assert.Equal(t, uint64(200000), header.ReserveIncrement)
+	}
+
+	assert.Equal(t, []uint64{90000001, 90000129}, client.requested)
+}
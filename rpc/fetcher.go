@@ -4,15 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
 	"github.com/xrpl-commons/firehose-xrpl/decoder"
 	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/types"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,6 +26,9 @@ import (
 // This is 946684800 seconds after Unix epoch (January 1, 1970)
 const xrplEpochOffset = 946684800
 
+// genesisLedgerIndex is the first ledger of an XRPL chain, it has no parent ledger
+const genesisLedgerIndex = 1
+
 // Buffer pools for reducing memory allocations
 var (
 	// bufferPool reuses byte buffers for hex decoding operations
@@ -79,23 +87,83 @@ func decodeHex(hexStr string) ([]byte, error) {
 	return result[:n], nil
 }
 
-// LastBlockInfo tracks the latest fetched block information
+// latestLedgerTTL is how long a latest-ledger poll result is shared with concurrent fetches
+// It is kept below typical poll intervals so a single fetch still polls on every iteration
+const latestLedgerTTL = 250 * time.Millisecond
+
+// LastBlockInfo tracks the latest validated ledger, shared by all fetches of a Fetcher
+// so concurrent fetches of a batch reuse one latest-ledger poll instead of each polling
 type LastBlockInfo struct {
-	blockNum uint64
+	blockNum atomic.Uint64
+
+	poll     chan struct{} // Held while polling, serializing polls
+	polledAt time.Time     // Guarded by poll
 }
 
 // NewLastBlockInfo creates a new LastBlockInfo
 func NewLastBlockInfo() *LastBlockInfo {
-	return &LastBlockInfo{}
+	return &LastBlockInfo{poll: make(chan struct{}, 1)}
 }
 
+// latest returns the latest known validated ledger
+func (l *LastBlockInfo) latest() uint64 {
+	return l.blockNum.Load()
+}
+
+// advance raises the latest known validated ledger to num, never lowering it, and returns the result
+func (l *LastBlockInfo) advance(num uint64) uint64 {
+	for {
+		current := l.blockNum.Load()
+		if num <= current {
+			return current
+		}
+		if l.blockNum.CompareAndSwap(current, num) {
+			return num
+		}
+	}
+}
+
+// defaultLatestLedgerRetries is how many times a failed latest-ledger poll is retried before Fetch gives up
+const defaultLatestLedgerRetries = 3
+
+// completeLedgersTTL is how long an endpoint's complete_ledgers is trusted before being refreshed
+const completeLedgersTTL = 5 * time.Minute
+
+// maxLatestLedgerBackoff caps the delay between latest-ledger poll retries
+const maxLatestLedgerBackoff = 10 * time.Second
+
 // Fetcher handles fetching XRPL ledgers and converting them to Firehose blocks
 type Fetcher struct {
 	fetchInterval            time.Duration
 	latestBlockRetryInterval time.Duration
 	lastBlockInfo            *LastBlockInfo
 	decoder                  *decoder.Decoder
-	workerPoolSize           int
+	latestLedgerRetries      int
+	adaptivePolling          bool
+	blockIDEncoding          BlockIDEncoding
+	maxBlockSize             int
+	failOnOversizedBlock     bool
+	allowGapSkipping         bool
+	verifyParentHash         bool
+	txFilter                 *txTypeFilter
+	unmapped                 unmappedTypes
+	validateMultisign        bool
+	largeMetaThreshold       int
+	maxTxSize                int
+	profileTxDecoding        bool
+	endpoints                []ClientInterface
+	gaps                     *ledgerGaps
+	fees                     *feeCache
+	notifier                 *ledgerNotifier
+	drainMu                  sync.Mutex // Orders inFlight.Add before Drain's Wait
+	draining                 atomic.Bool
+	inFlight                 sync.WaitGroup
+	cadence                  closeCadence
+	txPool                   *txPool
+	stats                    *FetchStats
+	startTime                time.Time
+	blocksProcessed          atomic.Uint64
+	transactionsProcessed    atomic.Uint64
 
 	logger *zap.Logger
 }
@@ -107,7 +175,13 @@ func NewFetcher(fetchInterval, latestBlockRetryInterval time.Duration, logger *z
 		latestBlockRetryInterval: latestBlockRetryInterval,
 		lastBlockInfo:            NewLastBlockInfo(),
 		decoder:                  decoder.NewDecoder(logger),
-		workerPoolSize:           10, // Default worker pool size
+		latestLedgerRetries:      defaultLatestLedgerRetries,
+		txPool:                   newTxPool(10), // Default worker pool size
+		blockIDEncoding:          BlockIDHexLower,
+		gaps:                     newLedgerGaps(),
+		fees:                     newFeeCache(),
+		stats:                    NewFetchStats(),
+		startTime:                time.Now(),
 		logger:                   logger,
 	}
 }
@@ -119,128 +193,302 @@ func NewFetcherWithWorkerPool(fetchInterval, latestBlockRetryInterval time.Durat
 		latestBlockRetryInterval: latestBlockRetryInterval,
 		lastBlockInfo:            NewLastBlockInfo(),
 		decoder:                  decoder.NewDecoder(logger),
-		workerPoolSize:           workerPoolSize,
+		latestLedgerRetries:      defaultLatestLedgerRetries,
+		txPool:                   newTxPool(workerPoolSize),
+		blockIDEncoding:          BlockIDHexLower,
+		gaps:                     newLedgerGaps(),
+		fees:                     newFeeCache(),
+		stats:                    NewFetchStats(),
+		startTime:                time.Now(),
 		logger:                   logger,
 	}
 }
 
+// SetLatestLedgerRetries sets how many times a failed latest-ledger poll is retried while waiting for a ledger
+func (f *Fetcher) SetLatestLedgerRetries(retries int) {
+	f.latestLedgerRetries = retries
+}
+
+// SetAdaptivePolling spaces latest-ledger polls by the observed ledger close cadence once caught up to the tip,
+// instead of polling every latestBlockRetryInterval
+func (f *Fetcher) SetAdaptivePolling(enabled bool) {
+	f.adaptivePolling = enabled
+}
+
+// SetBlockIDEncoding sets how ledger hashes are encoded into block Id and ParentId (hex-lower by default)
+func (f *Fetcher) SetBlockIDEncoding(encoding BlockIDEncoding) {
+	f.blockIDEncoding = encoding
+}
+
+// SetIncludeDecodedMeta attaches the decoded metadata to each transaction as a Struct
+func (f *Fetcher) SetIncludeDecodedMeta(enabled bool) {
+	f.decoder.SetIncludeDecodedMeta(enabled)
+}
+
+// SetBlockSizeLimit warns when a marshaled block exceeds maxBytes (0 disables the check),
+// and fails the fetch instead when fail is set
+func (f *Fetcher) SetBlockSizeLimit(maxBytes int, fail bool) {
+	f.maxBlockSize = maxBytes
+	f.failOnOversizedBlock = fail
+}
+
+// SetLargeMetaThreshold warns about and counts transactions whose metadata exceeds maxBytes (0 disables the check)
+// Decoding such metadata into a map is the main source of memory spikes on large AMM and Batch transactions
+func (f *Fetcher) SetLargeMetaThreshold(maxBytes int) {
+	f.largeMetaThreshold = maxBytes
+}
+
+// SetMaxTxSize skips, with a warning, transactions whose blob or metadata exceeds maxBytes (0 disables the check)
+// It keeps a single pathological transaction from being decoded into memory
+func (f *Fetcher) SetMaxTxSize(maxBytes int) {
+	f.maxTxSize = maxBytes
+}
+
+// SetProfileTxDecoding records how long each transaction takes to decode and map, by transaction type,
+// in the tx_decode_duration_seconds metric and the end-of-run summary. Off by default, it reads the clock
+// twice per transaction
+func (f *Fetcher) SetProfileTxDecoding(enabled bool) {
+	f.profileTxDecoding = enabled
+}
+
+// Close stops the shared transaction worker pool, the Fetcher must not be used afterwards
+// A fetch still mapping a ledger when Close is called fails with errPoolClosed
+func (f *Fetcher) Close() {
+	f.txPool.close()
+}
+
 // Fetch retrieves a ledger by number and converts it to a bstream Block
-func (f *Fetcher) Fetch(ctx context.Context, client *Client, requestBlockNum uint64) (b *pbbstream.Block, skipped bool, err error) {
+func (f *Fetcher) Fetch(ctx context.Context, client ClientInterface, requestBlockNum uint64) (b *pbbstream.Block, skipped bool, err error) {
+	if !f.beginFetch() {
+		return nil, false, errFetcherDraining
+	}
+	defer f.inFlight.Done()
+
+	if err := f.checkQuarantine(client); err != nil {
+		return nil, false, err
+	}
+
+	xrplBlock, err := f.FetchLedgerBlock(ctx, client, requestBlockNum)
+	if err != nil {
+		if f.allowGapSkipping && errors.Is(err, ErrLedgerNotFound) {
+			if !f.confirmLedgerMissing(ctx, client, requestBlockNum) {
+				return nil, false, fmt.Errorf("%s may not have caught up to ledger %d yet: %w", client.Endpoint(), requestBlockNum, err)
+			}
+			f.skipLedger(requestBlockNum, err)
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+
+	bstreamBlock, err := convertBlock(xrplBlock, f.blockIDEncoding)
+	if err != nil {
+		return nil, false, fmt.Errorf("converting block: %w", err)
+	}
+
+	if f.allowGapSkipping {
+		if err := f.linkAcrossGap(ctx, client, bstreamBlock); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if f.verifyParentHash {
+		if err := f.checkParentHash(ctx, client, bstreamBlock); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := f.checkBlockSize(bstreamBlock); err != nil {
+		return nil, false, err
+	}
+
+	f.blocksProcessed.Add(1)
+	f.transactionsProcessed.Add(uint64(len(xrplBlock.Transactions)))
+	ledgersFetched.Inc()
+	transactionsMapped.AddInt(len(xrplBlock.Transactions))
+	f.recordTipLag(requestBlockNum)
+
+	return bstreamBlock, false, nil
+}
+
+// FetchLedgerBlock retrieves a ledger by number and decodes it to an XRPL Block
+func (f *Fetcher) FetchLedgerBlock(ctx context.Context, client ClientInterface, requestBlockNum uint64) (*pbxrpl.Block, error) {
 	// Add context with block number for better logging
 	ctx = context.WithValue(ctx, "block_num", requestBlockNum)
 	f.logger.Debug("starting fetch for block", zap.Uint64("block_num", requestBlockNum))
 	// 1. Poll until the requested ledger is validated
 	blockStartTime := time.Now()
 	sleepDuration := time.Duration(0)
-	for f.lastBlockInfo.blockNum < requestBlockNum {
-		time.Sleep(sleepDuration)
-
-		latestLedger, err := client.GetLatestLedger(ctx)
+	for f.lastBlockInfo.latest() < requestBlockNum {
+		notified, err := f.waitForLedger(ctx, sleepDuration, requestBlockNum)
 		if err != nil {
-			return nil, false, fmt.Errorf("fetching latest ledger: %w", err)
+			return nil, err
+		}
+		if f.draining.Load() {
+			return nil, errFetcherDraining
+		}
+		if notified >= requestBlockNum {
+			// Announced on the ledger subscription, no need to poll
+			f.lastBlockInfo.advance(notified)
+			f.cadence.observe(notified, time.Now())
+			break
 		}
 
-		f.lastBlockInfo.blockNum = latestLedger.LedgerIndex
-		f.logger.Info("got latest validated ledger",
-			zap.Uint64("latest_ledger", f.lastBlockInfo.blockNum),
-			zap.Uint64("requested_ledger", requestBlockNum))
+		latest, err := f.pollLatestLedger(ctx, client, requestBlockNum)
+		if err != nil {
+			return nil, fmt.Errorf("fetching latest ledger from %s: %w", client.Endpoint(), err)
+		}
 
-		if f.lastBlockInfo.blockNum >= requestBlockNum {
+		if latest >= requestBlockNum {
 			break
 		}
 		sleepDuration = f.latestBlockRetryInterval
+		if f.adaptivePolling {
+			sleepDuration = f.cadence.nextPollDelay(time.Now(), f.latestBlockRetryInterval)
+		}
+	}
+
+	// 2. Make sure this endpoint retains the ledger, so the poller can rotate to one that does
+	if err := f.checkCanServe(ctx, client, requestBlockNum); err != nil {
+		return nil, err
 	}
 
-	// 2. Fetch the ledger with all transactions
+	// 3. Fetch the ledger with all transactions
 	ledgerResult, err := client.GetLedger(ctx, requestBlockNum)
 	if err != nil {
-		return nil, false, fmt.Errorf("fetching ledger %d: %w", requestBlockNum, err)
+		return nil, fmt.Errorf("fetching ledger %d from %s: %w", requestBlockNum, client.Endpoint(), err)
+	}
+	if err := checkLedgerResult(client, requestBlockNum, ledgerResult); err != nil {
+		return nil, err
 	}
 	ledger := ledgerResult.Ledger
 
-	// 3. Build transactions from the ledger data using parallel processing
+	// 4. Build transactions from the ledger data on the shared worker pool
+	// The pool bounds concurrency across all in-flight fetches of a batch
+	// Each worker writes only its own index, so no locking is needed
 	transactions := make([]*pbxrpl.Transaction, len(ledger.Transactions))
+	txErrs := make([]error, len(ledger.Transactions))
+	mapErrs := make([]error, len(ledger.Transactions))
+	oversized := make([]bool, len(ledger.Transactions))
+	indexUnknown := make([]bool, len(ledger.Transactions))
 	var wg sync.WaitGroup
-	errChan := make(chan error, len(ledger.Transactions))
-
-	// Use worker pool pattern for parallel processing
-	workerCount := f.workerPoolSize
-	if len(ledger.Transactions) < workerCount {
-		workerCount = len(ledger.Transactions)
-	}
-	if workerCount == 0 {
-		workerCount = 1 // Ensure at least one worker
-	}
-
-	// Use index-only channel for zero-copy work distribution
-	// Buffer size matches worker pool for optimal throughput without memory spike
-	txChan := make(chan int, workerCount)
 
-	// Start worker pool
-	for w := 0; w < workerCount; w++ {
+	for i := range ledger.Transactions {
 		wg.Add(1)
-		go func() {
+		err := f.txPool.submit(func() {
 			defer wg.Done()
-			for i := range txChan {
-				// Access transaction directly from original slice (zero-copy)
-				tx := &ledger.Transactions[i]
-
-				// Decode hash (still needed for protobuf)
-				txHash, err := decodeHex(tx.Hash)
-				if err != nil {
-					errChan <- fmt.Errorf("decoding tx hash at index %d: %w", i, err)
-					continue
-				}
-
-				// Pass hex strings directly - no unnecessary byte conversion
-				protoTx, err := f.decoder.MapTransactionToProto(tx.TxBlob, tx.Meta, txHash, uint32(i))
-				if err != nil {
-					f.logger.Warn("failed to map transaction to protobuf, skipping",
-						zap.Int("tx_index", i),
-						zap.String("tx_hash", tx.Hash),
-						zap.Error(err))
-					continue
-				}
-
-				transactions[i] = protoTx
+
+			// Access transaction directly from original slice (zero-copy)
+			tx := &ledger.Transactions[i]
+
+			// Decode hash (still needed for protobuf)
+			txHash, err := decodeHex(tx.Hash)
+			if err != nil {
+				txErrs[i] = fmt.Errorf("decoding tx hash %q at index %d: %w", tx.Hash, i, err)
+				return
 			}
-		}()
-	}
 
-	// Feed transaction indices to workers (producer runs inline for simplicity)
-	for i := range ledger.Transactions {
-		txChan <- i
+			if f.maxTxSize > 0 && (len(tx.TxBlob)/2 > f.maxTxSize || len(tx.Meta)/2 > f.maxTxSize) {
+				f.stats.recordOversized()
+				oversizedTransactions.Inc()
+				f.logger.Warn("skipping transaction exceeding the maximum transaction size",
+					zap.Int("tx_index", i),
+					zap.String("tx_hash", tx.Hash),
+					zap.Int("tx_bytes", len(tx.TxBlob)/2),
+					zap.Int("meta_bytes", len(tx.Meta)/2),
+					zap.Int("max_tx_size", f.maxTxSize))
+				oversized[i] = true
+				return
+			}
+
+			if f.largeMetaThreshold > 0 && len(tx.Meta)/2 > f.largeMetaThreshold {
+				f.stats.recordLargeMeta()
+				f.logger.Warn("transaction metadata exceeds size threshold",
+					zap.Int("tx_index", i),
+					zap.String("tx_hash", tx.Hash),
+					zap.Int("meta_bytes", len(tx.Meta)/2),
+					zap.Int("threshold", f.largeMetaThreshold))
+			}
+
+			var decodeStart time.Time
+			if f.profileTxDecoding {
+				decodeStart = time.Now()
+			}
+
+			// Decode once, the failure label below reuses the decoded type
+			decoded := f.decoder.Decode(tx.TxBlob, tx.Meta)
+			if !f.txFilter.keeps(decoded.Type()) {
+				f.stats.recordFiltered()
+				return
+			}
+
+			// rippled lists a ledger's transactions by hash, the metadata holds the order they were applied in
+			txIndex, ok := decoded.TransactionIndex()
+			indexUnknown[i] = !ok
+			protoTx, err := f.decoder.MapDecodedToProto(decoded, txHash, txIndex)
+			if err != nil {
+				f.stats.recordDecodeFailure()
+				decodeFailures.Inc(failedTxType(decoded))
+				f.logger.Warn("failed to map transaction to protobuf, skipping",
+					zap.Int("tx_index", i),
+					zap.String("tx_hash", tx.Hash),
+					zap.Error(err))
+				mapErrs[i] = fmt.Errorf("tx %s at index %d: %w", tx.Hash, i, err)
+				return
+			}
+
+			if f.profileTxDecoding {
+				f.recordDecodeDuration(protoTx.TxType, time.Since(decodeStart))
+			}
+
+			if protoTx.CodecOutdated {
+				codecOutdated.Inc()
+			}
+			if IsUnmapped(protoTx) {
+				f.recordUnmapped(protoTx, ledger.LedgerIndex)
+			}
+			protoTx.LedgerIndex = ledger.LedgerIndex
+			protoTx.CloseTime = timestamppb.New(xrplEpochToTime(ledger.CloseTime))
+			f.setExpiryWindow(protoTx, ledger.LedgerIndex)
+			transactions[i] = protoTx
+		})
+		if err != nil {
+			// The Fetcher was closed mid-ledger, the jobs already queued still run
+			wg.Done()
+			txErrs[i] = err
+			break
+		}
 	}
-	close(txChan)
 
-	// Wait for all workers to complete
+	// Wait for all of this ledger's transactions to complete
 	wg.Wait()
-	close(errChan)
 
-	// Check for any errors
-	if len(errChan) > 0 {
-		return nil, false, <-errChan
+	// Report every failed transaction, in ledger order
+	if err := errors.Join(txErrs...); err != nil {
+		return nil, fmt.Errorf("ledger %d: %w", requestBlockNum, err)
+	}
+	if err := errors.Join(mapErrs...); err != nil {
+		f.logger.Warn("skipped transactions that failed to map",
+			zap.Uint64("ledger_index", ledger.LedgerIndex),
+			zap.Int("failed", countErrors(mapErrs)),
+			zap.Error(err))
 	}
 
-	// Filter out nil transactions (failed mappings)
-	// Pre-allocate with capacity to avoid reallocation
-	validTransactions := make([]*pbxrpl.Transaction, 0, len(transactions))
-	for _, tx := range transactions {
-		if tx != nil {
-			validTransactions = append(validTransactions, tx)
-		}
+	transactions = orderTransactions(transactions, indexUnknown)
+
+	// The quorum checks request the endpoint, run them here so they don't hold pool workers
+	if f.validateMultisign {
+		f.checkMultisignQuorums(ctx, client, transactions, ledger.LedgerIndex)
 	}
-	transactions = validTransactions
 
-	// 4. Build the block header - sequential decoding is faster than goroutine overhead for small hashes
+	// 5. Build the block header - sequential decoding is faster than goroutine overhead for small hashes
 	ledgerHash, err := decodeHex(ledger.LedgerHash)
 	if err != nil {
-		return nil, false, fmt.Errorf("decoding ledger hash: %w", err)
+		return nil, fmt.Errorf("decoding ledger hash: %w", err)
 	}
 
 	parentHash, err := decodeHex(ledger.ParentHash)
 	if err != nil {
-		return nil, false, fmt.Errorf("decoding parent hash: %w", err)
+		return nil, fmt.Errorf("decoding parent hash: %w", err)
 	}
 
 	// Optional hashes - don't fail on error
@@ -266,7 +514,7 @@ func (f *Fetcher) Fetch(ctx context.Context, client *Client, requestBlockNum uin
 	// Convert XRPL epoch time to Unix time
 	closeTime := xrplEpochToTime(ledger.CloseTime)
 
-	// 5. Build the XRPL Block protobuf
+	// 6. Build the XRPL Block protobuf
 	xrplBlock := &pbxrpl.Block{
 		Number: ledger.LedgerIndex,
 		Hash:   ledgerHash,
@@ -277,55 +525,208 @@ func (f *Fetcher) Fetch(ctx context.Context, client *Client, requestBlockNum uin
 			TransactionHash:     transactionHash,
 			CloseTimeResolution: ledger.CloseTimeResolution,
 			CloseFlags:          ledger.CloseFlags,
+			TransactionCount:    uint32(len(ledger.Transactions)),
+			Filtered:            f.txFilter != nil,
+			// Marks the block as partial, consumers can't tell otherwise
+			SkippedTransactionCount: uint32(countTrue(oversized) + countErrors(mapErrs)),
 		},
 		Version:      1,
 		Transactions: transactions,
 		CloseTime:    timestamppb.New(closeTime),
 	}
 
-	// 6. Convert to bstream block
-	bstreamBlock, err := convertBlock(xrplBlock)
-	if err != nil {
-		return nil, false, fmt.Errorf("converting block: %w", err)
+	if err := f.setFeeSettings(ctx, client, xrplBlock.Header, ledger.LedgerIndex); err != nil {
+		return nil, err
 	}
 
+	f.stats.recordLedger(transactions)
+
 	f.logger.Info("fetched ledger",
 		zap.Uint64("ledger_index", ledger.LedgerIndex),
 		zap.Int("tx_count", len(transactions)),
 		zap.Time("close_time", closeTime),
 		zap.Duration("processing_time", time.Since(blockStartTime)))
 
-	return bstreamBlock, false, nil
+	return xrplBlock, nil
 }
 
-// Add performance monitoring variables
-var (
-	blocksProcessed       int
-	transactionsProcessed int
-	startTime             = time.Now()
-)
+// checkCanServe fails with ErrLedgerNotServed when the endpoint's complete_ledgers excludes the ledger,
+// refreshing the endpoint's ranges when older than completeLedgersTTL
+func (f *Fetcher) checkCanServe(ctx context.Context, client ClientInterface, ledgerIndex uint64) error {
+	if age, ok := client.CompleteLedgersAge(); !ok || age > completeLedgersTTL {
+		if _, err := client.GetServerInfo(ctx); err != nil {
+			f.logger.Debug("failed to refresh complete ledgers", zap.String("endpoint", client.Endpoint()), zap.Error(err))
+		}
+	}
+
+	if !client.CanServe(ledgerIndex) {
+		return fmt.Errorf("ledger %d from %s: %w", ledgerIndex, client.Endpoint(), ErrLedgerNotServed)
+	}
+
+	return nil
+}
 
-// GetPerformanceMetrics returns performance statistics
+// getLatestLedgerWithRetry polls the latest validated ledger, retrying transient failures with exponential backoff
+func (f *Fetcher) getLatestLedgerWithRetry(ctx context.Context, client ClientInterface) (*types.LedgerClosedResult, error) {
+	backoff := f.latestBlockRetryInterval
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	for attempt := 0; ; attempt++ {
+		latestLedger, err := client.GetLatestLedger(ctx)
+		if err == nil {
+			return latestLedger, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if attempt >= f.latestLedgerRetries {
+			return nil, err
+		}
+
+		f.logger.Warn("latest ledger poll failed, retrying",
+			zap.String("endpoint", client.Endpoint()),
+			zap.Int("attempt", attempt+1),
+			zap.Int("max_retries", f.latestLedgerRetries),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff = min(backoff*2, maxLatestLedgerBackoff)
+	}
+}
+
+// pollLatestLedger returns the latest validated ledger, polling the endpoint unless a concurrent fetch
+// already did within latestLedgerTTL or already saw requestBlockNum validated
+func (f *Fetcher) pollLatestLedger(ctx context.Context, client ClientInterface, requestBlockNum uint64) (uint64, error) {
+	select {
+	case f.lastBlockInfo.poll <- struct{}{}:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	defer func() { <-f.lastBlockInfo.poll }()
+
+	if latest := f.lastBlockInfo.latest(); latest >= requestBlockNum || time.Since(f.lastBlockInfo.polledAt) < latestLedgerTTL {
+		return latest, nil
+	}
+
+	latestLedger, err := f.getLatestLedgerWithRetry(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	f.lastBlockInfo.polledAt = time.Now()
+	f.cadence.observe(latestLedger.LedgerIndex, f.lastBlockInfo.polledAt)
+
+	latest := f.lastBlockInfo.advance(latestLedger.LedgerIndex)
+	f.logger.Info("got latest validated ledger",
+		zap.Uint64("latest_ledger", latest),
+		zap.Uint64("requested_ledger", requestBlockNum))
+
+	return latest, nil
+}
+
+// sleepContext waits for d or until ctx is done, returning the context error in the latter case
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// checkBlockSize flags blocks whose marshaled payload exceeds the configured limit
+func (f *Fetcher) checkBlockSize(block *pbbstream.Block) error {
+	if f.maxBlockSize <= 0 {
+		return nil
+	}
+
+	size := len(block.Payload.Value)
+	if size <= f.maxBlockSize {
+		return nil
+	}
+
+	if f.failOnOversizedBlock {
+		return fmt.Errorf("block %d payload is %d bytes, above the %d bytes limit, disable enrichments such as --include-decoded-meta to shrink it", block.Number, size, f.maxBlockSize)
+	}
+
+	f.logger.Warn("block payload exceeds size limit, downstream may fail to ingest it, consider disabling enrichments such as --include-decoded-meta",
+		zap.Uint64("block_num", block.Number),
+		zap.Int("size_bytes", size),
+		zap.Int("max_block_size", f.maxBlockSize))
+
+	return nil
+}
+
+// setExpiryWindow records how many ledgers were left before LastLedgerSequence when tx was included
+func (f *Fetcher) setExpiryWindow(tx *pbxrpl.Transaction, ledgerIndex uint64) {
+	if tx.LastLedgerSequence == 0 {
+		return
+	}
+
+	tx.LedgersUntilExpiry = int64(tx.LastLedgerSequence) - int64(ledgerIndex)
+	if tx.LedgersUntilExpiry <= 0 {
+		f.logger.Warn("transaction included at or past its LastLedgerSequence",
+			zap.String("tx_hash", hex.EncodeToString(tx.Hash)),
+			zap.Uint32("last_ledger_sequence", tx.LastLedgerSequence),
+			zap.Uint64("ledger_index", ledgerIndex))
+	}
+}
+
+// Metrics reports the fetcher throughput since it was created
+type Metrics struct {
+	BlocksProcessed       uint64
+	TransactionsProcessed uint64
+	BlocksPerSecond       float64
+	TransactionsPerSecond float64
+	Uptime                time.Duration
+}
+
+// GetPerformanceMetrics returns the blocks and transactions returned by Fetch and their rate since creation
+func (f *Fetcher) GetPerformanceMetrics() Metrics {
+	metrics := Metrics{
+		BlocksProcessed:       f.blocksProcessed.Load(),
+		TransactionsProcessed: f.transactionsProcessed.Load(),
+		Uptime:                time.Since(f.startTime),
+	}
+
+	if seconds := metrics.Uptime.Seconds(); seconds > 0 {
+		metrics.BlocksPerSecond = float64(metrics.BlocksProcessed) / seconds
+		metrics.TransactionsPerSecond = float64(metrics.TransactionsProcessed) / seconds
+	}
+
+	return metrics
+}
 
 // IsBlockAvailable checks if a block number is available
 func (f *Fetcher) IsBlockAvailable(blockNum uint64) bool {
-	return blockNum <= f.lastBlockInfo.blockNum
+	return blockNum <= f.lastBlockInfo.latest()
 }
 
+// batchBlockTimeout bounds the fetch of each ledger of a batch, counted once it gets its turn so
+// a large batch is not cut short by the ledgers queued before it
+const batchBlockTimeout = 30 * time.Second
+
 // FetchBatch retrieves multiple ledgers in parallel and converts them to bstream Blocks
-func (f *Fetcher) FetchBatch(ctx context.Context, client *Client, requestBlockNums []uint64) ([]*pbbstream.Block, error) {
+func (f *Fetcher) FetchBatch(ctx context.Context, client ClientInterface, requestBlockNums []uint64) ([]*pbbstream.Block, error) {
 	if len(requestBlockNums) == 0 {
 		return nil, nil
 	}
 
-	// Create a context for the batch operation
-	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-
 	// Use a worker pool for parallel block fetching
 	blocks := make([]*pbbstream.Block, len(requestBlockNums))
+	errs := make([]error, len(requestBlockNums))
 	var wg sync.WaitGroup
-	errChan := make(chan error, len(requestBlockNums))
 
 	// Limit concurrent block fetches to avoid overwhelming the RPC endpoint
 	concurrencyLimit := 5
@@ -345,9 +746,12 @@ func (f *Fetcher) FetchBatch(ctx context.Context, client *Client, requestBlockNu
 			defer func() { <-semaphore }()
 
 			// Fetch individual block
-			block, _, err := f.Fetch(ctx, client, num)
+			blockCtx, cancel := context.WithTimeout(ctx, batchBlockTimeout)
+			defer cancel()
+
+			block, _, err := f.Fetch(blockCtx, client, num)
 			if err != nil {
-				errChan <- fmt.Errorf("failed to fetch block %d: %w", num, err)
+				errs[idx] = fmt.Errorf("failed to fetch block %d: %w", num, err)
 				return
 			}
 
@@ -356,16 +760,48 @@ func (f *Fetcher) FetchBatch(ctx context.Context, client *Client, requestBlockNu
 	}
 
 	wg.Wait()
-	close(errChan)
 
-	// Check for errors
-	if len(errChan) > 0 {
-		return nil, <-errChan
+	// Report every failed block, in request order
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("%d of %d blocks failed: %w", countErrors(errs), len(requestBlockNums), err)
 	}
 
 	return blocks, nil
 }
 
+// failedTxType labels a transaction that failed to map, "unknown" when even its type cannot be decoded
+func failedTxType(decoded *decoder.DecodedTransaction) string {
+	if txType := decoded.Type(); txType != "" {
+		return txType
+	}
+
+	return "unknown"
+}
+
+// countErrors returns how many entries of errs are set
+func countErrors(errs []error) int {
+	count := 0
+	for _, err := range errs {
+		if err != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
+// countTrue returns how many entries of flags are set
+func countTrue(flags []bool) int {
+	count := 0
+	for _, flag := range flags {
+		if flag {
+			count++
+		}
+	}
+
+	return count
+}
+
 // xrplEpochToTime converts XRPL epoch seconds to Go time.Time
 // XRPL epoch starts at 2000-01-01 00:00:00 UTC
 func xrplEpochToTime(xrplTime uint64) time.Time {
@@ -373,24 +809,65 @@ func xrplEpochToTime(xrplTime uint64) time.Time {
 	return time.Unix(unixTime, 0).UTC()
 }
 
+// orderTransactions drops the transactions that failed to map (nil) and returns the others in
+// canonical (application) order, whatever order the node listed them in. Transactions whose metadata
+// has no TransactionIndex cannot be placed: they follow the ordered ones in listing order, numbered on
+// from the last known index so Index stays increasing within the block
+func orderTransactions(transactions []*pbxrpl.Transaction, indexUnknown []bool) []*pbxrpl.Transaction {
+	ordered := make([]*pbxrpl.Transaction, 0, len(transactions))
+	var unplaced []*pbxrpl.Transaction
+	for i, tx := range transactions {
+		switch {
+		case tx == nil:
+		case indexUnknown[i]:
+			unplaced = append(unplaced, tx)
+		default:
+			ordered = append(ordered, tx)
+		}
+	}
+
+	sort.SliceStable(ordered, func(a, b int) bool {
+		return ordered[a].Index < ordered[b].Index
+	})
+
+	var next uint32
+	if len(ordered) > 0 {
+		next = ordered[len(ordered)-1].Index + 1
+	}
+	for _, tx := range unplaced {
+		tx.Index = next
+		next++
+	}
+
+	return append(ordered, unplaced...)
+}
+
 // convertBlock converts an XRPL Block to a bstream Block
-func convertBlock(xrplBlk *pbxrpl.Block) (*pbbstream.Block, error) {
-	anyBlock, err := anypb.New(xrplBlk)
+func convertBlock(xrplBlk *pbxrpl.Block, idEncoding BlockIDEncoding) (*pbbstream.Block, error) {
+	// Deterministic marshaling keeps output byte-identical across runs even with map fields
+	anyBlock := &anypb.Any{}
+	err := anypb.MarshalFrom(anyBlock, xrplBlk, proto.MarshalOptions{Deterministic: true})
 	if err != nil {
 		return nil, fmt.Errorf("unable to create anypb: %w", err)
 	}
 
-	// Use hex encoding for block IDs (standard for XRPL)
-	blockHash := hex.EncodeToString(xrplBlk.Hash)
-	parentHash := hex.EncodeToString(xrplBlk.Header.ParentHash)
+	// Id and ParentId must share an encoding for the chain to link
+	blockHash := idEncoding.encode(xrplBlk.Hash)
+	parentHash := idEncoding.encode(xrplBlk.Header.ParentHash)
+
+	// The genesis ledger has no parent, its parent number stays 0 rather than wrapping around
+	var parentNum uint64
+	if xrplBlk.Number > genesisLedgerIndex {
+		parentNum = xrplBlk.Number - 1
+	}
 
 	return &pbbstream.Block{
 		Number:    xrplBlk.Number,
 		Id:        blockHash,
 		ParentId:  parentHash,
 		Timestamp: xrplBlk.CloseTime,
-		LibNum:    xrplBlk.Number - 1, // Every validated ledger in XRPL is final
-		ParentNum: xrplBlk.Number - 1,
+		LibNum:    parentNum, // Every validated ledger in XRPL is final
+		ParentNum: parentNum,
 		Payload:   anyBlock,
 	}, nil
 }
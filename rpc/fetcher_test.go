@@ -0,0 +1,491 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestOrderTransactions(t *testing.T) {
+	tx := func(index uint32) *pbxrpl.Transaction {
+		return &pbxrpl.Transaction{Index: index, Hash: []byte{byte(index)}}
+	}
+
+	tests := []struct {
+		name         string
+		transactions []*pbxrpl.Transaction
+		indexUnknown []bool
+		wantHashes   []byte // First hash byte of each emitted transaction, in order
+		wantIndexes  []uint32
+	}{
+		{
+			name:         "listed by hash",
+			transactions: []*pbxrpl.Transaction{tx(2), tx(0), tx(1)},
+			indexUnknown: []bool{false, false, false},
+			wantHashes:   []byte{0, 1, 2},
+			wantIndexes:  []uint32{0, 1, 2},
+		},
+		{
+			name:         "middle transaction failed to map",
+			transactions: []*pbxrpl.Transaction{tx(2), nil, tx(0)},
+			indexUnknown: []bool{false, false, false},
+			wantHashes:   []byte{0, 2},
+			wantIndexes:  []uint32{0, 2},
+		},
+		{
+			name:         "unknown index follows the ordered transactions",
+			transactions: []*pbxrpl.Transaction{tx(9), tx(1), tx(0)},
+			indexUnknown: []bool{true, false, false},
+			wantHashes:   []byte{0, 1, 9},
+			wantIndexes:  []uint32{0, 1, 2},
+		},
+		{
+			name:         "unknown indexes keep their listing order",
+			transactions: []*pbxrpl.Transaction{tx(8), tx(3), nil, tx(7)},
+			indexUnknown: []bool{true, false, false, true},
+			wantHashes:   []byte{3, 8, 7},
+			wantIndexes:  []uint32{3, 4, 5},
+		},
+		{
+			name:         "no index known",
+			transactions: []*pbxrpl.Transaction{tx(5), tx(4)},
+			indexUnknown: []bool{true, true},
+			wantHashes:   []byte{5, 4},
+			wantIndexes:  []uint32{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered := orderTransactions(tt.transactions, tt.indexUnknown)
+
+			var hashes []byte
+			var indexes []uint32
+			for _, tx := range ordered {
+				hashes = append(hashes, tx.Hash[0])
+				indexes = append(indexes, tx.Index)
+			}
+			assert.Equal(t, tt.wantHashes, hashes)
+			assert.Equal(t, tt.wantIndexes, indexes)
+		})
+	}
+}
+
+// benchmarkPayment is the Payment of the ledger 90000001 replay fixture
+var benchmarkPayment = types.LedgerTransaction{
+	Hash:   "D73985B82B093D35E87850995FE01C1540AEA4919FFB4DBB5777032657F02F58",
+	TxBlob: "120000220000000024000000056140000000000F424068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE8114B5F762798A53D543A014CAF8B297CFF8F2F937E88314F667B0CA50CC7709A220B0561B85E53A48461FA8",
+	Meta:   "201C00000000601240000000000F4240F8F1031000",
+}
+
+// benchmarkLedger returns a validated ledger holding count copies of benchmarkPayment
+func benchmarkLedger(index uint64, count int) *types.LedgerResult {
+	transactions := make([]types.LedgerTransaction, count)
+	for i := range transactions {
+		transactions[i] = benchmarkPayment
+	}
+
+	return &types.LedgerResult{
+		LedgerIndex: index,
+		LedgerHash:  strings.Repeat("CD", 32),
+		Validated:   true,
+		Ledger: types.Ledger{
+			LedgerIndex:  index,
+			LedgerHash:   strings.Repeat("CD", 32),
+			ParentHash:   strings.Repeat("AB", 32),
+			TotalCoins:   "99986000000000000",
+			Closed:       true,
+			Transactions: transactions,
+		},
+	}
+}
+
+// BenchmarkFetchLedgerBlockProfiling measures the cost of per-transaction decode profiling, the
+// disabled case being the overhead every fetch pays for the option
+func BenchmarkFetchLedgerBlockProfiling(b *testing.B) {
+	client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(90000001, 100)}
+
+	for _, profiling := range []bool{false, true} {
+		name := "disabled"
+		if profiling {
+			name = "enabled"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+			fetcher.SetProfileTxDecoding(profiling)
+			fetcher.lastBlockInfo.advance(90000001)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				block, err := fetcher.FetchLedgerBlock(context.Background(), client, 90000001)
+				require.NoError(b, err)
+				require.Len(b, block.Transactions, 100)
+			}
+		})
+	}
+}
+
+func TestSetExpiryWindow(t *testing.T) {
+	tests := []struct {
+		name               string
+		lastLedgerSequence uint32
+		want               int64
+	}{
+		{"no LastLedgerSequence", 0, 0},
+		{"ledgers left", 90000005, 4},
+		{"last ledger", 90000001, 0},
+		{"past the last ledger", 90000000, -1},
+	}
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &pbxrpl.Transaction{LastLedgerSequence: tt.lastLedgerSequence}
+			fetcher.setExpiryWindow(tx, 90000001)
+			assert.Equal(t, tt.want, tx.LedgersUntilExpiry)
+		})
+	}
+}
+
+// flakyLatestClient fails the first failures latest ledger polls
+type flakyLatestClient struct {
+	*fakeClient
+	failures int
+	calls    int
+}
+
+func (c *flakyLatestClient) GetLatestLedger(ctx context.Context) (*types.LedgerClosedResult, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return nil, errors.New("connection reset")
+	}
+	return c.fakeClient.GetLatestLedger(ctx)
+}
+
+func TestGetLatestLedgerWithRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		retries   int
+		failures  int
+		wantErr   bool
+		wantCalls int
+	}{
+		{"first poll succeeds", 0, 0, false, 1},
+		{"no retries", 0, 1, true, 1},
+		{"recovers within the retries", 2, 2, false, 3},
+		{"fails every retry", 2, 3, true, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &flakyLatestClient{
+				fakeClient: &fakeClient{endpoint: "memory", ledger: benchmarkLedger(90000001, 0)},
+				failures:   tt.failures,
+			}
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			fetcher.SetLatestLedgerRetries(tt.retries)
+
+			latest, err := fetcher.getLatestLedgerWithRetry(context.Background(), client)
+			assert.Equal(t, tt.wantCalls, client.calls)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint64(90000001), latest.LedgerIndex)
+		})
+	}
+}
+
+func TestCheckBlockSize(t *testing.T) {
+	block := &pbbstream.Block{Number: 90000001, Payload: &anypb.Any{Value: make([]byte, 1000)}}
+
+	tests := []struct {
+		name    string
+		limit   int
+		fail    bool
+		wantErr bool
+	}{
+		{"disabled", 0, true, false},
+		{"under the limit", 1000, true, false},
+		{"over the limit, warn", 999, false, false},
+		{"over the limit, fail", 999, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			fetcher.SetBlockSizeLimit(tt.limit, tt.fail)
+			assert.Equal(t, tt.wantErr, fetcher.checkBlockSize(block) != nil)
+		})
+	}
+}
+
+func TestLargeMetaThreshold(t *testing.T) {
+	// benchmarkPayment's metadata is 21 bytes
+	tests := []struct {
+		name      string
+		threshold int
+		want      uint64
+	}{
+		{"disabled", 0, 0},
+		{"above the metadata size", 21, 0},
+		{"below the metadata size", 20, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(90000001, 3)}
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			fetcher.SetLargeMetaThreshold(tt.threshold)
+			fetcher.lastBlockInfo.advance(90000001)
+
+			block, err := fetcher.FetchLedgerBlock(context.Background(), client, 90000001)
+			require.NoError(t, err)
+			assert.Len(t, block.Transactions, 3)
+			assert.Equal(t, tt.want, fetcher.Stats().LargeMeta)
+		})
+	}
+}
+
+func TestGetPerformanceMetrics(t *testing.T) {
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	fetcher.lastBlockInfo.advance(90000002)
+
+	metrics := fetcher.GetPerformanceMetrics()
+	assert.Zero(t, metrics.BlocksProcessed)
+	assert.Zero(t, metrics.TransactionsProcessed)
+
+	for _, ledger := range []*types.LedgerResult{benchmarkLedger(90000001, 3), benchmarkLedger(90000002, 2)} {
+		client := &fakeClient{endpoint: "memory", ledger: ledger}
+		_, _, err := fetcher.Fetch(context.Background(), client, ledger.LedgerIndex)
+		require.NoError(t, err)
+	}
+
+	// A failed fetch is not counted
+	_, _, err := fetcher.Fetch(context.Background(), &fakeClient{endpoint: "memory"}, 90000002)
+	require.Error(t, err)
+
+	metrics = fetcher.GetPerformanceMetrics()
+	assert.Equal(t, uint64(2), metrics.BlocksProcessed)
+	assert.Equal(t, uint64(5), metrics.TransactionsProcessed)
+	assert.Positive(t, metrics.Uptime)
+	assert.Positive(t, metrics.BlocksPerSecond)
+	assert.InDelta(t, 2.5*metrics.BlocksPerSecond, metrics.TransactionsPerSecond, 1e-6*metrics.TransactionsPerSecond)
+}
+
+func TestFetchCancelledWhileWaiting(t *testing.T) {
+	// The endpoint is one ledger behind the requested one and the next poll is an hour away
+	client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(90000000, 0)}
+	fetcher := NewFetcher(time.Hour, time.Hour, zap.NewNop())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := fetcher.Fetch(ctx, client, 90000001)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestSleepContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		d       time.Duration
+		wantErr error
+	}{
+		{"no wait", context.Background(), 0, nil},
+		{"short wait", context.Background(), time.Millisecond, nil},
+		{"cancelled before waiting", cancelled, 0, context.Canceled},
+		{"cancelled while waiting", cancelled, time.Hour, context.Canceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, sleepContext(tt.ctx, tt.d), tt.wantErr)
+		})
+	}
+}
+
+// countingClient counts the latest ledger polls, safe for concurrent fetches
+type countingClient struct {
+	*fakeClient
+	polls atomic.Int64
+}
+
+func (c *countingClient) GetLatestLedger(ctx context.Context) (*types.LedgerClosedResult, error) {
+	c.polls.Add(1)
+	return c.fakeClient.GetLatestLedger(ctx)
+}
+
+// The ledgers of a batch wait for the same tip, one poll must release them all
+func TestFetchBatchSharesLatestLedgerPoll(t *testing.T) {
+	const first, count = 90000001, 5
+
+	ledgers := make(map[uint64]*types.LedgerResult, count)
+	nums := make([]uint64, count)
+	for i := range nums {
+		nums[i] = first + uint64(i)
+		ledgers[nums[i]] = benchmarkLedger(nums[i], 1)
+	}
+	client := &countingClient{fakeClient: &fakeClient{
+		endpoint: "memory",
+		ledger:   ledgers[nums[count-1]],
+		ledgers:  ledgers,
+	}}
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	blocks, err := fetcher.FetchBatch(context.Background(), client, nums)
+	require.NoError(t, err)
+	require.Len(t, blocks, count)
+
+	assert.Equal(t, int64(1), client.polls.Load())
+}
+
+func TestConvertBlockParentNum(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        uint64
+		wantParentNum uint64
+	}{
+		{"ledger 0", 0, 0},
+		{"genesis ledger", 1, 0},
+		{"first child of genesis", 2, 1},
+		{"mainnet ledger", 90000001, 90000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, err := convertBlock(&pbxrpl.Block{
+				Number: tt.number,
+				Hash:   []byte{0xCD},
+				Header: &pbxrpl.Header{ParentHash: []byte{0xAB}},
+			}, BlockIDHexLower)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.number, block.Number)
+			assert.Equal(t, tt.wantParentNum, block.ParentNum)
+			assert.Equal(t, tt.wantParentNum, block.LibNum)
+		})
+	}
+}
+
+func TestFetchGenesisAcrossGaps(t *testing.T) {
+	client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(genesisLedgerIndex, 0)}
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	defer fetcher.Close()
+	fetcher.SetAllowGapSkipping(true)
+	fetcher.lastBlockInfo.advance(genesisLedgerIndex)
+
+	// Gap linking must not probe a ledger before genesis
+	block, skipped, err := fetcher.Fetch(context.Background(), client, genesisLedgerIndex)
+	require.NoError(t, err)
+	assert.False(t, skipped)
+	assert.Equal(t, uint64(0), block.ParentNum)
+}
+
+func TestMaxTxSize(t *testing.T) {
+	// A synthetic 4 KiB blob, far past the limit and never decoded
+	oversized := benchmarkLedger(90000001, 3)
+	oversized.Ledger.Transactions[1].TxBlob = strings.Repeat("AB", 4096)
+
+	tests := []struct {
+		name          string
+		ledger        *types.LedgerResult
+		maxTxSize     int
+		wantTxs       int
+		wantOversized uint64
+	}{
+		{"disabled", benchmarkLedger(90000001, 3), 0, 3, 0},
+		{"oversized blob", oversized, 1024, 2, 1},
+		{"metadata over the limit", benchmarkLedger(90000001, 3), 20, 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{endpoint: "memory", ledger: tt.ledger}
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			defer fetcher.Close()
+			fetcher.SetMaxTxSize(tt.maxTxSize)
+			fetcher.lastBlockInfo.advance(90000001)
+
+			before := testutil.ToFloat64(oversizedTransactions.Native())
+
+			block, err := fetcher.FetchLedgerBlock(context.Background(), client, 90000001)
+			require.NoError(t, err)
+			assert.Len(t, block.Transactions, tt.wantTxs)
+			assert.Equal(t, uint32(3), block.Header.TransactionCount)
+			assert.Equal(t, uint32(tt.wantOversized), block.Header.SkippedTransactionCount)
+			assert.Equal(t, tt.wantOversized, fetcher.Stats().Oversized)
+			assert.Equal(t, float64(tt.wantOversized), testutil.ToFloat64(oversizedTransactions.Native())-before)
+			assert.Zero(t, fetcher.Stats().DecodeFailures, "oversized transactions are skipped before decoding")
+		})
+	}
+}
+
+func TestSkippedTransactionCount(t *testing.T) {
+	ledger := benchmarkLedger(90000001, 3)
+	ledger.Ledger.Transactions[1].TxBlob = "ZZ"
+	client := &fakeClient{endpoint: "memory", ledger: ledger}
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	defer fetcher.Close()
+	fetcher.lastBlockInfo.advance(90000001)
+
+	block, err := fetcher.FetchLedgerBlock(context.Background(), client, 90000001)
+	require.NoError(t, err)
+	assert.Len(t, block.Transactions, 2)
+	assert.Equal(t, uint32(3), block.Header.TransactionCount)
+	assert.Equal(t, uint32(1), block.Header.SkippedTransactionCount)
+	assert.Equal(t, uint64(1), fetcher.Stats().DecodeFailures)
+}
+
+func TestTransactionLedgerIndexAndCloseTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		index     uint64
+		closeTime uint64
+		wantTime  time.Time
+	}{
+		{"xrpl epoch", 90000001, 0, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"recent ledger", 90000002, 813024010, time.Unix(1759708810, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := benchmarkLedger(tt.index, 3)
+			ledger.Ledger.CloseTime = tt.closeTime
+			client := &fakeClient{endpoint: "memory", ledger: ledger}
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			defer fetcher.Close()
+			fetcher.lastBlockInfo.advance(tt.index)
+
+			block, err := fetcher.FetchLedgerBlock(context.Background(), client, tt.index)
+			require.NoError(t, err)
+			require.Len(t, block.Transactions, 3)
+			assert.True(t, tt.wantTime.Equal(block.CloseTime.AsTime()))
+			for _, tx := range block.Transactions {
+				assert.Equal(t, block.Number, tx.LedgerIndex)
+				assert.True(t, block.CloseTime.AsTime().Equal(tx.CloseTime.AsTime()))
+			}
+		})
+	}
+}
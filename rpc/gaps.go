@@ -0,0 +1,170 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"go.uber.org/zap"
+)
+
+// gapWindow is how many recently fetched ledgers are remembered to link blocks without extra requests
+const gapWindow = 1024
+
+// ledgerGaps tracks fetched and skipped ledgers when gap skipping or parent hash verification is enabled,
+// so the first ledger after a skipped range can be linked to the last ledger actually emitted, and a
+// ledger's parent hash checked against the fetched parent, safe for concurrent use
+type ledgerGaps struct {
+	mu      sync.Mutex
+	fetched map[uint64]string // Ledger number to block ID
+	skipped map[uint64]bool
+}
+
+func newLedgerGaps() *ledgerGaps {
+	return &ledgerGaps{
+		fetched: make(map[uint64]string),
+		skipped: make(map[uint64]bool),
+	}
+}
+
+// recordFetched remembers the block ID of a fetched ledger, dropping ledgers older than gapWindow
+func (g *ledgerGaps) recordFetched(num uint64, id string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.fetched[num] = id
+	if len(g.fetched) <= 2*gapWindow || num < gapWindow {
+		return
+	}
+
+	for fetchedNum := range g.fetched {
+		if fetchedNum < num-gapWindow {
+			delete(g.fetched, fetchedNum)
+		}
+	}
+}
+
+// forget drops a fetched ledger found to be wrong
+func (g *ledgerGaps) forget(num uint64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.fetched, num)
+}
+
+// recordSkipped remembers a ledger the endpoint cannot provide, dropping ledgers older than gapWindow
+func (g *ledgerGaps) recordSkipped(num uint64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.skipped[num] = true
+	if len(g.skipped) <= 2*gapWindow || num < gapWindow {
+		return
+	}
+
+	for skippedNum := range g.skipped {
+		if skippedNum < num-gapWindow {
+			delete(g.skipped, skippedNum)
+		}
+	}
+}
+
+// lookup returns the block ID of a fetched ledger, and whether the ledger is known to be skipped
+func (g *ledgerGaps) lookup(num uint64) (id string, fetched bool, skipped bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	id, fetched = g.fetched[num]
+	return id, fetched, g.skipped[num]
+}
+
+// SetAllowGapSkipping lets Fetch report ledgers the endpoint does not have as skipped instead of failing,
+// so the stream continues past an irrecoverable gap at the cost of completeness
+func (f *Fetcher) SetAllowGapSkipping(enabled bool) {
+	f.allowGapSkipping = enabled
+}
+
+// confirmLedgerMissing reports whether the endpoint genuinely lacks a ledger it answered lgrNotFound for:
+// its refreshed complete_ledgers has a hole there, or it validated past it. rippled also answers
+// lgrNotFound for a ledger just past a lagging node's validated tip, which must be retried, not skipped
+func (f *Fetcher) confirmLedgerMissing(ctx context.Context, client ClientInterface, num uint64) bool {
+	if _, err := client.GetServerInfo(ctx); err == nil && !client.CanServe(num) {
+		return true
+	}
+
+	latest, err := client.GetLatestLedger(ctx)
+	if err != nil {
+		f.logger.Debug("failed to confirm missing ledger", zap.String("endpoint", client.Endpoint()), zap.Error(err))
+		return false
+	}
+
+	return latest.LedgerIndex > num
+}
+
+// skipLedger reports an irrecoverable ledger, loudly since the stream will not contain it
+func (f *Fetcher) skipLedger(num uint64, err error) {
+	f.gaps.recordSkipped(num)
+	f.stats.recordSkippedLedger()
+
+	f.logger.Error("skipping ledger the endpoint cannot provide, the stream will have a gap",
+		zap.Uint64("block_num", num),
+		zap.Error(err))
+}
+
+// linkAcrossGap points the block's parent at the closest preceding ledger that was not skipped,
+// probing ledger headers for ledgers this fetcher has not seen yet, at most gapWindow ledgers back
+func (f *Fetcher) linkAcrossGap(ctx context.Context, client ClientInterface, block *pbbstream.Block) (err error) {
+	defer func() {
+		if err == nil {
+			f.gaps.recordFetched(block.Number, block.Id)
+		}
+	}()
+
+	if block.Number <= genesisLedgerIndex {
+		return nil
+	}
+
+	lowest := uint64(0)
+	if block.ParentNum > gapWindow {
+		lowest = block.ParentNum - gapWindow
+	}
+
+	for num := block.ParentNum; ; num-- {
+		id, fetched, skipped := f.gaps.lookup(num)
+		if !fetched && !skipped {
+			probedID, err := f.ledgerID(ctx, client, num)
+			switch {
+			case errors.Is(err, ErrLedgerNotFound):
+				if !f.confirmLedgerMissing(ctx, client, num) {
+					return fmt.Errorf("%s may not have caught up to ledger %d yet: %w", client.Endpoint(), num, err)
+				}
+				f.gaps.recordSkipped(num)
+				skipped = true
+			case err != nil:
+				return fmt.Errorf("probing ledger %d for gaps: %w", num, err)
+			default:
+				id, fetched = probedID, true
+				f.gaps.recordFetched(num, id)
+			}
+		}
+
+		if fetched {
+			if num != block.ParentNum {
+				f.logger.Warn("linking ledger across skipped gap",
+					zap.Uint64("block_num", block.Number),
+					zap.Uint64("gap_start", num+1),
+					zap.Uint64("gap_end", block.ParentNum))
+				block.ParentNum = num
+				block.ParentId = id
+				block.LibNum = num
+			}
+			return nil
+		}
+
+		if num == lowest {
+			return fmt.Errorf("no ledger available within %d ledgers before ledger %d", gapWindow, block.Number)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+func TestFetchMissingLedger(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowGaps   bool
+		latest      uint64 // Latest validated ledger of the endpoint
+		wantSkipped bool
+		wantErr     bool
+	}{
+		{"gap skipping disabled", false, 90000003, false, true},
+		{"endpoint validated past the ledger", true, 90000003, true, false},
+		{"endpoint not caught up yet", true, 90000000, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The endpoint serves its latest ledger only, 90000001 is missing
+			client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(tt.latest, 0)}
+
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			fetcher.SetAllowGapSkipping(tt.allowGaps)
+			fetcher.lastBlockInfo.advance(90000003)
+
+			block, skipped, err := fetcher.Fetch(context.Background(), client, 90000001)
+			assert.Equal(t, tt.wantSkipped, skipped)
+			assert.Equal(t, tt.wantErr, err != nil, "err: %v", err)
+			assert.Nil(t, block)
+
+			_, _, recorded := fetcher.gaps.lookup(90000001)
+			assert.Equal(t, tt.wantSkipped, recorded)
+		})
+	}
+}
+
+func TestLinkAcrossGap(t *testing.T) {
+	client := &fakeClient{
+		endpoint: "memory",
+		ledger:   benchmarkLedger(90000003, 0),
+		hashes:   map[uint64]string{90000001: strings.ToUpper(ledgerHash("11"))},
+	}
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	fetcher.SetAllowGapSkipping(true)
+	fetcher.lastBlockInfo.advance(90000003)
+
+	// 90000002 is missing from the endpoint, 90000003 links to 90000001 across it
+	_, skipped, err := fetcher.Fetch(context.Background(), client, 90000002)
+	require.NoError(t, err)
+	require.True(t, skipped)
+
+	block, skipped, err := fetcher.Fetch(context.Background(), client, 90000003)
+	require.NoError(t, err)
+	require.False(t, skipped)
+	assert.Equal(t, uint64(90000001), block.ParentNum)
+	assert.Equal(t, uint64(90000001), block.LibNum)
+	assert.Equal(t, ledgerHash("11"), block.ParentId)
+
+	id, fetched, _ := fetcher.gaps.lookup(90000003)
+	assert.True(t, fetched)
+	assert.Equal(t, block.Id, id)
+}
+
+func TestLinkAcrossGapNoReachableParent(t *testing.T) {
+	// The endpoint's history begins at the start block, none of its parents can be found
+	client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(90000003, 0)}
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	fetcher.SetAllowGapSkipping(true)
+	fetcher.lastBlockInfo.advance(90000003)
+
+	block, skipped, err := fetcher.Fetch(context.Background(), client, 90000003)
+	require.Error(t, err)
+	assert.False(t, skipped)
+	assert.Nil(t, block)
+	assert.LessOrEqual(t, len(fetcher.gaps.skipped), gapWindow+1)
+}
+
+func TestLinkAcrossGapTransientNotFound(t *testing.T) {
+	// The endpoint serves 90000003 but has only validated up to 90000001, its lgrNotFound for
+	// 90000002 may be transient and must not be recorded as a gap
+	client := &fakeClient{
+		endpoint: "memory",
+		ledger:   benchmarkLedger(90000001, 0),
+		ledgers:  map[uint64]*types.LedgerResult{90000003: benchmarkLedger(90000003, 0)},
+		hashes:   map[uint64]string{90000001: strings.ToUpper(ledgerHash("11"))},
+	}
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	fetcher.SetAllowGapSkipping(true)
+	fetcher.lastBlockInfo.advance(90000003)
+
+	block, skipped, err := fetcher.Fetch(context.Background(), client, 90000003)
+	require.ErrorIs(t, err, ErrLedgerNotFound)
+	assert.False(t, skipped)
+	assert.Nil(t, block)
+
+	_, _, recorded := fetcher.gaps.lookup(90000002)
+	assert.False(t, recorded)
+}
+
+func TestRecordSkippedPrunes(t *testing.T) {
+	gaps := newLedgerGaps()
+	for num := uint64(1); num <= 3*gapWindow; num++ {
+		gaps.recordSkipped(num)
+	}
+
+	assert.LessOrEqual(t, len(gaps.skipped), 2*gapWindow)
+	_, _, skipped := gaps.lookup(3 * gapWindow)
+	assert.True(t, skipped)
+	_, _, skipped = gaps.lookup(1)
+	assert.False(t, skipped)
+}
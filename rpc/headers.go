@@ -0,0 +1,30 @@
+package rpc
+
+import (
+	"encoding/base64"
+	"net/http"
+)
+
+// WithHeader sends an extra HTTP header on every request to the endpoint, e.g. the API key
+// header of a managed rippled provider. Setting the same key again replaces its value
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Set(key, value)
+	}
+}
+
+// WithBasicAuth authenticates every request to the endpoint with HTTP basic auth
+func WithBasicAuth(user, password string) ClientOption {
+	credentials := base64.StdEncoding.EncodeToString([]byte(user + ":" + password))
+	return WithHeader("Authorization", "Basic "+credentials)
+}
+
+// setHeaders adds the configured extra headers to req
+func (c *Client) setHeaders(req *http.Request) {
+	for key, values := range c.headers {
+		req.Header[key] = values
+	}
+}
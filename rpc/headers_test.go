@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestClientHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []ClientOption
+		want    map[string]string
+	}{
+		{
+			name:    "api key",
+			options: []ClientOption{WithHeader("X-Api-Key", "secret")},
+			want:    map[string]string{"X-Api-Key": "secret"},
+		},
+		{
+			name:    "replaced value",
+			options: []ClientOption{WithHeader("X-Api-Key", "old"), WithHeader("x-api-key", "new")},
+			want:    map[string]string{"X-Api-Key": "new"},
+		},
+		{
+			name:    "basic auth",
+			options: []ClientOption{WithBasicAuth("alice", "s3cret")},
+			want:    map[string]string{"Authorization": "Basic YWxpY2U6czNjcmV0"},
+		},
+		{
+			name:    "header and basic auth",
+			options: []ClientOption{WithHeader("X-Api-Key", "secret"), WithBasicAuth("alice", "s3cret")},
+			want:    map[string]string{"X-Api-Key": "secret", "Authorization": "Basic YWxpY2U6czNjcmV0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := make(chan http.Header, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received <- r.Header.Clone()
+				_, _ = io.WriteString(w, `{"result":{"status":"success","info":{"complete_ledgers":"32570-90000"}}}`)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, zap.NewNop(), append(tt.options, WithRetryPolicy(0, 0))...)
+			require.NoError(t, err)
+
+			_, err = client.GetServerInfo(context.Background())
+			require.NoError(t, err)
+
+			header := <-received
+			assert.Equal(t, "application/json", header.Get("Content-Type"))
+			for key, value := range tt.want {
+				assert.Equal(t, value, header.Get(key), key)
+			}
+		})
+	}
+}
+
+func TestSubscribeLedgersSendsHeaders(t *testing.T) {
+	received := make(chan http.Header, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop(), WithHeader("X-Api-Key", "secret"))
+	require.NoError(t, err)
+	client.SetWebSocketEndpoint("ws" + strings.TrimPrefix(server.URL, "http"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := client.dialLedgerStream(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	select {
+	case header := <-received:
+		assert.Equal(t, "secret", header.Get("X-Api-Key"))
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the handshake")
+	}
+}
@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrEndpointQuarantined is returned by Fetch for an endpoint whose error rate exceeded the health
+// policy, so the poller rotates to the next endpoint without waiting on a failing node
+var ErrEndpointQuarantined = errors.New("endpoint quarantined")
+
+const (
+	// healthWindow is how many recent requests the error rate and latency are computed over
+	healthWindow = 20
+
+	// minHealthSamples is how many requests are needed before an endpoint can be quarantined
+	minHealthSamples = 10
+)
+
+// requestOutcome is the result of one JSON-RPC request
+type requestOutcome struct {
+	failed  bool
+	latency time.Duration
+}
+
+// endpointHealth tracks the recent request outcomes of an endpoint, safe for concurrent use
+type endpointHealth struct {
+	mu               sync.Mutex
+	maxErrorRate     float64 // 0 disables quarantine
+	cooldown         time.Duration
+	outcomes         [healthWindow]requestOutcome
+	count            int // Number of valid entries in outcomes
+	next             int // Ring position of the next outcome
+	quarantinedUntil time.Time
+}
+
+// WithHealthPolicy quarantines the endpoint for cooldown once its error rate over the last requests
+// exceeds maxErrorRate (0 to 1), during which Fetch fails fast so the poller uses other endpoints
+// maxErrorRate 0 disables quarantine, health is still tracked for sorting
+func WithHealthPolicy(maxErrorRate float64, cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		c.health.maxErrorRate = maxErrorRate
+		c.health.cooldown = cooldown
+	}
+}
+
+// record adds a request outcome, quarantining the endpoint when the error rate exceeds the policy
+func (h *endpointHealth) record(now time.Time, failed bool, latency time.Duration) (quarantined bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.outcomes[h.next] = requestOutcome{failed: failed, latency: latency}
+	h.next = (h.next + 1) % healthWindow
+	h.count = min(h.count+1, healthWindow)
+
+	errorRate, _ := h.statsLocked()
+	if !shouldQuarantine(h.count, errorRate, h.maxErrorRate) {
+		return false
+	}
+
+	// Start the endpoint afresh after its cooldown, so old failures don't quarantine it again right away
+	h.quarantinedUntil = now.Add(h.cooldown)
+	h.count, h.next = 0, 0
+	return true
+}
+
+// statsLocked returns the error rate and average latency of the recorded outcomes
+func (h *endpointHealth) statsLocked() (errorRate float64, avgLatency time.Duration) {
+	if h.count == 0 {
+		return 0, 0
+	}
+
+	var failures int
+	var total time.Duration
+	for _, outcome := range h.outcomes[:h.count] {
+		if outcome.failed {
+			failures++
+		}
+		total += outcome.latency
+	}
+
+	return float64(failures) / float64(h.count), total / time.Duration(h.count)
+}
+
+// quarantined reports whether the endpoint is in its cooldown
+func (h *endpointHealth) quarantined(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return now.Before(h.quarantinedUntil)
+}
+
+// score rates the endpoint, higher is healthier
+func (h *endpointHealth) score(now time.Time) uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	errorRate, avgLatency := h.statsLocked()
+	return healthScore(errorRate, avgLatency, now.Before(h.quarantinedUntil))
+}
+
+// shouldQuarantine decides whether an endpoint with samples recent requests failing at errorRate
+// exceeds maxErrorRate, requiring minHealthSamples so a single early failure doesn't quarantine it
+func shouldQuarantine(samples int, errorRate, maxErrorRate float64) bool {
+	return maxErrorRate > 0 && samples >= minHealthSamples && errorRate > maxErrorRate
+}
+
+// healthScore ranks endpoints by success rate, then latency: the success rate in millionths divided
+// by 1 + the average latency in seconds. Quarantined endpoints score 0
+func healthScore(errorRate float64, avgLatency time.Duration, quarantined bool) uint64 {
+	if quarantined {
+		return 0
+	}
+
+	return uint64((1 - errorRate) * 1_000_000 / (1 + avgLatency.Seconds()))
+}
+
+// recordOutcome feeds the result of a request to the endpoint health, ignoring requests
+// abandoned by the caller since they say nothing about the endpoint
+func (c *Client) recordOutcome(ctx context.Context, start time.Time, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	if c.health.record(time.Now(), err != nil, time.Since(start)) {
+		c.logger.Warn("endpoint error rate too high, quarantining it",
+			zap.String("endpoint", c.rpcEndpoint),
+			zap.Duration("cooldown", c.health.cooldown))
+	}
+}
+
+// Quarantined reports whether the endpoint is cooling down after too many errors
+func (c *Client) Quarantined() bool {
+	return c.health.quarantined(time.Now())
+}
+
+// HealthScore rates the endpoint from its recent requests, higher is healthier
+func (c *Client) HealthScore() uint64 {
+	return c.health.score(time.Now())
+}
+
+// EndpointHealthSorter orders endpoints healthiest first, for firecore Clients.StartSorting
+// with SortDirectionDescending
+type EndpointHealthSorter struct{}
+
+// FetchSortValue returns the client's health score
+func (EndpointHealthSorter) FetchSortValue(_ context.Context, client ClientInterface) (uint64, error) {
+	return client.HealthScore(), nil
+}
+
+// SetEndpoints registers the endpoints the poller rotates through, so a quarantined endpoint is
+// only skipped while another one can serve the fetch
+func (f *Fetcher) SetEndpoints(clients []ClientInterface) {
+	f.endpoints = clients
+}
+
+// checkQuarantine fails fast on a quarantined endpoint, unless no other endpoint is available:
+// with a single endpoint or all of them quarantined, failing would only stall the poller
+func (f *Fetcher) checkQuarantine(client ClientInterface) error {
+	if !client.Quarantined() {
+		return nil
+	}
+
+	for _, other := range f.endpoints {
+		if other != client && !other.Quarantined() {
+			return fmt.Errorf("%s: %w", client.Endpoint(), ErrEndpointQuarantined)
+		}
+	}
+
+	f.logger.Debug("every endpoint quarantined, fetching anyway", zap.String("endpoint", client.Endpoint()))
+	return nil
+}
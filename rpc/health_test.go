@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestShouldQuarantine(t *testing.T) {
+	tests := []struct {
+		name         string
+		samples      int
+		errorRate    float64
+		maxErrorRate float64
+		want         bool
+	}{
+		{"disabled", healthWindow, 1, 0, false},
+		{"too few samples", minHealthSamples - 1, 1, 0.5, false},
+		{"below the limit", minHealthSamples, 0.5, 0.5, false},
+		{"above the limit", minHealthSamples, 0.6, 0.5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shouldQuarantine(tt.samples, tt.errorRate, tt.maxErrorRate))
+		})
+	}
+}
+
+func TestHealthScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		errorRate   float64
+		avgLatency  time.Duration
+		quarantined bool
+		want        uint64
+	}{
+		{"healthy", 0, 0, false, 1_000_000},
+		{"half failing", 0.5, 0, false, 500_000},
+		{"one second latency", 0, time.Second, false, 500_000},
+		{"quarantined", 0, 0, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, healthScore(tt.errorRate, tt.avgLatency, tt.quarantined))
+		})
+	}
+}
+
+func TestEndpointHealthQuarantine(t *testing.T) {
+	now := time.Now()
+	health := &endpointHealth{maxErrorRate: 0.5, cooldown: time.Minute}
+
+	for i := 0; i < minHealthSamples-1; i++ {
+		require.False(t, health.record(now, true, 0))
+	}
+	require.True(t, health.record(now, true, 0))
+
+	assert.True(t, health.quarantined(now))
+	assert.False(t, health.quarantined(now.Add(time.Minute)))
+}
+
+func TestCheckQuarantine(t *testing.T) {
+	newEndpoint := func(quarantined bool) *Client {
+		client, err := NewClient("http://localhost:5005", zap.NewNop())
+		require.NoError(t, err)
+		if quarantined {
+			client.health.quarantinedUntil = time.Now().Add(time.Minute)
+		}
+		return client
+	}
+
+	healthy, quarantined, alsoQuarantined := newEndpoint(false), newEndpoint(true), newEndpoint(true)
+
+	tests := []struct {
+		name      string
+		endpoints []ClientInterface
+		client    *Client
+		wantErr   bool
+	}{
+		{"healthy endpoint", []ClientInterface{healthy, quarantined}, healthy, false},
+		{"another endpoint is healthy", []ClientInterface{healthy, quarantined}, quarantined, true},
+		{"single endpoint", []ClientInterface{quarantined}, quarantined, false},
+		{"endpoints not registered", nil, quarantined, false},
+		{"every endpoint quarantined", []ClientInterface{quarantined, alsoQuarantined}, quarantined, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+			fetcher.SetEndpoints(tt.endpoints)
+
+			err := fetcher.checkQuarantine(tt.client)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrEndpointQuarantined)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
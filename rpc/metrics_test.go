@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFetchRecordsMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		latest     uint64
+		txCount    int
+		wantTipLag float64
+	}{
+		{"behind the tip", 90000011, 3, 10},
+		{"at the tip", 90000001, 1, 0},
+		{"empty ledger", 90000002, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const num = 90000001
+			client := &fakeClient{
+				endpoint: "memory",
+				ledger:   benchmarkLedger(num, tt.txCount),
+			}
+
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			defer fetcher.Close()
+			fetcher.lastBlockInfo.advance(tt.latest)
+
+			ledgersBefore := testutil.ToFloat64(ledgersFetched.Native())
+			txsBefore := testutil.ToFloat64(transactionsMapped.Native())
+
+			_, _, err := fetcher.Fetch(context.Background(), client, num)
+			require.NoError(t, err)
+
+			assert.Equal(t, float64(1), testutil.ToFloat64(ledgersFetched.Native())-ledgersBefore)
+			assert.Equal(t, float64(tt.txCount), testutil.ToFloat64(transactionsMapped.Native())-txsBefore)
+			assert.Equal(t, tt.wantTipLag, testutil.ToFloat64(tipLag.Native()))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+)
+
+// SetValidateMultisign checks that the signers of each multi-signed transaction meet the account's
+// signer list quorum, resolved with one ledger_entry request per multi-signed transaction
+// Accounts whose signer list an earlier transaction of the same ledger changed are not checked,
+// the list is only known as of the previous ledger
+func (f *Fetcher) SetValidateMultisign(enabled bool) {
+	f.validateMultisign = enabled
+}
+
+// checkMultisignQuorums checks the multi-signed transactions of a ledger, in ledger order
+func (f *Fetcher) checkMultisignQuorums(ctx context.Context, client ClientInterface, transactions []*pbxrpl.Transaction, ledgerIndex uint64) {
+	// SignerList entry IDs changed by the transactions checked so far
+	changed := make(map[string]bool)
+	for _, tx := range transactions {
+		f.checkMultisignQuorum(ctx, client, tx, ledgerIndex, changed)
+		recordSignerListChanges(tx, changed)
+	}
+}
+
+// recordSignerListChanges adds the SignerList entries a transaction created, modified or deleted to changed
+func recordSignerListChanges(tx *pbxrpl.Transaction, changed map[string]bool) {
+	for _, change := range tx.StateChanges {
+		if change.LedgerEntryType == "SignerList" {
+			changed[strings.ToUpper(hex.EncodeToString(change.Key))] = true
+		}
+	}
+}
+
+// checkMultisignQuorum resolves the account's signer list as of the previous ledger and warns when
+// the presented signers are not in the list or their total weight is below the quorum
+// Accounts whose SignerList entry is in changed are skipped, the previous ledger's list is outdated
+func (f *Fetcher) checkMultisignQuorum(ctx context.Context, client ClientInterface, tx *pbxrpl.Transaction, ledgerIndex uint64, changed map[string]bool) {
+	if !tx.IsMultisigned || ledgerIndex == 0 {
+		return
+	}
+
+	logger := f.logger.With(
+		zap.Uint64("ledger_index", ledgerIndex),
+		zap.String("tx_hash", hex.EncodeToString(tx.Hash)),
+		zap.String("account", tx.Account))
+
+	index, err := utils.SignerListIndex(tx.Account)
+	if err != nil {
+		logger.Warn("multisign check: invalid account", zap.Error(err))
+		return
+	}
+	if changed[index] {
+		logger.Debug("multisign check: signer list changed earlier in the ledger, skipping")
+		return
+	}
+
+	// The signer list in effect is the one before this ledger's transactions were applied
+	signerList, err := client.GetLedgerEntry(ctx, index, ledgerIndex-1)
+	if errors.Is(err, ErrEntryNotFound) {
+		logger.Warn("multisign check: account has no signer list")
+		return
+	}
+	if err != nil {
+		logger.Warn("multisign check: failed to resolve signer list", zap.Error(err))
+		return
+	}
+
+	quorum, _ := numberFromJSON(signerList["SignerQuorum"])
+	weights := signerWeights(signerList)
+
+	var total uint64
+	for _, signer := range tx.Signers {
+		weight, ok := weights[signer.Account]
+		if !ok {
+			logger.Warn("multisign check: signer is not in the account's signer list",
+				zap.String("signer", signer.Account))
+			continue
+		}
+		total += weight
+	}
+
+	if total < quorum {
+		logger.Warn("multisign check: signer weight is below quorum",
+			zap.Uint64("signer_weight", total),
+			zap.Uint64("quorum", quorum),
+			zap.Int("signers", len(tx.Signers)))
+	}
+}
+
+// signerWeights returns the weight of each account in a SignerList entry
+func signerWeights(signerList map[string]any) map[string]uint64 {
+	entries, _ := signerList["SignerEntries"].([]any)
+
+	weights := make(map[string]uint64, len(entries))
+	for _, entryRaw := range entries {
+		wrapper, _ := entryRaw.(map[string]any)
+		entry, ok := wrapper["SignerEntry"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		account, _ := entry["Account"].(string)
+		weight, _ := numberFromJSON(entry["SignerWeight"])
+		weights[account] = weight
+	}
+
+	return weights
+}
+
+// numberFromJSON reads a non-negative integer decoded from JSON
+func numberFromJSON(raw any) (uint64, bool) {
+	v, ok := raw.(float64)
+	if !ok || v < 0 {
+		return 0, false
+	}
+
+	return uint64(v), true
+}
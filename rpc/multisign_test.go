@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const (
+	multisignAccount = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	multisignSigner1 = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	multisignSigner2 = "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+)
+
+// signerListClient serves a single signer list, or fails with err
+type signerListClient struct {
+	fakeClient
+	signerList map[string]any
+	err        error
+	requested  []uint64 // Ledger indexes the signer list was requested at
+}
+
+func (c *signerListClient) GetLedgerEntry(_ context.Context, _ string, ledgerIndex uint64) (map[string]any, error) {
+	c.requested = append(c.requested, ledgerIndex)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.signerList, nil
+}
+
+// testSignerList builds a SignerList entry as rippled renders it in JSON, weights by account
+func testSignerList(quorum float64, weights map[string]float64) map[string]any {
+	entries := make([]any, 0, len(weights))
+	for account, weight := range weights {
+		entries = append(entries, map[string]any{"SignerEntry": map[string]any{
+			"Account":      account,
+			"SignerWeight": weight,
+		}})
+	}
+	return map[string]any{"SignerQuorum": quorum, "SignerEntries": entries}
+}
+
+func multisignedTx(signers ...string) *pbxrpl.Transaction {
+	tx := &pbxrpl.Transaction{Account: multisignAccount, IsMultisigned: len(signers) > 0}
+	for _, signer := range signers {
+		tx.Signers = append(tx.Signers, &pbxrpl.Signer{Account: signer})
+	}
+	return tx
+}
+
+func TestCheckMultisignQuorum(t *testing.T) {
+	signerList := testSignerList(3, map[string]float64{multisignSigner1: 2, multisignSigner2: 1})
+
+	tests := []struct {
+		name          string
+		tx            *pbxrpl.Transaction
+		signerList    map[string]any
+		err           error
+		wantRequested bool
+		wantWarning   string // Empty when no warning is expected
+	}{
+		{"single-signed", multisignedTx(), signerList, nil, false, ""},
+		{"quorum met", multisignedTx(multisignSigner1, multisignSigner2), signerList, nil, true, ""},
+		{"below quorum", multisignedTx(multisignSigner1), signerList, nil, true, "below quorum"},
+		{"unknown signer", multisignedTx(multisignSigner1, multisignAccount), signerList, nil, true, "not in the account's signer list"},
+		{"no signer list", multisignedTx(multisignSigner1), nil, ErrEntryNotFound, true, "has no signer list"},
+		{"request failure", multisignedTx(multisignSigner1), nil, errors.New("unavailable"), true, "failed to resolve signer list"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.WarnLevel)
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.New(core))
+			defer fetcher.Close()
+
+			client := &signerListClient{fakeClient: fakeClient{endpoint: "memory"}, signerList: tt.signerList, err: tt.err}
+			fetcher.checkMultisignQuorum(context.Background(), client, tt.tx, 90000001, map[string]bool{})
+
+			if tt.wantRequested {
+				// The signer list in effect is the one of the previous ledger
+				assert.Equal(t, []uint64{90000000}, client.requested)
+			} else {
+				assert.Empty(t, client.requested)
+			}
+
+			if tt.wantWarning == "" {
+				assert.Zero(t, logs.Len())
+				return
+			}
+			assert.Equal(t, 1, logs.FilterMessageSnippet(tt.wantWarning).Len(), "warnings: %v", logs.All())
+		})
+	}
+}
+
+func TestCheckMultisignQuorumsSignerListChanged(t *testing.T) {
+	index, err := utils.SignerListIndex(multisignAccount)
+	require.NoError(t, err)
+	key, err := hex.DecodeString(index)
+	require.NoError(t, err)
+
+	// A SignerListSet earlier in the ledger replaced the list, the previous ledger's one is outdated
+	signerListSet := &pbxrpl.Transaction{
+		Account:      multisignAccount,
+		StateChanges: []*pbxrpl.StateChange{{LedgerEntryType: "SignerList", Key: key}},
+	}
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.New(core))
+	defer fetcher.Close()
+
+	client := &signerListClient{fakeClient: fakeClient{endpoint: "memory"}, signerList: testSignerList(3, map[string]float64{multisignSigner2: 1})}
+	fetcher.checkMultisignQuorums(context.Background(), client, []*pbxrpl.Transaction{signerListSet, multisignedTx(multisignSigner1)}, 90000001)
+
+	assert.Empty(t, client.requested)
+	assert.Zero(t, logs.Len())
+}
+
+func TestSignerWeights(t *testing.T) {
+	signerList := map[string]any{"SignerEntries": []any{
+		map[string]any{"SignerEntry": map[string]any{"Account": multisignSigner1, "SignerWeight": float64(2)}},
+		map[string]any{"SignerEntry": map[string]any{"Account": multisignSigner2, "SignerWeight": float64(1)}},
+		map[string]any{"NotASignerEntry": map[string]any{}},
+		"malformed",
+	}}
+
+	assert.Equal(t, map[string]uint64{multisignSigner1: 2, multisignSigner2: 1}, signerWeights(signerList))
+	assert.Empty(t, signerWeights(map[string]any{}))
+}
+
+func TestNumberFromJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    any
+		want   uint64
+		wantOK bool
+	}{
+		{"number", float64(3), 3, true},
+		{"zero", float64(0), 0, true},
+		{"negative", float64(-1), 0, false},
+		{"string", "3", 0, false},
+		{"missing", nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := numberFromJSON(tt.raw)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/xrpl-commons/firehose-xrpl/types"
+)
+
+// ledgerNotifier fans ledger close notifications out to every fetch waiting for a ledger
+type ledgerNotifier struct {
+	mu     sync.Mutex
+	latest uint64
+	wake   chan struct{} // Closed and replaced on every notification
+}
+
+func newLedgerNotifier() *ledgerNotifier {
+	return &ledgerNotifier{wake: make(chan struct{})}
+}
+
+// run consumes notifications until closed is closed
+func (n *ledgerNotifier) run(closed <-chan types.LedgerClosedResult) {
+	for notification := range closed {
+		n.mu.Lock()
+		n.latest = max(n.latest, notification.LedgerIndex)
+		close(n.wake)
+		n.wake = make(chan struct{})
+		n.mu.Unlock()
+	}
+}
+
+// state returns the latest notified ledger and a channel closed on the next notification
+func (n *ledgerNotifier) state() (uint64, <-chan struct{}) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.latest, n.wake
+}
+
+// SetLedgerNotifications wakes fetches waiting for a ledger as soon as it is announced on closed
+// (see Client.SubscribeLedgers) instead of sleeping latestBlockRetryInterval between polls
+// Polling continues as before once closed is closed. A notification skipping ahead after the
+// subscription reconnects leaves no gap: the poller still fetches every ledger by number, and the
+// ledgers in between are known validated
+func (f *Fetcher) SetLedgerNotifications(closed <-chan types.LedgerClosedResult) {
+	f.notifier = newLedgerNotifier()
+	go f.notifier.run(closed)
+}
+
+// waitForLedger sleeps for d, returning early when a notification arrives, or right away when
+// requestBlockNum was already announced. It returns the latest announced ledger, 0 without notifications
+func (f *Fetcher) waitForLedger(ctx context.Context, d time.Duration, requestBlockNum uint64) (uint64, error) {
+	if f.notifier == nil {
+		return 0, sleepContext(ctx, d)
+	}
+
+	latest, wake := f.notifier.state()
+	if latest >= requestBlockNum || d <= 0 {
+		return latest, ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timer.C:
+	case <-wake:
+	}
+
+	latest, _ = f.notifier.state()
+	return latest, nil
+}
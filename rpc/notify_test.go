@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+// A subscription that reconnects resumes at the current ledger, the ledgers closed while it was
+// down must still be released to the fetches waiting for them
+func TestWaitForLedgerReconnectGap(t *testing.T) {
+	closed := make(chan types.LedgerClosedResult)
+	fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+	fetcher.SetLedgerNotifications(closed)
+
+	closed <- types.LedgerClosedResult{LedgerIndex: 10}
+	// Disconnected during 11 and 12
+	closed <- types.LedgerClosedResult{LedgerIndex: 13}
+	close(closed)
+
+	require.Eventually(t, func() bool {
+		latest, _ := fetcher.notifier.state()
+		return latest == 13
+	}, time.Second, time.Millisecond)
+
+	tests := []struct {
+		requested uint64
+		want      uint64
+	}{
+		{10, 13},
+		{11, 13},
+		{12, 13},
+		{13, 13},
+	}
+
+	for _, tt := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		notified, err := fetcher.waitForLedger(ctx, time.Hour, tt.requested)
+		cancel()
+
+		require.NoError(t, err, "ledger %d", tt.requested)
+		assert.Equal(t, tt.want, notified, "ledger %d", tt.requested)
+	}
+}
+
+func TestWaitForLedgerAhead(t *testing.T) {
+	closed := make(chan types.LedgerClosedResult)
+	fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+	fetcher.SetLedgerNotifications(closed)
+	defer close(closed)
+
+	closed <- types.LedgerClosedResult{LedgerIndex: 10}
+
+	// Ledger 11 is not announced yet, the wait ends with its timer
+	notified, err := fetcher.waitForLedger(context.Background(), 10*time.Millisecond, 11)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), notified)
+}
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"errors"
+	"sync"
+)
+
+// errPoolClosed is returned when a job is submitted after the pool was closed, a fetch
+// still in flight when its Fetcher is closed
+var errPoolClosed = errors.New("transaction worker pool is closed")
+
+// txPool is a bounded set of workers shared by every Fetch call of a Fetcher,
+// so concurrent fetches in a batch don't each spawn their own goroutines
+type txPool struct {
+	size  int
+	jobs  chan func()
+	start sync.Once
+
+	// mu keeps close from closing jobs while a submit is sending on it
+	mu     sync.RWMutex
+	closed bool
+}
+
+// newTxPool creates a pool of size workers, started lazily on first use
+func newTxPool(size int) *txPool {
+	if size <= 0 {
+		size = 1 // Ensure at least one worker
+	}
+
+	return &txPool{
+		size: size,
+		// Buffer size matches worker pool for optimal throughput without memory spike
+		jobs: make(chan func(), size),
+	}
+}
+
+// submit queues job for execution, blocking while all workers are busy and the queue is full
+// It returns errPoolClosed, without running job, once the pool was closed
+func (p *txPool) submit(job func()) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errPoolClosed
+	}
+
+	p.start.Do(func() {
+		for w := 0; w < p.size; w++ {
+			go func() {
+				for job := range p.jobs {
+					job()
+				}
+			}()
+		}
+	})
+
+	p.jobs <- job
+	return nil
+}
+
+// close stops the workers once queued jobs have drained
+// It waits for submits already sending, the workers keep running until then
+func (p *txPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+
+	p.closed = true
+	close(p.jobs)
+}
@@ -0,0 +1,211 @@
+package rpc
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+func TestTxPool(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+		jobs int
+	}{
+		{"single worker", 1, 50},
+		{"fewer jobs than workers", 8, 3},
+		{"more jobs than workers", 4, 200},
+		{"invalid size", 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := newTxPool(tt.size)
+			defer pool.close()
+
+			var active, peak, done atomic.Int64
+			var wg sync.WaitGroup
+			for i := 0; i < tt.jobs; i++ {
+				wg.Add(1)
+				require.NoError(t, pool.submit(func() {
+					defer wg.Done()
+					current := active.Add(1)
+					for {
+						seen := peak.Load()
+						if current <= seen || peak.CompareAndSwap(seen, current) {
+							break
+						}
+					}
+					time.Sleep(100 * time.Microsecond)
+					active.Add(-1)
+					done.Add(1)
+				}))
+			}
+			wg.Wait()
+
+			assert.Equal(t, int64(tt.jobs), done.Load())
+			assert.LessOrEqual(t, peak.Load(), int64(max(tt.size, 1)))
+		})
+	}
+}
+
+// BenchmarkFetchBatchGoroutines reports the peak goroutine count of a batch: the shared pool caps
+// the mapping workers whatever the number of ledgers fetched concurrently
+func BenchmarkFetchBatchGoroutines(b *testing.B) {
+	const first, count = 90000001, 20
+
+	ledgers := make(map[uint64]*types.LedgerResult, count)
+	nums := make([]uint64, count)
+	for i := range nums {
+		nums[i] = first + uint64(i)
+		ledgers[nums[i]] = benchmarkLedger(nums[i], 200)
+	}
+	client := &fakeClient{endpoint: "memory", ledgers: ledgers}
+
+	for _, poolSize := range []int{1, 10, 50} {
+		b.Run(strconv.Itoa(poolSize)+"_workers", func(b *testing.B) {
+			fetcher := NewFetcherWithWorkerPool(time.Second, time.Second, poolSize, zap.NewNop())
+			defer fetcher.Close()
+			fetcher.lastBlockInfo.advance(first + count)
+
+			var peak atomic.Int64
+			stop := make(chan struct{})
+			go func() {
+				ticker := time.NewTicker(100 * time.Microsecond)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-stop:
+						return
+					case <-ticker.C:
+						if n := int64(runtime.NumGoroutine()); n > peak.Load() {
+							peak.Store(n)
+						}
+					}
+				}
+			}()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				blocks, err := fetcher.FetchBatch(context.Background(), client, nums)
+				require.NoError(b, err)
+				require.Len(b, blocks, count)
+			}
+			b.StopTimer()
+			close(stop)
+
+			b.ReportMetric(float64(peak.Load()), "peak-goroutines")
+		})
+	}
+}
+
+func TestTxPoolBackpressure(t *testing.T) {
+	const size = 2
+
+	pool := newTxPool(size)
+	defer pool.close()
+
+	release := make(chan struct{})
+	var running sync.WaitGroup
+	running.Add(size)
+	var submitted atomic.Int64
+	go func() {
+		for i := 0; i < 3*size; i++ {
+			first := i < size
+			assert.NoError(t, pool.submit(func() {
+				if first {
+					running.Done()
+				}
+				<-release
+			}))
+			submitted.Add(1)
+		}
+	}()
+
+	// Every worker busy and the queue full: submitting blocks instead of buffering the rest
+	running.Wait()
+	assert.Never(t, func() bool { return submitted.Load() > 2*size }, 50*time.Millisecond, 5*time.Millisecond)
+
+	close(release)
+	assert.Eventually(t, func() bool { return submitted.Load() == 3*size }, time.Second, time.Millisecond)
+}
+
+func TestTxPoolSubmitAfterClose(t *testing.T) {
+	pool := newTxPool(2)
+
+	var ran atomic.Int64
+	var submitting sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		submitting.Add(1)
+		go func() {
+			defer submitting.Done()
+			for {
+				// Racing close must not panic on the closed queue
+				if err := pool.submit(func() { ran.Add(1) }); err != nil {
+					assert.ErrorIs(t, err, errPoolClosed)
+					return
+				}
+			}
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return ran.Load() > 0 }, time.Second, time.Millisecond)
+	pool.close()
+	pool.close()
+	submitting.Wait()
+
+	assert.ErrorIs(t, pool.submit(func() { t.Error("job submitted after close ran") }), errPoolClosed)
+}
+
+func TestFetchLedgerBlockAfterClose(t *testing.T) {
+	client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(90000001, 3)}
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	fetcher.lastBlockInfo.advance(90000001)
+	fetcher.Close()
+
+	_, err := fetcher.FetchLedgerBlock(context.Background(), client, 90000001)
+	assert.ErrorIs(t, err, errPoolClosed)
+}
+
+// BenchmarkFetchLargeLedgerMemory reports the memory allocated per ledger and per transaction for large
+// ledgers: with workers pulling from a queue bounded by the pool size, the cost per transaction stays flat
+// as ledgers grow rather than paying for buffers sized to the transaction count
+func BenchmarkFetchLargeLedgerMemory(b *testing.B) {
+	const num = 90000001
+
+	for _, txCount := range []int{1000, 10000} {
+		client := &fakeClient{endpoint: "memory", ledger: benchmarkLedger(num, txCount)}
+
+		for _, poolSize := range []int{1, 10, 50} {
+			b.Run(strconv.Itoa(txCount)+"_txs/"+strconv.Itoa(poolSize)+"_workers", func(b *testing.B) {
+				fetcher := NewFetcherWithWorkerPool(time.Second, time.Second, poolSize, zap.NewNop())
+				defer fetcher.Close()
+				fetcher.lastBlockInfo.advance(num)
+
+				var before, after runtime.MemStats
+				runtime.ReadMemStats(&before)
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := fetcher.FetchLedgerBlock(context.Background(), client, num); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.StopTimer()
+
+				runtime.ReadMemStats(&after)
+				b.ReportMetric(float64(after.TotalAlloc-before.TotalAlloc)/float64(b.N*txCount), "B/tx")
+			})
+		}
+	}
+}
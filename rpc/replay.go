@@ -0,0 +1,301 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// ReplayHandler serves JSON-RPC responses recorded in a directory, one <key>.json file per request,
+// so the fetcher can run offline and deterministically against recorded ledgers
+// With an upstream endpoint it records instead: requests are forwarded and their responses saved
+type ReplayHandler struct {
+	dir      string
+	upstream string
+	client   *http.Client
+	logger   *zap.Logger
+}
+
+// NewReplayHandler creates a handler replaying the responses recorded in dir, or recording the
+// responses of upstream into dir when upstream is set
+func NewReplayHandler(dir, upstream string, logger *zap.Logger) *ReplayHandler {
+	return &ReplayHandler{
+		dir:      dir,
+		upstream: upstream,
+		client:   &http.Client{},
+		logger:   logger,
+	}
+}
+
+// replayRequest is the part of a JSON-RPC request that identifies its response
+type replayRequest struct {
+	Method string `json:"method"`
+	Params []struct {
+		LedgerIndex  any    `json:"ledger_index"`
+		LedgerHash   string `json:"ledger_hash"`
+		Index        string `json:"index"`
+		Transaction  string `json:"transaction"`
+		Transactions bool   `json:"transactions"`
+		Binary       bool   `json:"binary"`
+		Marker       any    `json:"marker"`
+	} `json:"params"`
+}
+
+// replayKeyPattern is what a recording name may contain, keys are built from request fields and
+// must not reach outside the recording directory
+var replayKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// replayKey names the recording of a request, e.g. ledger_90000000 for a full ledger,
+// ledger_header_90000000 for a header only and ledger_json_90000000 for a JSON-expanded ledger
+func replayKey(body []byte) (string, error) {
+	key, err := requestKey(body)
+	if err != nil {
+		return "", err
+	}
+	if !replayKeyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid recording name %q", key)
+	}
+
+	return key, nil
+}
+
+// requestKey builds the recording name of a request from its method and parameters
+func requestKey(body []byte) (string, error) {
+	// ledger_index and marker are numbers or strings, UseNumber keeps a number's digits as sent
+	// instead of formatting it as a float (9.0000001e+07)
+	var req replayRequest
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&req); err != nil {
+		return "", fmt.Errorf("parsing request: %w", err)
+	}
+
+	var ledger, hash, index, transaction, marker string
+	var transactions, binary bool
+	if len(req.Params) > 0 {
+		params := req.Params[0]
+		if params.LedgerIndex != nil {
+			ledger = fmt.Sprint(params.LedgerIndex)
+		}
+		hash, index, transaction, transactions = params.LedgerHash, params.Index, params.Transaction, params.Transactions
+		binary = params.Binary
+		marker = "start"
+		if params.Marker != nil {
+			marker = fmt.Sprint(params.Marker)
+		}
+	}
+
+	switch req.Method {
+	case "ledger":
+		if hash != "" {
+			ledger = strings.ToUpper(hash)
+		}
+		if !transactions {
+			return "ledger_header_" + ledger, nil
+		}
+		if !binary {
+			return "ledger_json_" + ledger, nil
+		}
+		return "ledger_" + ledger, nil
+	case "ledger_entry":
+		return fmt.Sprintf("ledger_entry_%s_%s", strings.ToUpper(index), ledger), nil
+	case "ledger_data":
+		return fmt.Sprintf("ledger_data_%s_%s", ledger, strings.ToUpper(marker)), nil
+	case "tx":
+		return "tx_" + strings.ToUpper(transaction), nil
+	}
+
+	return req.Method, nil
+}
+
+// ServeHTTP answers a JSON-RPC request from its recording, or from upstream when recording
+func (h *ReplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	key, err := replayKey(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var response []byte
+	if h.upstream != "" {
+		response, err = h.record(r, key, body)
+	} else {
+		response, err = h.replay(key)
+	}
+	if err != nil {
+		h.logger.Warn("replay request failed", zap.String("key", key), zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(response)
+}
+
+// record forwards a request upstream and saves the response under key
+func (h *ReplayHandler) record(r *http.Request, key string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, h.upstream, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			h.logger.Debug("failed to close upstream response body", zap.Error(err))
+		}
+	}(resp.Body)
+
+	response, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("upstream status %d", resp.StatusCode)
+	}
+
+	// Latest-ledger polls change on every call, saving them would make the replay tip the last one seen
+	if key != "ledger_closed" {
+		path := filepath.Join(h.dir, key+".json")
+		if err := os.WriteFile(path+".tmp", response, 0o644); err != nil {
+			return nil, fmt.Errorf("saving recording: %w", err)
+		}
+		if err := os.Rename(path+".tmp", path); err != nil {
+			return nil, fmt.Errorf("saving recording: %w", err)
+		}
+		h.logger.Debug("recorded response", zap.String("key", key))
+	}
+
+	return response, nil
+}
+
+// replay reads the recording of key, answering like rippled when a request was not recorded
+func (h *ReplayHandler) replay(key string) ([]byte, error) {
+	response, err := os.ReadFile(filepath.Join(h.dir, key+".json"))
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	// A full ledger also answers a header request
+	if ledger, ok := strings.CutPrefix(key, "ledger_header_"); ok {
+		if response, err := os.ReadFile(filepath.Join(h.dir, "ledger_"+ledger+".json")); err == nil {
+			return response, nil
+		}
+	}
+
+	switch {
+	case key == "ledger_closed":
+		return h.syntheticLedgerClosed()
+	case key == "server_info":
+		return h.syntheticServerInfo()
+	case strings.HasPrefix(key, "ledger_entry_"):
+		return rpcErrorResponse("entryNotFound", 21), nil
+	case strings.HasPrefix(key, "ledger_"):
+		return rpcErrorResponse("lgrNotFound", 21), nil
+	case strings.HasPrefix(key, "tx_"):
+		return rpcErrorResponse("txnNotFound", 29), nil
+	}
+
+	return rpcErrorResponse("unknownCmd", 32), nil
+}
+
+// recordedLedgerPattern matches the recordings of full ledgers by number
+var recordedLedgerPattern = regexp.MustCompile(`^ledger_(\d+)\.json$`)
+
+// recordedLedgers returns the lowest and highest recorded full ledger numbers
+func (h *ReplayHandler) recordedLedgers() (lowest, highest uint64, err error) {
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, entry := range entries {
+		match := recordedLedgerPattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		num, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if lowest == 0 || num < lowest {
+			lowest = num
+		}
+		highest = max(highest, num)
+	}
+
+	if highest == 0 {
+		return 0, 0, fmt.Errorf("no ledger recorded in %s", h.dir)
+	}
+	return lowest, highest, nil
+}
+
+// syntheticLedgerClosed reports the highest recorded ledger as the latest validated one
+func (h *ReplayHandler) syntheticLedgerClosed() ([]byte, error) {
+	_, highest, err := h.recordedLedgers()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(h.dir, fmt.Sprintf("ledger_%d.json", highest)))
+	if err != nil {
+		return nil, err
+	}
+
+	var recorded rawLedgerResponse
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("parsing recorded ledger %d: %w", highest, err)
+	}
+
+	return json.Marshal(map[string]any{"result": map[string]any{
+		"ledger_hash":  recorded.Result.LedgerHash,
+		"ledger_index": highest,
+		"status":       "success",
+	}})
+}
+
+// syntheticServerInfo reports the recorded ledger range as the node's complete ledgers
+func (h *ReplayHandler) syntheticServerInfo() ([]byte, error) {
+	lowest, highest, err := h.recordedLedgers()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{"result": map[string]any{
+		"info":   map[string]any{"complete_ledgers": fmt.Sprintf("%d-%d", lowest, highest)},
+		"status": "success",
+	}})
+}
+
+// rpcErrorResponse builds a rippled error response
+func rpcErrorResponse(name string, code int) []byte {
+	response, _ := json.Marshal(map[string]any{"result": map[string]any{
+		"error":      name,
+		"error_code": code,
+		"status":     "error",
+	}})
+	return response
+}
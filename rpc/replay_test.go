@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+)
+
+func TestReplayKey(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "binary ledger",
+			body: `{"method":"ledger","params":[{"ledger_index":90000001,"transactions":true,"binary":true}]}`,
+			want: "ledger_90000001",
+		},
+		{
+			name: "ledger header",
+			body: `{"method":"ledger","params":[{"ledger_index":90000001}]}`,
+			want: "ledger_header_90000001",
+		},
+		{
+			name: "JSON ledger",
+			body: `{"method":"ledger","params":[{"ledger_index":90000001,"transactions":true,"expand":true}]}`,
+			want: "ledger_json_90000001",
+		},
+		{
+			name: "ledger by hash",
+			body: `{"method":"ledger","params":[{"ledger_hash":"cdcd","transactions":true,"binary":true}]}`,
+			want: "ledger_CDCD",
+		},
+		{
+			name: "ledger_entry",
+			body: `{"method":"ledger_entry","params":[{"index":"4bc5","ledger_index":90000001}]}`,
+			want: "ledger_entry_4BC5_90000001",
+		},
+		{
+			name: "ledger_data first page",
+			body: `{"method":"ledger_data","params":[{"ledger_index":90000001,"binary":true}]}`,
+			want: "ledger_data_90000001_START",
+		},
+		{
+			name: "ledger_data next page",
+			body: `{"method":"ledger_data","params":[{"ledger_index":90000001,"binary":true,"marker":"ab12"}]}`,
+			want: "ledger_data_90000001_AB12",
+		},
+		{
+			name: "tx",
+			body: `{"method":"tx","params":[{"transaction":"d739"}]}`,
+			want: "tx_D739",
+		},
+		{
+			name: "other method",
+			body: `{"method":"server_info","params":[{}]}`,
+			want: "server_info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := replayKey([]byte(tt.body))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReplayKeyRejectsPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"tx", `{"method":"tx","params":[{"transaction":"/../../x"}]}`},
+		{"method", `{"method":"../server_info","params":[{}]}`},
+		{"ledger_index", `{"method":"ledger","params":[{"ledger_index":"../../x"}]}`},
+		{"ledger_hash", `{"method":"ledger","params":[{"ledger_hash":"ab/../cd","transactions":true,"binary":true}]}`},
+		{"ledger_entry index", `{"method":"ledger_entry","params":[{"index":"..\\x","ledger_index":90000001}]}`},
+		{"marker", `{"method":"ledger_data","params":[{"ledger_index":90000001,"marker":"a/b"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := replayKey([]byte(tt.body))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReplayHandlerRejectsPaths(t *testing.T) {
+	server := httptest.NewServer(NewReplayHandler("testdata/replay", "", zap.NewNop()))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(`{"method":"tx","params":[{"transaction":"/../../ledger_90000001"}]}`))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestFetchReplayedLedger(t *testing.T) {
+	server := httptest.NewServer(NewReplayHandler("testdata/replay", "", zap.NewNop()))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop())
+	require.NoError(t, err)
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	block, skipped, err := fetcher.Fetch(ctx, client, 90000001)
+	require.NoError(t, err)
+	require.False(t, skipped)
+	assert.Equal(t, uint64(90000001), block.Number)
+	assert.Equal(t, uint64(90000000), block.ParentNum)
+
+	var xrplBlock pbxrpl.Block
+	require.NoError(t, block.Payload.UnmarshalTo(&xrplBlock))
+
+	assert.Equal(t, uint64(10), xrplBlock.Header.BaseFee)
+	assert.Equal(t, uint64(1000000), xrplBlock.Header.ReserveBase)
+	require.Len(t, xrplBlock.Transactions, 1)
+
+	tx := xrplBlock.Transactions[0]
+	assert.Equal(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", tx.Account)
+	assert.Equal(t, uint64(12), tx.Fee)
+	assert.Equal(t, "tesSUCCESS", tx.Result)
+	require.NotNil(t, tx.GetPayment())
+}
+
+// TestFetchReplayedFlagLedger fetches the ledger following flag ledger 90000128, which holds the
+// pseudo-transactions voted on it: an amendment gaining majority and a fee change
+func TestFetchReplayedFlagLedger(t *testing.T) {
+	server := httptest.NewServer(NewReplayHandler("testdata/replay", "", zap.NewNop()))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop())
+	require.NoError(t, err)
+
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	block, _, err := fetcher.Fetch(ctx, client, 90000129)
+	require.NoError(t, err)
+
+	var xrplBlock pbxrpl.Block
+	require.NoError(t, block.Payload.UnmarshalTo(&xrplBlock))
+	require.Len(t, xrplBlock.Transactions, 2)
+
+	amendment := xrplBlock.Transactions[0]
+	assert.Equal(t, "EnableAmendment", amendment.TxType)
+	assert.Equal(t, []string{"tfGotMajority"}, amendment.SetFlags)
+	require.NotNil(t, amendment.GetEnableAmendment())
+	assert.Equal(t, "AMM", amendment.GetEnableAmendment().AmendmentName)
+	assert.Equal(t, uint32(90000128), amendment.GetEnableAmendment().LedgerSequence)
+
+	fee := xrplBlock.Transactions[1]
+	assert.Equal(t, "SetFee", fee.TxType)
+	require.NotNil(t, fee.GetSetFee())
+	assert.Equal(t, "10", fee.GetSetFee().BaseFeeDrops.GetValue())
+	assert.Equal(t, "1000000", fee.GetSetFee().ReserveBaseDrops.GetValue())
+	assert.Equal(t, "200000", fee.GetSetFee().ReserveIncrementDrops.GetValue())
+}
@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMaxRetries is how many times a retryable JSON-RPC request is retried by default
+	defaultMaxRetries = 3
+
+	// defaultRetryBase is the delay before the first retry, doubled on each following one
+	defaultRetryBase = 200 * time.Millisecond
+
+	// maxRetryBackoff caps the delay between JSON-RPC retries
+	maxRetryBackoff = 10 * time.Second
+)
+
+// ClientOption configures optional Client behavior
+type ClientOption func(*Client)
+
+// WithRetryPolicy retries JSON-RPC requests failing with a transient error (timeout, connection
+// refused or reset, HTTP 429/502/503/504) up to maxRetries times, waiting base*2^attempt with jitter in between
+// maxRetries 0 disables retries, base 0 retries without waiting
+func WithRetryPolicy(maxRetries int, base time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryBase = base
+	}
+}
+
+// httpStatusError is returned for JSON-RPC responses with a non-2xx HTTP status
+type httpStatusError struct {
+	StatusCode int
+	RetryAfter time.Duration // From the Retry-After header, 0 when absent
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected HTTP status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// isRetryable reports whether a request error is transient, as opposed to a malformed
+// response or a request the server will keep rejecting
+func isRetryable(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// Every transport error is a net.Error, only timeouts among them are transient: a DNS failure,
+	// a TLS certificate error or an unsupported scheme means the endpoint is misconfigured
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// retryDelay returns the jittered exponential backoff before retry number attempt (0-based)
+// A base of 0 retries immediately
+func (c *Client) retryDelay(attempt int) time.Duration {
+	if c.retryBase <= 0 {
+		return 0
+	}
+
+	delay := c.retryBase << attempt
+	if delay <= 0 || delay > maxRetryBackoff {
+		delay = maxRetryBackoff
+	}
+
+	// Full jitter between half and the whole delay spreads retries from concurrent fetches
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// withRetry runs do until it succeeds, fails with a non-retryable error or the retries are exhausted
+func (c *Client) withRetry(ctx context.Context, do func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := do()
+		if err == nil || attempt >= c.maxRetries || !isRetryable(err) || ctx.Err() != nil {
+			return err
+		}
+
+		delay := c.retryDelay(attempt)
+		c.logger.Debug("JSON-RPC request failed, retrying",
+			zap.String("endpoint", c.rpcEndpoint),
+			zap.Int("attempt", attempt+1),
+			zap.Int("max_retries", c.maxRetries),
+			zap.Duration("delay", delay),
+			zap.Error(err))
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    time.Duration
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"zero base retries immediately", 0, 0, 0, 0},
+		{"zero base stays immediate", 0, 5, 0, 0},
+		{"first retry", 200 * time.Millisecond, 0, 100 * time.Millisecond, 200 * time.Millisecond},
+		{"doubled", 200 * time.Millisecond, 2, 400 * time.Millisecond, 800 * time.Millisecond},
+		{"capped", 200 * time.Millisecond, 10, maxRetryBackoff / 2, maxRetryBackoff},
+		{"overflow capped", time.Second, 62, maxRetryBackoff / 2, maxRetryBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{retryBase: tt.base}
+			for i := 0; i < 20; i++ {
+				delay := client.retryDelay(tt.attempt)
+				assert.GreaterOrEqual(t, delay, tt.min)
+				assert.LessOrEqual(t, delay, tt.max)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"too many requests", &httpStatusError{StatusCode: http.StatusTooManyRequests}, true},
+		{"bad gateway", &httpStatusError{StatusCode: http.StatusBadGateway}, true},
+		{"service unavailable", &httpStatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"gateway timeout", &httpStatusError{StatusCode: http.StatusGatewayTimeout}, true},
+		{"bad request", &httpStatusError{StatusCode: http.StatusBadRequest}, false},
+		{"connection refused", &url.Error{Op: "Post", URL: "http://localhost:5005", Err: &net.OpError{Op: "dial", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}}, true},
+		{"connection reset", &url.Error{Op: "Post", URL: "http://localhost:5005", Err: &net.OpError{Op: "read", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}}, true},
+		{"timeout", &url.Error{Op: "Post", URL: "http://localhost:5005", Err: os.ErrDeadlineExceeded}, true},
+		{"unexpected EOF", &url.Error{Op: "Post", URL: "http://localhost:5005", Err: io.ErrUnexpectedEOF}, true},
+		{"no such host", &url.Error{Op: "Post", URL: "http://s1.ripple.invalid", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "s1.ripple.invalid", IsNotFound: true}}}, false},
+		{"TLS certificate", &url.Error{Op: "Post", URL: "https://localhost:5005", Err: x509.UnknownAuthorityError{}}, false},
+		{"unsupported protocol scheme", &url.Error{Op: "Post", URL: "htp://localhost:5005", Err: errors.New(`unsupported protocol scheme "htp"`)}, false},
+		{"malformed response", errors.New("failed to parse response"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryable(tt.err))
+		})
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	transient := &httpStatusError{StatusCode: http.StatusServiceUnavailable}
+
+	tests := []struct {
+		name       string
+		maxRetries int
+		failures   int
+		err        error
+		wantCalls  int
+		wantErr    bool
+	}{
+		{"success", 3, 0, transient, 1, false},
+		{"recovers", 3, 2, transient, 3, false},
+		{"exhausted", 2, 5, transient, 3, true},
+		{"disabled", 0, 5, transient, 1, true},
+		{"not retryable", 3, 5, errors.New("bad"), 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{maxRetries: tt.maxRetries, logger: zap.NewNop()}
+
+			var calls int
+			err := client.withRetry(context.Background(), func() error {
+				calls++
+				if calls <= tt.failures {
+					return tt.err
+				}
+				return nil
+			})
+
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"errors"
+	"time"
+)
+
+// errFetcherDraining is returned by fetches started or still waiting for their ledger once Drain was called
+var errFetcherDraining = errors.New("fetcher is shutting down")
+
+// Drain stops the fetcher from starting new ledgers and waits up to timeout for the ledgers being
+// fetched to finish. Fetches still waiting for their ledger to validate are abandoned right away.
+// It returns false when a ledger was still being fetched at the timeout
+func (f *Fetcher) Drain(timeout time.Duration) bool {
+	f.drainMu.Lock()
+	f.draining.Store(true)
+	f.drainMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.inFlight.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// beginFetch counts a fetch in flight unless the fetcher is draining. Checking and counting under
+// drainMu keeps a fetch from being added once Drain started waiting
+func (f *Fetcher) beginFetch() bool {
+	f.drainMu.Lock()
+	defer f.drainMu.Unlock()
+
+	if f.draining.Load() {
+		return false
+	}
+	f.inFlight.Add(1)
+	return true
+}
@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDrain(t *testing.T) {
+	tests := []struct {
+		name     string
+		inFlight int
+		want     bool
+	}{
+		{"nothing in flight", 0, true},
+		{"fetch still in flight", 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+			for i := 0; i < tt.inFlight; i++ {
+				require.True(t, fetcher.beginFetch())
+			}
+
+			assert.Equal(t, tt.want, fetcher.Drain(10*time.Millisecond))
+			assert.False(t, fetcher.beginFetch(), "no fetch starts once draining")
+		})
+	}
+}
+
+func TestDrainRejectsFetch(t *testing.T) {
+	fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+	require.True(t, fetcher.Drain(time.Second))
+
+	_, _, err := fetcher.Fetch(context.Background(), nil, 1)
+	assert.ErrorIs(t, err, errFetcherDraining)
+}
+
+// Fetches starting while Drain is called are either rejected or waited for, run with -race
+func TestDrainConcurrentFetches(t *testing.T) {
+	fetcher := NewFetcher(time.Second, time.Second, zap.NewNop())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if fetcher.beginFetch() {
+				fetcher.inFlight.Done()
+			}
+		}()
+	}
+
+	assert.True(t, fetcher.Drain(time.Second))
+	wg.Wait()
+}
@@ -0,0 +1,223 @@
+package rpc
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"github.com/xrpl-commons/firehose-xrpl/utils"
+	"go.uber.org/zap"
+)
+
+// FetchStats accumulates decode statistics over a fetcher run, safe for concurrent use
+type FetchStats struct {
+	mu sync.Mutex
+
+	ledgers          uint64
+	transactions     uint64
+	decodeFailures   uint64
+	codecOutdated    uint64
+	skippedLedgers   uint64
+	largeMeta        uint64
+	oversized        uint64
+	filtered         uint64
+	byType           map[string]uint64
+	byResultCategory map[utils.ResultCategory]uint64
+	decodeTimes      map[string]DecodeTiming
+}
+
+// DecodeTiming is the time spent decoding and mapping the transactions of one type
+type DecodeTiming struct {
+	Count uint64
+	Total time.Duration
+}
+
+// Average returns the mean decode and map time of a transaction
+func (t DecodeTiming) Average() time.Duration {
+	if t.Count == 0 {
+		return 0
+	}
+	return t.Total / time.Duration(t.Count)
+}
+
+// NewFetchStats creates an empty FetchStats
+func NewFetchStats() *FetchStats {
+	return &FetchStats{
+		byType:           make(map[string]uint64),
+		byResultCategory: make(map[utils.ResultCategory]uint64),
+		decodeTimes:      make(map[string]DecodeTiming),
+	}
+}
+
+// recordLedger counts a fetched ledger and its successfully mapped transactions
+func (s *FetchStats) recordLedger(transactions []*pbxrpl.Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ledgers++
+	s.transactions += uint64(len(transactions))
+	for _, tx := range transactions {
+		if tx.CodecOutdated {
+			s.codecOutdated++
+		}
+		s.byType[tx.TxType]++
+		s.byResultCategory[utils.GetResultCategory(tx.Result)]++
+	}
+}
+
+// recordDecodeFailure counts a transaction that could not be mapped to protobuf
+func (s *FetchStats) recordDecodeFailure() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.decodeFailures++
+}
+
+// recordSkippedLedger counts a ledger skipped because the endpoint cannot provide it
+func (s *FetchStats) recordSkippedLedger() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.skippedLedgers++
+}
+
+// recordLargeMeta counts a transaction whose metadata exceeds the large metadata threshold
+func (s *FetchStats) recordLargeMeta() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.largeMeta++
+}
+
+// recordOversized counts a transaction skipped because it exceeds the maximum transaction size
+func (s *FetchStats) recordOversized() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.oversized++
+}
+
+// recordFiltered counts a transaction left out of its block by the transaction type filter
+func (s *FetchStats) recordFiltered() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.filtered++
+}
+
+// recordDecodeTime adds the decode and map time of a transaction to its type's total
+func (s *FetchStats) recordDecodeTime(txType string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	timing := s.decodeTimes[txType]
+	timing.Count++
+	timing.Total += d
+	s.decodeTimes[txType] = timing
+}
+
+// recordDecodeDuration reports the decode and map time of a transaction to the metrics and the run summary
+func (f *Fetcher) recordDecodeDuration(txType string, d time.Duration) {
+	txDecodeDuration.WithLabelValues(txType).Observe(d.Seconds())
+	f.stats.recordDecodeTime(txType, d)
+}
+
+// StatsSummary is a point-in-time copy of FetchStats
+type StatsSummary struct {
+	Ledgers          uint64
+	Transactions     uint64
+	DecodeWarnings   uint64
+	DecodeFailures   uint64
+	CodecOutdated    uint64
+	SkippedLedgers   uint64
+	LargeMeta        uint64
+	Oversized        uint64
+	Filtered         uint64
+	ByType           map[string]uint64
+	ByResultCategory map[string]uint64
+	DecodeTimes      map[string]DecodeTiming // Only with decode profiling enabled
+}
+
+// snapshot copies the accumulated counters
+func (s *FetchStats) snapshot(decodeWarnings uint64) StatsSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	summary := StatsSummary{
+		Ledgers:          s.ledgers,
+		Transactions:     s.transactions,
+		DecodeWarnings:   decodeWarnings,
+		DecodeFailures:   s.decodeFailures,
+		CodecOutdated:    s.codecOutdated,
+		SkippedLedgers:   s.skippedLedgers,
+		LargeMeta:        s.largeMeta,
+		Oversized:        s.oversized,
+		Filtered:         s.filtered,
+		ByType:           make(map[string]uint64, len(s.byType)),
+		ByResultCategory: make(map[string]uint64, len(s.byResultCategory)),
+		DecodeTimes:      make(map[string]DecodeTiming, len(s.decodeTimes)),
+	}
+	for txType, count := range s.byType {
+		summary.ByType[txType] = count
+	}
+	for category, count := range s.byResultCategory {
+		summary.ByResultCategory[string(category)] = count
+	}
+	for txType, timing := range s.decodeTimes {
+		summary.DecodeTimes[txType] = timing
+	}
+
+	return summary
+}
+
+// Stats returns a summary of the decode statistics accumulated by the fetcher
+func (f *Fetcher) Stats() StatsSummary {
+	return f.stats.snapshot(f.decoder.WarningCount())
+}
+
+// LogStats logs a human-readable end-of-run summary of the decode statistics
+func (f *Fetcher) LogStats() {
+	summary := f.Stats()
+
+	f.logger.Info("fetch run summary",
+		zap.Uint64("ledgers", summary.Ledgers),
+		zap.Uint64("transactions", summary.Transactions),
+		zap.Uint64("decode_warnings", summary.DecodeWarnings),
+		zap.Uint64("decode_failures", summary.DecodeFailures),
+		zap.Uint64("codec_outdated", summary.CodecOutdated),
+		zap.Uint64("skipped_ledgers", summary.SkippedLedgers),
+		zap.Uint64("large_meta", summary.LargeMeta),
+		zap.Uint64("oversized", summary.Oversized),
+		zap.Uint64("filtered", summary.Filtered))
+
+	for _, txType := range SortedKeys(summary.ByType) {
+		f.logger.Info("transactions by type",
+			zap.String("tx_type", txType),
+			zap.Uint64("count", summary.ByType[txType]))
+	}
+	for _, category := range SortedKeys(summary.ByResultCategory) {
+		f.logger.Info("transactions by result category",
+			zap.String("category", category),
+			zap.Uint64("count", summary.ByResultCategory[category]))
+	}
+	for _, txType := range SortedKeys(summary.DecodeTimes) {
+		timing := summary.DecodeTimes[txType]
+		f.logger.Info("transaction decode time by type",
+			zap.String("tx_type", txType),
+			zap.Uint64("count", timing.Count),
+			zap.Duration("total", timing.Total),
+			zap.Duration("average", timing.Average()))
+	}
+}
+
+// SortedKeys returns the keys of a map in lexical order
+func SortedKeys[V any](counts map[string]V) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,151 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+// ErrWebSocketUnavailable is returned by SubscribeLedgers when no WebSocket endpoint is configured,
+// callers should fall back to polling over HTTP
+var ErrWebSocketUnavailable = errors.New("no websocket endpoint configured")
+
+const (
+	// maxSubscribeBackoff caps the delay between WebSocket reconnection attempts
+	maxSubscribeBackoff = 30 * time.Second
+
+	// maxSubscribeReconnects is how many consecutive failed reconnections end the subscription
+	maxSubscribeReconnects = 10
+)
+
+// ledgerStreamMessage is a message of the rippled "ledger" stream, or the response to the subscribe request
+type ledgerStreamMessage struct {
+	Type        string `json:"type"`
+	LedgerHash  string `json:"ledger_hash"`
+	LedgerIndex uint64 `json:"ledger_index"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+}
+
+// SetWebSocketEndpoint sets the rippled WebSocket URL (ws:// or wss://) used by SubscribeLedgers
+// rippled serves WebSocket on a different port than JSON-RPC, so it is configured separately
+func (c *Client) SetWebSocketEndpoint(wsEndpoint string) {
+	c.wsEndpoint = wsEndpoint
+}
+
+// SubscribeLedgers subscribes to the rippled "ledger" stream and pushes each validated ledger as it closes
+//
+// The connection is re-established with exponential backoff when it drops. The channel is closed
+// when ctx is done or when reconnecting keeps failing, so a closed channel means the subscription
+// is gone and the caller should fall back to polling. The initial connection error is returned directly.
+func (c *Client) SubscribeLedgers(ctx context.Context) (<-chan types.LedgerClosedResult, error) {
+	if c.wsEndpoint == "" {
+		return nil, ErrWebSocketUnavailable
+	}
+
+	conn, err := c.dialLedgerStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	closed := make(chan types.LedgerClosedResult, 16)
+	go func() {
+		defer close(closed)
+
+		backoff := time.Second
+		failures := 0
+		for {
+			err := c.readLedgerStream(ctx, conn, closed)
+			if ctx.Err() != nil {
+				return
+			}
+
+			c.logger.Warn("ledger subscription disconnected, reconnecting",
+				zap.String("endpoint", c.wsEndpoint),
+				zap.Error(err))
+
+			for conn = nil; conn == nil; {
+				if err := sleepContext(ctx, backoff); err != nil {
+					return
+				}
+				backoff = min(backoff*2, maxSubscribeBackoff)
+
+				conn, err = c.dialLedgerStream(ctx)
+				if err != nil {
+					failures++
+					c.logger.Warn("ledger subscription reconnect failed",
+						zap.String("endpoint", c.wsEndpoint),
+						zap.Int("attempt", failures),
+						zap.Duration("backoff", backoff),
+						zap.Error(err))
+					if failures >= maxSubscribeReconnects {
+						c.logger.Error("giving up on ledger subscription", zap.String("endpoint", c.wsEndpoint))
+						return
+					}
+				}
+			}
+
+			backoff = time.Second
+			failures = 0
+		}
+	}()
+
+	return closed, nil
+}
+
+// dialLedgerStream connects to the WebSocket endpoint and subscribes to the ledger stream
+func (c *Client) dialLedgerStream(ctx context.Context) (*websocket.Conn, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.wsEndpoint, c.headers)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", c.wsEndpoint, err)
+	}
+
+	subscribe := map[string]any{"command": "subscribe", "streams": []string{"ledger"}}
+	if err := conn.WriteJSON(subscribe); err != nil {
+		c.closeLedgerStream(conn)
+		return nil, fmt.Errorf("sending subscribe request: %w", err)
+	}
+
+	return conn, nil
+}
+
+// closeLedgerStream closes the WebSocket connection, which may already be closed when ctx ended
+func (c *Client) closeLedgerStream(conn *websocket.Conn) {
+	if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		c.logger.Debug("failed to close ledger stream connection", zap.String("endpoint", c.wsEndpoint), zap.Error(err))
+	}
+}
+
+// readLedgerStream forwards ledgerClosed messages until the connection fails or ctx is done
+func (c *Client) readLedgerStream(ctx context.Context, conn *websocket.Conn, out chan<- types.LedgerClosedResult) error {
+	// Unblock ReadJSON when the caller goes away
+	stop := context.AfterFunc(ctx, func() { c.closeLedgerStream(conn) })
+	defer stop()
+	defer c.closeLedgerStream(conn)
+
+	for {
+		var msg ledgerStreamMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			return err
+		}
+
+		switch msg.Type {
+		case "response":
+			if msg.Status != "success" {
+				return fmt.Errorf("subscribe rejected: %s", msg.Error)
+			}
+		case "ledgerClosed":
+			select {
+			case out <- types.LedgerClosedResult{LedgerHash: msg.LedgerHash, LedgerIndex: msg.LedgerIndex, Status: "success"}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
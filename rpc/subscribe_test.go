@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+// ledgerStreamServer answers a ledger subscription with the given stream messages, then holds the connection
+func ledgerStreamServer(t *testing.T, messages ...ledgerStreamMessage) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var subscribe map[string]any
+		if err := conn.ReadJSON(&subscribe); err != nil {
+			return
+		}
+		assert.Equal(t, "subscribe", subscribe["command"])
+
+		_ = conn.WriteJSON(ledgerStreamMessage{Type: "response", Status: "success"})
+		for _, msg := range messages {
+			_ = conn.WriteJSON(msg)
+		}
+
+		// Block until the client goes away
+		_, _, _ = conn.ReadMessage()
+	}))
+}
+
+func TestSubscribeLedgers(t *testing.T) {
+	server := ledgerStreamServer(t,
+		ledgerStreamMessage{Type: "ledgerClosed", LedgerIndex: 90000001, LedgerHash: "AA"},
+		ledgerStreamMessage{Type: "serverStatus"},
+		ledgerStreamMessage{Type: "ledgerClosed", LedgerIndex: 90000002, LedgerHash: "BB"},
+	)
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop())
+	require.NoError(t, err)
+	client.SetWebSocketEndpoint("ws" + strings.TrimPrefix(server.URL, "http"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	closed, err := client.SubscribeLedgers(ctx)
+	require.NoError(t, err)
+
+	for _, want := range []types.LedgerClosedResult{
+		{LedgerIndex: 90000001, LedgerHash: "AA", Status: "success"},
+		{LedgerIndex: 90000002, LedgerHash: "BB", Status: "success"},
+	} {
+		select {
+		case got := <-closed:
+			assert.Equal(t, want, got)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for a closed ledger")
+		}
+	}
+
+	// The channel closes once the caller is gone
+	cancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-closed:
+			return !ok
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+}
+
+func TestSubscribeLedgersUnavailable(t *testing.T) {
+	client, err := NewClient("http://127.0.0.1:1", zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = client.SubscribeLedgers(context.Background())
+	assert.ErrorIs(t, err, ErrWebSocketUnavailable)
+}
@@ -0,0 +1,210 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// busyThreshold is how many busy responses within busyWindow slow the endpoint's request rate down
+	busyThreshold = 2
+
+	// busyWindow is the period busy responses are counted over
+	busyWindow = 10 * time.Second
+
+	// throttleStartRate is the request rate (per second) an unlimited endpoint is slowed to first
+	throttleStartRate = 10.0
+
+	// minThrottleRate is the lowest request rate (per second) an endpoint is slowed to
+	minThrottleRate = 0.5
+
+	// throttleRecovery is the factor the slowed rate grows by on each successful request
+	throttleRecovery = 1.05
+
+	// maxRetryAfter caps how long a Retry-After header pauses the endpoint
+	maxRetryAfter = time.Minute
+)
+
+// WithMaxRequestsPerSecond caps the requests sent to the endpoint per second, busy responses
+// can still slow it down further. 0 disables the cap
+func WithMaxRequestsPerSecond(rate float64) ClientOption {
+	return func(c *Client) {
+		c.throttle.maxRate = rate
+	}
+}
+
+// throttle is a token bucket pacing the requests to an endpoint, safe for concurrent use
+// Its rate adapts to the endpoint: halved on repeated busy responses (HTTP 429/503, rippled
+// tooBusy/slowDown) and grown back on successes until the limit is lifted or back at the cap
+type throttle struct {
+	mu          sync.Mutex
+	maxRate     float64 // Hard cap from the configuration, 0 = none
+	rate        float64 // Adaptive rate after busy responses, 0 = not slowed down
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time // Set from Retry-After
+	busyCount   int       // Busy responses since busySince
+	busySince   time.Time
+}
+
+// effectiveRateLocked returns the requests per second currently allowed, 0 for unlimited
+func (t *throttle) effectiveRateLocked() float64 {
+	if t.maxRate > 0 && (t.rate == 0 || t.rate > t.maxRate) {
+		return t.maxRate
+	}
+	return t.rate
+}
+
+// reserve takes a token, returning how long the request must wait before being sent
+func (t *throttle) reserve(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var wait time.Duration
+	if now.Before(t.pausedUntil) {
+		wait = t.pausedUntil.Sub(now)
+	}
+
+	rate := t.effectiveRateLocked()
+	if rate == 0 {
+		return wait
+	}
+
+	// Allow a burst of one second worth of requests, at least one
+	burst := max(rate, 1)
+	t.tokens = min(burst, t.tokens+now.Sub(t.last).Seconds()*rate)
+	t.last = now
+
+	// Tokens go negative while requests are queued, each waiting for its turn
+	t.tokens--
+	if t.tokens < 0 {
+		wait = max(wait, time.Duration(-t.tokens/rate*float64(time.Second)))
+	}
+
+	return wait
+}
+
+// busy records a busy response, pausing for retryAfter when set. It returns the new rate
+// when the endpoint was slowed down
+func (t *throttle) busy(now time.Time, retryAfter time.Duration) (slowedTo float64, slowed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if retryAfter > 0 {
+		until := now.Add(min(retryAfter, maxRetryAfter))
+		if until.After(t.pausedUntil) {
+			t.pausedUntil = until
+		}
+	}
+
+	// A rippled error result follows a successful HTTP request, so busy responses are counted
+	// over a window rather than in a row
+	if now.Sub(t.busySince) > busyWindow {
+		t.busyCount, t.busySince = 0, now
+	}
+	t.busyCount++
+	if t.busyCount < busyThreshold {
+		return 0, false
+	}
+	t.busyCount = 0
+
+	current := t.effectiveRateLocked()
+	if current == 0 {
+		current = 2 * throttleStartRate
+	}
+	t.rate = max(current/2, minThrottleRate)
+
+	return t.rate, true
+}
+
+// ok records a successful request, growing a slowed rate back. It returns true when the
+// adaptive limit was lifted
+func (t *throttle) ok() (lifted bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.rate == 0 {
+		return false
+	}
+
+	ceiling := t.maxRate
+	if ceiling == 0 {
+		ceiling = 2 * throttleStartRate
+	}
+
+	t.rate *= throttleRecovery
+	if t.rate >= ceiling {
+		t.rate = 0
+		return true
+	}
+	return false
+}
+
+// throttleWait waits for the endpoint's throttle to let a request through
+func (c *Client) throttleWait(ctx context.Context) error {
+	return sleepContext(ctx, c.throttle.reserve(time.Now()))
+}
+
+// recordBusy feeds a busy response to the throttle
+func (c *Client) recordBusy(retryAfter time.Duration) {
+	if rate, slowed := c.throttle.busy(time.Now(), retryAfter); slowed {
+		c.logger.Warn("endpoint busy, slowing down requests",
+			zap.String("endpoint", c.rpcEndpoint),
+			zap.Float64("requests_per_second", rate),
+			zap.Duration("retry_after", retryAfter))
+	}
+}
+
+// rpcResponse is a JSON-RPC response whose result can carry a rippled error
+type rpcResponse interface {
+	ResultError() string
+}
+
+// recordThrottleOutcome feeds the outcome of a request to the throttle, once its result is decoded
+// into out: a busy result arrives with HTTP 200 and is recorded by rpcError, it is no success
+func (c *Client) recordThrottleOutcome(err error, out any) {
+	var statusErr *httpStatusError
+	switch {
+	case err == nil:
+		if response, ok := out.(rpcResponse); ok && isBusyResult(response.ResultError()) {
+			return
+		}
+		if c.throttle.ok() {
+			c.logger.Info("endpoint recovered, request rate limit lifted", zap.String("endpoint", c.rpcEndpoint))
+		}
+	case errors.As(err, &statusErr) && isBusyStatus(statusErr.StatusCode):
+		c.recordBusy(statusErr.RetryAfter)
+	}
+}
+
+// isBusyStatus reports whether an HTTP status asks the client to slow down, rippled
+// answering 503 when it sheds load
+func isBusyStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// isBusyResult reports whether a rippled error result asks the client to slow down
+func isBusyResult(name string) bool {
+	return name == "tooBusy" || name == "slowDown"
+}
+
+// parseRetryAfter parses a Retry-After header, either delay seconds or an HTTP date
+// It returns 0 when the header is absent or invalid
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
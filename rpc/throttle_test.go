@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestThrottleBusy(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		maxRate  float64
+		busy     int
+		wantRate float64
+	}{
+		{"single busy response", 0, 1, 0},
+		{"unlimited endpoint slowed to the start rate", 0, busyThreshold, throttleStartRate},
+		{"capped endpoint halved", 8, busyThreshold, 4},
+		{"halved twice", 8, 2 * busyThreshold, 2},
+		{"floored at the minimum rate", 0.6, busyThreshold, minThrottleRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := &throttle{maxRate: tt.maxRate}
+			for i := 0; i < tt.busy; i++ {
+				th.busy(now, 0)
+			}
+			assert.Equal(t, tt.wantRate, th.rate)
+		})
+	}
+}
+
+func TestThrottleRecovery(t *testing.T) {
+	th := &throttle{rate: 10}
+
+	var lifted bool
+	var successes int
+	for !lifted {
+		lifted = th.ok()
+		successes++
+		require.Less(t, successes, 100, "rate never recovered")
+	}
+
+	assert.Equal(t, 0.0, th.rate)
+	assert.False(t, th.ok(), "nothing to lift once recovered")
+}
+
+func TestThrottleRetryAfter(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{"no header", 0, 0},
+		{"paused", 5 * time.Second, 5 * time.Second},
+		{"capped", time.Hour, maxRetryAfter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := &throttle{}
+			th.busy(now, tt.retryAfter)
+			assert.Equal(t, tt.want, th.reserve(now))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{"-3", 0},
+		{"Mon, 01 Jan 2024 00:00:10 GMT", 10 * time.Second},
+		{"Sun, 31 Dec 2023 23:59:00 GMT", 0},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.value, now))
+		})
+	}
+}
+
+func TestBusyResultSlowsDown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"error":"tooBusy","error_code":9,"status":"error"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, zap.NewNop(), WithRetryPolicy(0, 0))
+	require.NoError(t, err)
+	client.throttle.rate = 4
+
+	for i := 0; i < busyThreshold; i++ {
+		_, err := client.GetServerInfo(context.Background())
+
+		var rpcErr *RPCLedgerError
+		require.True(t, errors.As(err, &rpcErr))
+		assert.True(t, rpcErr.IsBusy())
+	}
+
+	// Halved once, without a recovery step for the HTTP 200 of the busy results
+	assert.Equal(t, 2.0, client.throttle.rate)
+}
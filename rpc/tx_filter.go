@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xrpl-commons/firehose-xrpl/decoder"
+)
+
+// txTypeFilter selects the transactions kept in blocks by type, nil keeps everything
+type txTypeFilter struct {
+	include map[string]bool // Empty includes every type
+	exclude map[string]bool
+}
+
+// SetTxTypeFilter only keeps transactions whose type is in include (all types when empty) and not in exclude,
+// to shrink blocks for indexers interested in a few types. Filtered-out transactions are left out of the block
+// but keep their place: the remaining transactions keep their ledger index, and the header still counts them
+func (f *Fetcher) SetTxTypeFilter(include, exclude []string) error {
+	if len(include) == 0 && len(exclude) == 0 {
+		f.txFilter = nil
+		return nil
+	}
+
+	filter := &txTypeFilter{}
+	var err error
+	if filter.include, err = txTypeSet(include); err != nil {
+		return err
+	}
+	if filter.exclude, err = txTypeSet(exclude); err != nil {
+		return err
+	}
+
+	f.txFilter = filter
+	return nil
+}
+
+// txTypeSet validates transaction type names against the types the decoder knows
+func txTypeSet(txTypes []string) (map[string]bool, error) {
+	set := make(map[string]bool, len(txTypes))
+	for _, txType := range txTypes {
+		if !decoder.IsKnownTransactionType(txType) {
+			return nil, fmt.Errorf("unknown transaction type %q, valid types are: %s", txType, strings.Join(decoder.KnownTransactionTypes(), ", "))
+		}
+		set[txType] = true
+	}
+
+	return set, nil
+}
+
+// keeps reports whether a transaction of txType belongs in the block
+// Transactions whose type could not be decoded are kept, since they cannot be told apart
+func (t *txTypeFilter) keeps(txType string) bool {
+	if t == nil || txType == "" {
+		return true
+	}
+	if len(t.include) > 0 && !t.include[txType] {
+		return false
+	}
+
+	return !t.exclude[txType]
+}
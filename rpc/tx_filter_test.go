@@ -0,0 +1,133 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xrpl-commons/firehose-xrpl/types"
+	"go.uber.org/zap"
+)
+
+func TestTxTypeFilterKeeps(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		keeps   map[string]bool
+	}{
+		{
+			name:  "no filter",
+			keeps: map[string]bool{"Payment": true, "AccountSet": true, "": true},
+		},
+		{
+			name:    "include mode",
+			include: []string{"Payment", "AMMDeposit"},
+			keeps:   map[string]bool{"Payment": true, "AMMDeposit": true, "AccountSet": false, "": true},
+		},
+		{
+			name:    "exclude mode",
+			exclude: []string{"AccountSet"},
+			keeps:   map[string]bool{"Payment": true, "AMMDeposit": true, "AccountSet": false, "": true},
+		},
+		{
+			name:    "exclude within include",
+			include: []string{"Payment", "AMMDeposit"},
+			exclude: []string{"AMMDeposit"},
+			keeps:   map[string]bool{"Payment": true, "AMMDeposit": false, "AccountSet": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			defer fetcher.Close()
+
+			require.NoError(t, fetcher.SetTxTypeFilter(tt.include, tt.exclude))
+			for txType, want := range tt.keeps {
+				assert.Equal(t, want, fetcher.txFilter.keeps(txType), "type %q", txType)
+			}
+		})
+	}
+}
+
+func TestSetTxTypeFilterUnknownType(t *testing.T) {
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+	defer fetcher.Close()
+
+	assert.Error(t, fetcher.SetTxTypeFilter([]string{"Paymnet"}, nil))
+	assert.Error(t, fetcher.SetTxTypeFilter(nil, []string{"Paymnet"}))
+}
+
+// mixedLedger returns a validated ledger holding a Payment, an AccountSet and a Payment, in that order
+func mixedLedger(t *testing.T, index uint64) *types.LedgerResult {
+	accountSet, err := binarycodec.Encode(map[string]interface{}{
+		"TransactionType": "AccountSet",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Fee":             "12",
+		"Sequence":        uint32(6),
+		"Flags":           uint32(0),
+	})
+	require.NoError(t, err)
+
+	ledger := benchmarkLedger(index, 3)
+	for i := range ledger.Ledger.Transactions {
+		meta, err := binarycodec.Encode(map[string]interface{}{
+			"TransactionIndex":  uint32(i),
+			"TransactionResult": "tesSUCCESS",
+			"AffectedNodes":     []interface{}{},
+		})
+		require.NoError(t, err)
+		ledger.Ledger.Transactions[i].Meta = meta
+	}
+	ledger.Ledger.Transactions[1].TxBlob = accountSet
+	ledger.Ledger.Transactions[1].Hash = strings.Repeat("EF", 32)
+
+	return ledger
+}
+
+func TestFetchTxTypeFilter(t *testing.T) {
+	tests := []struct {
+		name        string
+		include     []string
+		exclude     []string
+		wantTypes   []string
+		wantIndexes []uint32
+	}{
+		{"include mode", []string{"Payment"}, nil, []string{"Payment", "Payment"}, []uint32{0, 2}},
+		{"exclude mode", nil, []string{"Payment"}, []string{"AccountSet"}, []uint32{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const num = 90000001
+			client := &fakeClient{endpoint: "memory", ledger: mixedLedger(t, num)}
+
+			fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.NewNop())
+			defer fetcher.Close()
+			fetcher.lastBlockInfo.advance(num)
+			require.NoError(t, fetcher.SetTxTypeFilter(tt.include, tt.exclude))
+
+			block, err := fetcher.FetchLedgerBlock(context.Background(), client, num)
+			require.NoError(t, err)
+
+			var txTypes []string
+			var indexes []uint32
+			for _, tx := range block.Transactions {
+				txTypes = append(txTypes, tx.TxType)
+				indexes = append(indexes, tx.Index)
+			}
+			assert.Equal(t, tt.wantTypes, txTypes)
+			assert.Equal(t, tt.wantIndexes, indexes)
+
+			// The header still describes the whole ledger
+			assert.Equal(t, uint32(3), block.Header.TransactionCount)
+			assert.True(t, block.Header.Filtered)
+			assert.Equal(t, uint64(3-len(tt.wantTypes)), fetcher.Stats().Filtered)
+		})
+	}
+}
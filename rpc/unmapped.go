@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+)
+
+// unmappedWarnInterval is the minimum time between two warnings about the same unmapped transaction type
+const unmappedWarnInterval = 10 * time.Minute
+
+// unmappedTypes rate-limits the warnings about transaction types without a mapping, per type
+type unmappedTypes struct {
+	mu         sync.Mutex
+	lastWarned map[string]time.Time
+}
+
+// shouldWarn reports whether a warning about txType is due, and if so marks it as warned at now
+func (u *unmappedTypes) shouldWarn(txType string, now time.Time) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if last, ok := u.lastWarned[txType]; ok && now.Sub(last) < unmappedWarnInterval {
+		return false
+	}
+	if u.lastWarned == nil {
+		u.lastWarned = make(map[string]time.Time)
+	}
+	u.lastWarned[txType] = now
+	return true
+}
+
+// IsUnmapped reports whether a mapped transaction's type has no mapping, its tx_details only carrying
+// the raw decoded fields, or left empty when those could not be converted
+func IsUnmapped(tx *pbxrpl.Transaction) bool {
+	if tx.GetUnknownDetails() != nil {
+		return true
+	}
+	// Every mapped type sets tx_details, codec outdated transactions are counted on their own
+	return tx.TxDetails == nil && !tx.CodecOutdated
+}
+
+// recordUnmapped counts a transaction whose type has no mapping, its tx_details only carrying the raw
+// decoded fields, and warns so a mapping gets added for the new type
+func (f *Fetcher) recordUnmapped(tx *pbxrpl.Transaction, ledgerIndex uint64) {
+	unmappedTransactions.Inc(tx.TxType)
+
+	if !f.unmapped.shouldWarn(tx.TxType, time.Now()) {
+		return
+	}
+	f.logger.Warn("transaction type has no mapping, tx_details only holds its raw fields",
+		zap.String("tx_type", tx.TxType),
+		zap.Uint64("ledger_index", ledgerIndex),
+		zap.Uint32("tx_index", tx.Index))
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	pbxrpl "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/type/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestUnmappedTypesShouldWarn(t *testing.T) {
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		txType string
+		at     time.Duration
+		want   bool
+	}{
+		{"first sighting", "FutureTx", 0, true},
+		{"same type within the interval", "FutureTx", unmappedWarnInterval - time.Second, false},
+		{"other type", "OtherFutureTx", time.Minute, true},
+		{"same type after the interval", "FutureTx", unmappedWarnInterval, true},
+		{"just warned again", "FutureTx", unmappedWarnInterval + time.Minute, false},
+	}
+
+	// Cases run in order against the same tracker
+	var unmapped unmappedTypes
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, unmapped.shouldWarn(tt.txType, start.Add(tt.at)))
+		})
+	}
+}
+
+func TestIsUnmapped(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   *pbxrpl.Transaction
+		want bool
+	}{
+		{"mapped", &pbxrpl.Transaction{TxType: "AccountSet", TxDetails: &pbxrpl.Transaction_AccountSet{AccountSet: &pbxrpl.AccountSet{}}}, false},
+		{"raw fields", &pbxrpl.Transaction{TxType: "SyntheticUnknownTx", TxDetails: &pbxrpl.Transaction_UnknownDetails{UnknownDetails: &structpb.Struct{}}}, true},
+		{"raw fields not convertible", &pbxrpl.Transaction{TxType: "SyntheticUnknownTx"}, true},
+		{"codec outdated", &pbxrpl.Transaction{TxType: "SyntheticUnknownTx", CodecOutdated: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsUnmapped(tt.tx))
+		})
+	}
+}
+
+func TestRecordUnmapped(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	fetcher := NewFetcher(time.Millisecond, time.Millisecond, zap.New(core))
+	defer fetcher.Close()
+
+	counter := unmappedTransactions.Native().WithLabelValues("SyntheticUnknownTx")
+	before := testutil.ToFloat64(counter)
+
+	tx := &pbxrpl.Transaction{TxType: "SyntheticUnknownTx", Index: 3}
+	for i := 0; i < 3; i++ {
+		fetcher.recordUnmapped(tx, 90000001)
+	}
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(counter)-before)
+	assert.Equal(t, 1, logs.FilterMessageSnippet("has no mapping").Len(), "the warning is rate-limited")
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	pbxrplsvc "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/service/v1"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlocksServer implements the BlockStream gRPC service on top of the ledger fetcher
+type BlocksServer struct {
+	pbxrplsvc.UnimplementedBlockStreamServer
+
+	client     *rpc.Client
+	newFetcher func() *rpc.Fetcher
+	logger     *zap.Logger
+
+	// Cancelled by Shutdown, ends every open stream
+	shutdownCtx context.Context
+	shutdown    context.CancelFunc
+}
+
+// NewBlocksServer creates a BlockStream service fetching ledgers through client
+// newFetcher is called once per stream so concurrent streams don't share fetch state
+func NewBlocksServer(client *rpc.Client, newFetcher func() *rpc.Fetcher, logger *zap.Logger) *BlocksServer {
+	shutdownCtx, shutdown := context.WithCancel(context.Background())
+	return &BlocksServer{
+		client:      client,
+		newFetcher:  newFetcher,
+		logger:      logger,
+		shutdownCtx: shutdownCtx,
+		shutdown:    shutdown,
+	}
+}
+
+// Shutdown ends every open stream and refuses new ones. grpc.Server.GracefulStop waits for open
+// streams, and a stream following the chain head never ends on its own
+func (s *BlocksServer) Shutdown() {
+	s.shutdown()
+}
+
+// Blocks streams ledgers from start_block to stop_block, or follows the chain head when stop_block is 0
+// Send blocks when the client's flow control window is full, so a slow client slows down fetching
+func (s *BlocksServer) Blocks(req *pbxrplsvc.BlocksRequest, stream pbxrplsvc.BlockStream_BlocksServer) error {
+	if req.StopBlock != 0 && req.StopBlock < req.StartBlock {
+		return status.Errorf(codes.InvalidArgument, "stop_block %d is before start_block %d", req.StopBlock, req.StartBlock)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	stopWatching := context.AfterFunc(s.shutdownCtx, cancel)
+	defer stopWatching()
+
+	fetcher := s.newFetcher()
+	defer fetcher.Close()
+
+	s.logger.Info("starting block stream",
+		zap.Uint64("start_block", req.StartBlock),
+		zap.Uint64("stop_block", req.StopBlock))
+
+	for blockNum := req.StartBlock; req.StopBlock == 0 || blockNum <= req.StopBlock; blockNum++ {
+		if ctx.Err() != nil {
+			return s.contextError(ctx)
+		}
+
+		block, err := fetcher.FetchLedgerBlock(ctx, s.client, blockNum)
+		if err != nil {
+			if ctx.Err() != nil {
+				return s.contextError(ctx)
+			}
+			return status.Error(codes.Unavailable, fmt.Sprintf("fetching ledger %d: %s", blockNum, err))
+		}
+
+		if err := stream.Send(&pbxrplsvc.BlocksResponse{Block: block}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// contextError reports why a stream's context ended: the server shutting down or the client going away
+func (s *BlocksServer) contextError(ctx context.Context) error {
+	if s.shutdownCtx.Err() != nil {
+		return status.Error(codes.Unavailable, "server shutting down")
+	}
+	return status.FromContextError(ctx.Err()).Err()
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbxrplsvc "github.com/xrpl-commons/firehose-xrpl/pb/sf/xrpl/service/v1"
+	"github.com/xrpl-commons/firehose-xrpl/rpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startBlocksServer serves the recorded replay ledgers (90000001 and 90000129) over an in-memory gRPC connection
+func startBlocksServer(t *testing.T) (*grpc.Server, *BlocksServer, pbxrplsvc.BlockStreamClient) {
+	t.Helper()
+
+	replay := httptest.NewServer(rpc.NewReplayHandler("../rpc/testdata/replay", "", zap.NewNop()))
+	t.Cleanup(replay.Close)
+
+	client, err := rpc.NewClient(replay.URL, zap.NewNop())
+	require.NoError(t, err)
+
+	newFetcher := func() *rpc.Fetcher {
+		return rpc.NewFetcherWithWorkerPool(0, 10*time.Millisecond, 2, zap.NewNop())
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	blocksServer := NewBlocksServer(client, newFetcher, zap.NewNop())
+	pbxrplsvc.RegisterBlockStreamServer(grpcServer, blocksServer)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return grpcServer, blocksServer, pbxrplsvc.NewBlockStreamClient(conn)
+}
+
+func TestBlocksRange(t *testing.T) {
+	_, _, client := startBlocksServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.Blocks(ctx, &pbxrplsvc.BlocksRequest{StartBlock: 90000001, StopBlock: 90000001})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(90000001), resp.Block.Number)
+
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestBlocksInvalidRange(t *testing.T) {
+	_, _, client := startBlocksServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.Blocks(ctx, &pbxrplsvc.BlocksRequest{StartBlock: 90000002, StopBlock: 90000001})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBlocksClientCancel(t *testing.T) {
+	_, _, client := startBlocksServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Following from the recorded tip, the stream waits for 90000130 after the first block
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream, err := client.Blocks(streamCtx, &pbxrplsvc.BlocksRequest{StartBlock: 90000129})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	cancelStream()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestBlocksShutdownEndsFollowingStream(t *testing.T) {
+	grpcServer, blocksServer, client := startBlocksServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.Blocks(ctx, &pbxrplsvc.BlocksRequest{StartBlock: 90000129})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	stopped := make(chan struct{})
+	go func() {
+		blocksServer.Shutdown()
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		t.Fatal("graceful stop waited on the following stream")
+	}
+}
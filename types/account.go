@@ -0,0 +1,40 @@
+package types
+
+// AccountObjectsRequest represents a request to list the ledger objects owned by an account
+type AccountObjectsRequest struct {
+	Method string                 `json:"method"`
+	Params []AccountObjectsParams `json:"params"`
+}
+
+type AccountObjectsParams struct {
+	Account     string `json:"account"`
+	LedgerIndex any    `json:"ledger_index,omitempty"` // Can be uint64 or string ("validated", "closed", "current")
+	Type        string `json:"type,omitempty"`         // e.g. "offer", "state", "escrow", "check"
+	Limit       uint32 `json:"limit,omitempty"`
+	Marker      any    `json:"marker,omitempty"` // Opaque pagination marker from a previous response
+}
+
+// AccountObjectsResponse represents the response from account_objects
+type AccountObjectsResponse struct {
+	Result AccountObjectsResult `json:"result"`
+}
+
+// ResultError returns the rippled error of the result, empty on success
+func (r *AccountObjectsResponse) ResultError() string {
+	return r.Result.Error
+}
+
+type AccountObjectsResult struct {
+	Account        string           `json:"account"`
+	AccountObjects []map[string]any `json:"account_objects"`
+	LedgerHash     string           `json:"ledger_hash,omitempty"`
+	LedgerIndex    uint64           `json:"ledger_index,omitempty"`
+	Limit          uint32           `json:"limit,omitempty"`
+	Marker         any              `json:"marker,omitempty"` // Present when more pages are available
+	Validated      bool             `json:"validated"`
+	Status         string           `json:"status"`
+	// Error fields
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
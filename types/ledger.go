@@ -24,6 +24,11 @@ type LedgerClosedResponse struct {
 	Result LedgerClosedResult `json:"result"`
 }
 
+// ResultError returns the rippled error of the result, empty on success
+func (r *LedgerClosedResponse) ResultError() string {
+	return r.Result.Error
+}
+
 type LedgerClosedResult struct {
 	LedgerHash  string `json:"ledger_hash"`
 	LedgerIndex uint64 `json:"ledger_index"`
@@ -121,6 +126,71 @@ type LedgerTransaction struct {
 	MetaData        any    `json:"metaData,omitempty"`    // JSON metadata when binary=false
 }
 
+// LedgerJSONResponse represents the response from the ledger method with binary=false
+type LedgerJSONResponse struct {
+	Result LedgerJSONResult `json:"result"`
+}
+
+// ResultError returns the rippled error of the result, empty on success
+func (r *LedgerJSONResponse) ResultError() string {
+	return r.Result.Error
+}
+
+// LedgerJSONResult is a ledger fetched with expand=true and binary=false: the header and the
+// transactions exactly as rippled renders them in JSON, for comparison with the binary decoding
+type LedgerJSONResult struct {
+	Ledger      map[string]any `json:"ledger"`
+	LedgerHash  string         `json:"ledger_hash"`
+	LedgerIndex uint64         `json:"ledger_index"`
+	Validated   bool           `json:"validated"`
+	Status      string         `json:"status"`
+	// Error fields
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// JSONTransaction is an expanded ledger transaction with its metadata
+type JSONTransaction struct {
+	Hash string
+	Tx   map[string]any
+	Meta map[string]any
+}
+
+// Transactions returns the expanded transactions of the ledger in ledger order, whatever the API
+// version: API v1 inlines the transaction fields next to metaData, API v2 nests them in tx_json and meta
+func (r *LedgerJSONResult) Transactions() []JSONTransaction {
+	entries, _ := r.Ledger["transactions"].([]any)
+
+	txs := make([]JSONTransaction, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		tx := JSONTransaction{}
+		tx.Hash, _ = fields["hash"].(string)
+
+		if txJSON, ok := fields["tx_json"].(map[string]any); ok {
+			tx.Tx = txJSON
+			tx.Meta, _ = fields["meta"].(map[string]any)
+		} else {
+			tx.Tx = make(map[string]any, len(fields))
+			for name, value := range fields {
+				if name != "metaData" {
+					tx.Tx[name] = value
+				}
+			}
+			tx.Meta, _ = fields["metaData"].(map[string]any)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
 // ServerInfoRequest represents a request to get server information
 type ServerInfoRequest struct {
 	Method string `json:"method"`
@@ -132,9 +202,21 @@ type ServerInfoResponse struct {
 	Result ServerInfoResult `json:"result"`
 }
 
+// ResultError returns the rippled error of the result, empty on success
+func (r *ServerInfoResponse) ResultError() string {
+	return r.Result.Error
+}
+
 type ServerInfoResult struct {
 	Info   ServerInfo `json:"info"`
 	Status string     `json:"status"`
+	// Error fields (present when status == "error")
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+
+	// CompleteLedgers is Info.CompleteLedgers parsed into ranges
+	CompleteLedgers LedgerRanges `json:"-"`
 }
 
 type ServerInfo struct {
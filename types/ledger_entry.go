@@ -0,0 +1,92 @@
+package types
+
+import "encoding/json"
+
+// LedgerEntryRequest represents a ledger_entry request for a single ledger object
+type LedgerEntryRequest struct {
+	Method string              `json:"method"`
+	Params []LedgerEntryParams `json:"params"`
+}
+
+type LedgerEntryParams struct {
+	Index       string `json:"index"`                  // Ledger entry ID (64 hex chars)
+	LedgerIndex any    `json:"ledger_index,omitempty"` // Can be uint64 or string ("validated", "closed", "current")
+}
+
+// LedgerEntryResponse represents the response from ledger_entry
+type LedgerEntryResponse struct {
+	Result LedgerEntryResult `json:"result"`
+}
+
+// ResultError returns the rippled error of the result, empty on success
+func (r *LedgerEntryResponse) ResultError() string {
+	return r.Result.Error
+}
+
+type LedgerEntryResult struct {
+	Index       string         `json:"index"`
+	LedgerIndex uint64         `json:"ledger_index,omitempty"`
+	Node        map[string]any `json:"node"`
+	Validated   bool           `json:"validated"`
+	Status      string         `json:"status"`
+	// Error fields
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// LedgerDataRequest represents a ledger_data request for one page of a ledger's state
+type LedgerDataRequest struct {
+	Method string             `json:"method"`
+	Params []LedgerDataParams `json:"params"`
+}
+
+type LedgerDataParams struct {
+	LedgerIndex any    `json:"ledger_index,omitempty"` // Can be uint64 or string ("validated", "closed", "current")
+	Binary      bool   `json:"binary"`
+	Limit       uint32 `json:"limit,omitempty"`
+	Marker      any    `json:"marker,omitempty"` // Opaque pagination marker from a previous response
+}
+
+// LedgerDataResponse represents the response from ledger_data
+type LedgerDataResponse struct {
+	Result LedgerDataResult `json:"result"`
+}
+
+// ResultError returns the rippled error of the result, empty on success
+func (r *LedgerDataResponse) ResultError() string {
+	return r.Result.Error
+}
+
+type LedgerDataResult struct {
+	LedgerHash  string           `json:"ledger_hash"`
+	LedgerIndex json.Number      `json:"ledger_index"` // rippled quotes it in ledger_data responses
+	State       []LedgerDataItem `json:"state"`
+	Marker      any              `json:"marker,omitempty"` // Present when more pages are available
+	Validated   bool             `json:"validated"`
+	Status      string           `json:"status"`
+	// Error fields
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// LedgerDataItem is a ledger object of a binary ledger_data page
+type LedgerDataItem struct {
+	Data  string `json:"data"`  // Binary ledger object (hex)
+	Index string `json:"index"` // Ledger entry ID (64 hex chars)
+}
+
+// LedgerObject is a decoded ledger object
+type LedgerObject struct {
+	Index  string
+	Fields map[string]any
+}
+
+// LedgerDataPage is one decoded page of a ledger's state
+type LedgerDataPage struct {
+	LedgerHash  string
+	LedgerIndex uint64
+	Objects     []LedgerObject
+	Marker      any // Marker of the next page, nil on the last page
+}
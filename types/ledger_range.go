@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// LedgerRange is an inclusive range of ledger indexes
+type LedgerRange struct {
+	Start uint64
+	End   uint64 // math.MaxUint64 for an open-ended ("current") range
+}
+
+// LedgerRanges is the parsed form of a server's complete_ledgers, in the order reported
+type LedgerRanges []LedgerRange
+
+// ParseLedgerRanges parses a complete_ledgers string such as "32570-88888,90000-current"
+// Single ledgers ("5") are accepted, and "empty" or "" yield no ranges
+func ParseLedgerRanges(completeLedgers string) (LedgerRanges, error) {
+	completeLedgers = strings.TrimSpace(completeLedgers)
+	if completeLedgers == "" || completeLedgers == "empty" {
+		return nil, nil
+	}
+
+	parts := strings.Split(completeLedgers, ",")
+	ranges := make(LedgerRanges, 0, len(parts))
+	for _, part := range parts {
+		startStr, endStr, isRange := strings.Cut(strings.TrimSpace(part), "-")
+
+		start, err := strconv.ParseUint(startStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ledger range %q: %w", part, err)
+		}
+
+		end := start
+		switch {
+		case !isRange:
+		case endStr == "current":
+			end = math.MaxUint64
+		default:
+			if end, err = strconv.ParseUint(endStr, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid ledger range %q: %w", part, err)
+			}
+		}
+
+		if end < start {
+			return nil, fmt.Errorf("invalid ledger range %q: end before start", part)
+		}
+		ranges = append(ranges, LedgerRange{Start: start, End: end})
+	}
+
+	return ranges, nil
+}
+
+// Contains reports whether ledgerIndex falls within one of the ranges
+func (r LedgerRanges) Contains(ledgerIndex uint64) bool {
+	for _, lr := range r {
+		if ledgerIndex >= lr.Start && ledgerIndex <= lr.End {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,43 @@
+package types
+
+// PathFindRequest represents a ripple_path_find request for the paths rippled would use for a payment
+type PathFindRequest struct {
+	Method string           `json:"method"`
+	Params []PathFindParams `json:"params"`
+}
+
+type PathFindParams struct {
+	SourceAccount      string `json:"source_account"`
+	DestinationAccount string `json:"destination_account"`
+	DestinationAmount  any    `json:"destination_amount"` // Drops string for XRP, {currency, issuer, value} object for tokens
+	LedgerIndex        any    `json:"ledger_index,omitempty"`
+}
+
+// PathFindResponse represents the response from ripple_path_find
+type PathFindResponse struct {
+	Result PathFindResult `json:"result"`
+}
+
+// ResultError returns the rippled error of the result, empty on success
+func (r *PathFindResponse) ResultError() string {
+	return r.Result.Error
+}
+
+type PathFindResult struct {
+	Alternatives          []PathAlternative `json:"alternatives"`
+	DestinationAccount    string            `json:"destination_account"`
+	DestinationCurrencies []string          `json:"destination_currencies,omitempty"`
+	LedgerIndex           uint64            `json:"ledger_index,omitempty"`
+	Validated             bool              `json:"validated"`
+	Status                string            `json:"status"`
+	// Error fields
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// PathAlternative is one way to deliver the destination amount, in the same shape as a Payment's Paths
+type PathAlternative struct {
+	PathsComputed []interface{} `json:"paths_computed"`
+	SourceAmount  any           `json:"source_amount"`
+}
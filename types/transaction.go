@@ -16,16 +16,44 @@ type TxResponse struct {
 	Result TxResult `json:"result"`
 }
 
+// ResultError returns the rippled error of the result, empty on success
+func (r *TxResponse) ResultError() string {
+	return r.Result.Error
+}
+
 type TxResult struct {
 	Hash            string `json:"hash"`
 	LedgerIndex     uint64 `json:"ledger_index"`
 	Status          string `json:"status"`
 	Validated       bool   `json:"validated"`
-	Meta            any    `json:"meta,omitempty"`      // JSON or binary
-	MetaBlob        string `json:"meta_blob,omitempty"` // When binary=true
-	TxBlob          string `json:"tx_blob,omitempty"`   // When binary=true
+	Meta            any    `json:"meta,omitempty"`      // JSON, or binary with API v1
+	MetaBlob        string `json:"meta_blob,omitempty"` // When binary=true with API v2
+	Tx              any    `json:"tx,omitempty"`        // Binary with API v1
+	TxBlob          string `json:"tx_blob,omitempty"`   // When binary=true with API v2
 	TransactionType string `json:"TransactionType,omitempty"`
 	Account         string `json:"Account,omitempty"`
 	Fee             string `json:"Fee,omitempty"`
 	Sequence        uint32 `json:"Sequence,omitempty"`
+	// Error fields
+	Error        string `json:"error,omitempty"`
+	ErrorCode    int    `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// BinaryTx returns the hex transaction blob of a binary response, whatever the API version
+func (r *TxResult) BinaryTx() string {
+	if r.TxBlob != "" {
+		return r.TxBlob
+	}
+	tx, _ := r.Tx.(string)
+	return tx
+}
+
+// BinaryMeta returns the hex metadata blob of a binary response, whatever the API version
+func (r *TxResult) BinaryMeta() string {
+	if r.MetaBlob != "" {
+		return r.MetaBlob
+	}
+	meta, _ := r.Meta.(string)
+	return meta
 }
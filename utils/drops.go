@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// dropsPerXRP is the number of drops in one XRP
+const dropsPerXRP = 1_000_000
+
+// DropsToXRPString converts drops to an exact XRP decimal string with 6 fractional digits,
+// e.g. 1 drop is "0.000001" and 12000000 drops "12.000000", without going through a float
+func DropsToXRPString(drops uint64) string {
+	return fmt.Sprintf("%d.%06d", drops/dropsPerXRP, drops%dropsPerXRP)
+}
+
+// XRPStringToDrops parses an XRP decimal string such as "12", "0.5" or "0.000001" to drops exactly,
+// rejecting signs, exponents, more than 6 fractional digits and values overflowing uint64
+func XRPStringToDrops(xrp string) (uint64, error) {
+	whole, frac, hasFrac := strings.Cut(xrp, ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("invalid XRP amount %q", xrp)
+	}
+	if hasFrac && frac == "" || len(frac) > 6 {
+		return 0, fmt.Errorf("invalid XRP amount %q: expected 1 to 6 fractional digits", xrp)
+	}
+	if !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid XRP amount %q: expected digits", xrp)
+	}
+
+	var wholeXRP uint64
+	if whole != "" {
+		var err error
+		if wholeXRP, err = strconv.ParseUint(whole, 10, 64); err != nil {
+			return 0, fmt.Errorf("invalid XRP amount %q: %w", xrp, err)
+		}
+	}
+	if wholeXRP > math.MaxUint64/dropsPerXRP {
+		return 0, fmt.Errorf("invalid XRP amount %q: overflows drops", xrp)
+	}
+
+	var fracDrops uint64
+	if frac != "" {
+		// Right-pad to drops: "5" is 500000 drops
+		fracDrops, _ = strconv.ParseUint(frac+strings.Repeat("0", 6-len(frac)), 10, 64)
+	}
+
+	drops := wholeXRP * dropsPerXRP
+	if drops > math.MaxUint64-fracDrops {
+		return 0, fmt.Errorf("invalid XRP amount %q: overflows drops", xrp)
+	}
+
+	return drops + fracDrops, nil
+}
+
+// isDigits reports whether s only holds ASCII digits, true for an empty string
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDropsToXRPString(t *testing.T) {
+	tests := []struct {
+		name  string
+		drops uint64
+		want  string
+	}{
+		{"zero", 0, "0.000000"},
+		{"one drop", 1, "0.000001"},
+		{"fee", 12, "0.000012"},
+		{"whole XRP", 12_000_000, "12.000000"},
+		{"total supply", 100_000_000_000_000_000, "100000000000.000000"},
+		{"max uint64", 18_446_744_073_709_551_615, "18446744073709.551615"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DropsToXRPString(tt.drops))
+		})
+	}
+}
+
+func TestXRPStringToDrops(t *testing.T) {
+	tests := []struct {
+		name    string
+		xrp     string
+		want    uint64
+		wantErr bool
+	}{
+		{"one drop", "0.000001", 1, false},
+		{"total supply", "100000000000", 100_000_000_000_000_000, false},
+		{"total supply with fraction", "100000000000.000000", 100_000_000_000_000_000, false},
+		{"half XRP", "0.5", 500_000, false},
+		{"no whole part", ".5", 500_000, false},
+		{"max uint64", "18446744073709.551615", 18_446_744_073_709_551_615, false},
+		{"overflow", "18446744073709.551616", 0, true},
+		{"whole part overflow", "18446744073710", 0, true},
+		{"too many fractional digits", "0.0000001", 0, true},
+		{"trailing point", "1.", 0, true},
+		{"empty", "", 0, true},
+		{"negative", "-1", 0, true},
+		{"exponent", "1e6", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := XRPStringToDrops(tt.xrp)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDropsRoundTrip(t *testing.T) {
+	for _, drops := range []uint64{0, 1, 999_999, 1_000_000, 100_000_000_000_000_000} {
+		got, err := XRPStringToDrops(DropsToXRPString(drops))
+		require.NoError(t, err)
+		assert.Equal(t, drops, got)
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NormalizeIOUValue canonicalizes an IOU or MPT amount value to a plain decimal string: exponents are
+// expanded, leading and trailing zeros dropped, and zero is "0" whatever its sign,
+// e.g. "1.0E-5" is "0.00001", "-0.50" is "-0.5" and "1000000000000000e-3" is "1000000000000"
+func NormalizeIOUValue(value string) (string, error) {
+	mantissa, exponentStr, hasExponent := strings.Cut(strings.ToLower(value), "e")
+
+	negative := strings.HasPrefix(mantissa, "-")
+	if negative {
+		mantissa = mantissa[1:]
+	} else {
+		mantissa = strings.TrimPrefix(mantissa, "+")
+	}
+
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return "", fmt.Errorf("invalid amount value %q", value)
+	}
+
+	exponent := 0
+	if hasExponent {
+		var err error
+		if exponent, err = strconv.Atoi(exponentStr); err != nil {
+			return "", fmt.Errorf("invalid amount value %q exponent: %w", value, err)
+		}
+	}
+
+	// IOU amounts have 16 significant digits and exponents within -96 and 80, rejecting far larger
+	// exponents keeps a malformed value from expanding to a huge string
+	if exponent < -1000 || exponent > 1000 {
+		return "", fmt.Errorf("invalid amount value %q: exponent out of range", value)
+	}
+
+	// All the digits, with the decimal point moved to after point digits
+	digits := whole + frac
+	point := len(whole) + exponent
+
+	if point <= 0 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	} else if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	intPart := strings.TrimLeft(digits[:point], "0")
+	fracPart := strings.TrimRight(digits[point:], "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	if intPart == "0" && fracPart == "" {
+		return "0", nil
+	}
+
+	normalized := intPart
+	if fracPart != "" {
+		normalized += "." + fracPart
+	}
+	if negative {
+		normalized = "-" + normalized
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeIOUValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"negative exponent", "1.0E-5", "0.00001", false},
+		{"negative", "-0.5", "-0.5", false},
+		{"large mantissa with exponent", "1000000000000000e-3", "1000000000000", false},
+		{"plain integer", "100", "100", false},
+		{"trailing zeros", "-0.50", "-0.5", false},
+		{"leading zeros", "007.25", "7.25", false},
+		{"positive exponent", "1.5e3", "1500", false},
+		{"explicit plus", "+2", "2", false},
+		{"rippled minimum", "1000000000000000e-96", "0." + strings.Repeat("0", 80) + "1", false},
+		{"negative zero", "-0.0", "0", false},
+		{"zero with exponent", "0e10", "0", false},
+		{"empty", "", "", true},
+		{"letters", "abc", "", true},
+		{"bad exponent", "1e", "", true},
+		{"exponent out of range", "1e1001", "", true},
+		{"sign only", "-", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeIOUValue(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
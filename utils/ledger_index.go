@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+)
+
+// signerListSpace is the ledger namespace of SignerList entries ('S')
+const signerListSpace = 0x0053
+
+// feeSettingsSpace is the ledger namespace of the FeeSettings singleton ('e')
+const feeSettingsSpace = 0x0065
+
+// SignerListIndex computes the ledger entry ID of an account's signer list
+// It is SHA-512Half of the namespace, the account ID and the signer list ID (always 0)
+func SignerListIndex(account string) (string, error) {
+	_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(account)
+	if err != nil {
+		return "", fmt.Errorf("decoding account %q: %w", account, err)
+	}
+
+	buf := make([]byte, 0, 2+len(accountID)+4)
+	buf = binary.BigEndian.AppendUint16(buf, signerListSpace)
+	buf = append(buf, accountID...)
+	buf = binary.BigEndian.AppendUint32(buf, 0)
+
+	sum := sha512.Sum512(buf)
+	return strings.ToUpper(hex.EncodeToString(sum[:32])), nil
+}
+
+// FeeSettingsIndex computes the ledger entry ID of the FeeSettings singleton, SHA-512Half of its namespace
+func FeeSettingsIndex() string {
+	sum := sha512.Sum512(binary.BigEndian.AppendUint16(nil, feeSettingsSpace))
+	return strings.ToUpper(hex.EncodeToString(sum[:32]))
+}
+
+// MPTIssuanceID computes the MPTokenIssuanceID of an issuance, the issuer's sequence (big endian)
+// followed by the issuer account ID
+func MPTIssuanceID(issuer string, sequence uint32) (string, error) {
+	_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(issuer)
+	if err != nil {
+		return "", fmt.Errorf("decoding issuer %q: %w", issuer, err)
+	}
+
+	id := binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(accountID)), sequence)
+	id = append(id, accountID...)
+
+	return strings.ToUpper(hex.EncodeToString(id)), nil
+}
+
+// NFTokenPageOwner returns the account holding an NFTokenPage, whose ID starts with the
+// owner's 20-byte account ID followed by the low 96 bits of the NFTokenIDs it can hold
+func NFTokenPageOwner(pageID string) (string, error) {
+	id, err := hex.DecodeString(pageID)
+	if err != nil {
+		return "", fmt.Errorf("decoding NFTokenPage ID %q: %w", pageID, err)
+	}
+	if len(id) != 32 {
+		return "", fmt.Errorf("NFTokenPage ID %q is %d bytes, expected 32", pageID, len(id))
+	}
+
+	owner, err := addresscodec.EncodeAccountIDToClassicAddress(id[:20])
+	if err != nil {
+		return "", fmt.Errorf("encoding NFTokenPage owner: %w", err)
+	}
+
+	return owner, nil
+}
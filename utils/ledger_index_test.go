@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNFTokenPageOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		pageID  string
+		want    string
+		wantErr bool
+	}{
+		{"last page", "B5F762798A53D543A014CAF8B297CFF8F2F937E8FFFFFFFFFFFFFFFFFFFFFFFF", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", false},
+		{"lower case", strings.ToLower("F667B0CA50CC7709A220B0561B85E53A48461FA80A7AB19A00000001B7DFAC7A"), "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", false},
+		{"not hex", strings.Repeat("ZZ", 32), "", true},
+		{"too short", "B5F762798A53D543A014CAF8B297CFF8F2F937E8", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := NFTokenPageOwner(tt.pageID)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, owner)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"encoding/hex"
+	"strings"
+	"unicode/utf8"
+)
+
+// MemoText returns the memo data (hex) as text when the memo format (hex) is a text/ media type,
+// or when there is no format and the data is valid UTF-8. It returns "" for binary memos, data
+// that is not valid hex and text that is not valid UTF-8
+func MemoText(dataHex, formatHex string) string {
+	data, err := hex.DecodeString(dataHex)
+	if err != nil || len(data) == 0 || !utf8.Valid(data) {
+		return ""
+	}
+
+	if formatHex == "" {
+		return string(data)
+	}
+
+	format, err := hex.DecodeString(formatHex)
+	if err != nil || !strings.HasPrefix(strings.ToLower(string(format)), "text/") {
+		return ""
+	}
+
+	return string(data)
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoText(t *testing.T) {
+	hexOf := func(s string) string { return hex.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name   string
+		data   string
+		format string
+		want   string
+	}{
+		{"text/plain", hexOf("hello xrpl"), hexOf("text/plain"), "hello xrpl"},
+		{"text/plain upper case hex", "68656C6C6F", "746578742F706C61696E", "hello"},
+		{"other text type", hexOf(`{"a":1}`), hexOf("text/json"), `{"a":1}`},
+		{"upper case media type", hexOf("hi"), hexOf("Text/Plain"), "hi"},
+		{"no format, utf-8 data", hexOf("café"), "", "café"},
+		{"binary format", hexOf("hello"), hexOf("application/octet-stream"), ""},
+		{"binary data, no format", "FF00FE", "", ""},
+		{"invalid utf-8 with text format", "C328", hexOf("text/plain"), ""},
+		{"data not hex", "zz", hexOf("text/plain"), ""},
+		{"format not hex", hexOf("hello"), "zz", ""},
+		{"empty data", "", hexOf("text/plain"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MemoText(tt.data, tt.format))
+		})
+	}
+}
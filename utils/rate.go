@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// transferRateParity is the TransferRate value meaning "no fee" (1e9 billionths = 100%)
+const transferRateParity = 1_000_000_000
+
+// maxTransferRate is the highest TransferRate rippled accepts, a 100% fee
+const maxTransferRate = 2_000_000_000
+
+// Tick sizes rippled accepts, 0 disables the tick size
+const (
+	minTickSize = 3
+	maxTickSize = 15
+)
+
+// TransferRateToPercent converts an AccountSet TransferRate to the fee percentage as a decimal string
+// 1000000000 means no fee ("0"), 1005000000 means a 0.5% fee ("0.5")
+// 0 is the special value that resets the transfer fee and is also reported as "0"
+// Rates below parity are invalid and return an empty string
+func TransferRateToPercent(rate uint32) string {
+	if rate == 0 {
+		return "0"
+	}
+	if rate < transferRateParity {
+		return ""
+	}
+
+	// The fee is expressed in billionths, so one percent is 10^7 units
+	fee := uint64(rate) - transferRateParity
+	whole := fee / 10_000_000
+	frac := fee % 10_000_000
+
+	if frac == 0 {
+		return strconv.FormatUint(whole, 10)
+	}
+
+	fracStr := strings.TrimRight(leftPad(strconv.FormatUint(frac, 10), 7), "0")
+	return strconv.FormatUint(whole, 10) + "." + fracStr
+}
+
+// ValidTransferRate reports whether rate is 0 (reset) or within parity and a 100% fee, as rippled requires
+func ValidTransferRate(rate uint32) bool {
+	return rate == 0 || (rate >= transferRateParity && rate <= maxTransferRate)
+}
+
+// ValidTickSize reports whether size is 0 (disable) or within 3 to 15 significant digits, as rippled requires
+func ValidTickSize(size uint32) bool {
+	return size == 0 || (size >= minTickSize && size <= maxTickSize)
+}
+
+// leftPad pads s with leading zeros up to width
+func leftPad(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat("0", width-len(s)) + s
+}
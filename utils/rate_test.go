@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferRateToPercent(t *testing.T) {
+	tests := []struct {
+		name string
+		rate uint32
+		want string
+	}{
+		{"reset", 0, "0"},
+		{"parity", 1_000_000_000, "0"},
+		{"half a percent", 1_005_000_000, "0.5"},
+		{"fractional", 1_000_000_001, "0.0000001"},
+		{"whole percent", 1_010_000_000, "1"},
+		{"maximum", 2_000_000_000, "100"},
+		{"below parity", 999_999_999, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TransferRateToPercent(tt.rate))
+		})
+	}
+}
+
+func TestValidTransferRate(t *testing.T) {
+	tests := []struct {
+		name string
+		rate uint32
+		want bool
+	}{
+		{"reset", 0, true},
+		{"below parity", 999_999_999, false},
+		{"parity", 1_000_000_000, true},
+		{"half a percent", 1_005_000_000, true},
+		{"maximum", 2_000_000_000, true},
+		{"above maximum", 2_000_000_001, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidTransferRate(tt.rate))
+		})
+	}
+}
+
+func TestValidTickSize(t *testing.T) {
+	tests := []struct {
+		name string
+		size uint32
+		want bool
+	}{
+		{"disabled", 0, true},
+		{"too small", 2, false},
+		{"minimum", 3, true},
+		{"maximum", 15, true},
+		{"too large", 16, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidTickSize(tt.size))
+		})
+	}
+}
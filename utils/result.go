@@ -0,0 +1,55 @@
+package utils
+
+import "strings"
+
+// ResultCategory groups transaction result codes by their prefix
+type ResultCategory string
+
+const (
+	// ResultSuccess is tes: the transaction succeeded and was applied
+	ResultSuccess ResultCategory = "success"
+	// ResultClaimed is tec: the transaction failed but claimed a fee and was applied
+	ResultClaimed ResultCategory = "claimed"
+	// ResultFailure is tef: the transaction failed and was not applied
+	ResultFailure ResultCategory = "failure"
+	// ResultLocal is tel: the transaction was rejected locally by the server
+	ResultLocal ResultCategory = "local"
+	// ResultMalformed is tem: the transaction was malformed
+	ResultMalformed ResultCategory = "malformed"
+	// ResultRetry is ter: the transaction could not be applied yet but may succeed later
+	ResultRetry ResultCategory = "retry"
+	// ResultUnknown is any code without a recognized prefix
+	ResultUnknown ResultCategory = "unknown"
+)
+
+// GetResultCategory returns the category of a transaction result code such as "tesSUCCESS"
+func GetResultCategory(result string) ResultCategory {
+	switch {
+	case strings.HasPrefix(result, "tes"):
+		return ResultSuccess
+	case strings.HasPrefix(result, "tec"):
+		return ResultClaimed
+	case strings.HasPrefix(result, "tef"):
+		return ResultFailure
+	case strings.HasPrefix(result, "tel"):
+		return ResultLocal
+	case strings.HasPrefix(result, "tem"):
+		return ResultMalformed
+	case strings.HasPrefix(result, "ter"):
+		return ResultRetry
+	}
+
+	return ResultUnknown
+}
+
+// IsClaimedResult reports whether a result is a tec code (failed but fee claimed)
+func IsClaimedResult(result string) bool {
+	return GetResultCategory(result) == ResultClaimed
+}
+
+// IsAppliedResult reports whether a result means the transaction was applied to the ledger:
+// tes, or tec which only claims the fee (and may still create or delete ledger entries)
+func IsAppliedResult(result string) bool {
+	category := GetResultCategory(result)
+	return category == ResultSuccess || category == ResultClaimed
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetResultCategory(t *testing.T) {
+	tests := []struct {
+		result      string
+		want        ResultCategory
+		wantClaimed bool
+		wantApplied bool
+	}{
+		{"tesSUCCESS", ResultSuccess, false, true},
+		{"tecPATH_DRY", ResultClaimed, true, true},
+		{"tefPAST_SEQ", ResultFailure, false, false},
+		{"telINSUF_FEE_P", ResultLocal, false, false},
+		{"temBAD_AMOUNT", ResultMalformed, false, false},
+		{"terQUEUED", ResultRetry, false, false},
+		{"", ResultUnknown, false, false},
+		{"tarUNKNOWN", ResultUnknown, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetResultCategory(tt.result))
+			assert.Equal(t, tt.wantClaimed, IsClaimedResult(tt.result))
+			assert.Equal(t, tt.wantApplied, IsAppliedResult(tt.result))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package utils
+
+import "crypto/sha512"
+
+// transactionIDPrefix is the hash prefix of signed transactions ("TXN\0")
+var transactionIDPrefix = []byte{'T', 'X', 'N', 0}
+
+// TransactionHash computes the hash of a serialized transaction, SHA-512Half of the prefix and the blob
+func TransactionHash(txBlob []byte) []byte {
+	hasher := sha512.New()
+	hasher.Write(transactionIDPrefix)
+	hasher.Write(txBlob)
+
+	return hasher.Sum(nil)[:32]
+}
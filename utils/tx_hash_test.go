@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionHash(t *testing.T) {
+	tests := []struct {
+		name   string
+		txBlob string
+		want   string
+	}{
+		{
+			name:   "signed payment",
+			txBlob: "120000220000000024000000056140000000000F424068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE8114B5F762798A53D543A014CAF8B297CFF8F2F937E88314F667B0CA50CC7709A220B0561B85E53A48461FA8",
+			want:   "D73985B82B093D35E87850995FE01C1540AEA4919FFB4DBB5777032657F02F58",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := hex.DecodeString(tt.txBlob)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, strings.ToUpper(hex.EncodeToString(TransactionHash(blob))))
+		})
+	}
+}